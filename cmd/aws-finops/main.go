@@ -7,6 +7,7 @@ import (
 	"github.com/diillson/aws-finops-dashboard-go/internal/adapter/driven/aws"
 	"github.com/diillson/aws-finops-dashboard-go/internal/adapter/driven/config"
 	"github.com/diillson/aws-finops-dashboard-go/internal/adapter/driven/export"
+	"github.com/diillson/aws-finops-dashboard-go/internal/adapter/driven/history"
 	"github.com/diillson/aws-finops-dashboard-go/internal/adapter/driving/cli"
 	"github.com/diillson/aws-finops-dashboard-go/internal/application/usecase"
 	"github.com/diillson/aws-finops-dashboard-go/pkg/console"
@@ -21,6 +22,7 @@ func main() {
 	awsRepo := aws.NewAWSRepository()
 	exportRepo := export.NewExportRepository()
 	configRepo := config.NewConfigRepository()
+	historyRepo := history.NewHistoryRepository()
 	consoleImpl := console.NewConsole()
 
 	// Inicializa o caso de uso
@@ -28,6 +30,7 @@ func main() {
 		awsRepo,
 		exportRepo,
 		configRepo,
+		historyRepo,
 		consoleImpl,
 	)
 
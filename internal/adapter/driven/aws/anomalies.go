@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	ceTypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// GetAnomalyMonitors lista os monitores de anomalia de custo configurados na conta.
+func (r *AWSRepositoryImpl) GetAnomalyMonitors(ctx context.Context, profile string) ([]entity.AnomalyMonitor, error) {
+	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
+	if err != nil {
+		return nil, err
+	}
+	ceClient := client.(*costexplorer.Client)
+
+	var monitors []entity.AnomalyMonitor
+	pages := costexplorer.NewGetAnomalyMonitorsPaginator(ceClient, &costexplorer.GetAnomalyMonitorsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list anomaly monitors: %w", err)
+		}
+		for _, m := range page.AnomalyMonitors {
+			monitors = append(monitors, entity.AnomalyMonitor{
+				ARN:  aws.ToString(m.MonitorArn),
+				Name: aws.ToString(m.MonitorName),
+				Type: string(m.MonitorType),
+			})
+		}
+	}
+	return monitors, nil
+}
+
+// GetCostAnomalies retorna as anomalias detectadas pelos monitores do Cost Explorer no período,
+// ordenadas pelo impacto. O serviço, a conta e a região vêm da causa raiz de maior contribuição.
+func (r *AWSRepositoryImpl) GetCostAnomalies(ctx context.Context, profile string, period entity.Period) ([]entity.CostAnomaly, error) {
+	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
+	if err != nil {
+		return nil, err
+	}
+	ceClient := client.(*costexplorer.Client)
+
+	input := &costexplorer.GetAnomaliesInput{
+		DateInterval: &ceTypes.AnomalyDateInterval{
+			StartDate: aws.String(period.StartDate()),
+			// O intervalo de anomalias é inclusivo nas duas pontas.
+			EndDate: aws.String(period.End.AddDate(0, 0, -1).Format("2006-01-02")),
+		},
+	}
+
+	var anomalies []entity.CostAnomaly
+	pages := costexplorer.NewGetAnomaliesPaginator(ceClient, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cost anomalies: %w", err)
+		}
+		for _, a := range page.Anomalies {
+			anomalies = append(anomalies, toCostAnomaly(a))
+		}
+	}
+
+	sort.Slice(anomalies, func(i, j int) bool { return anomalies[i].Impact > anomalies[j].Impact })
+	return anomalies, nil
+}
+
+func toCostAnomaly(a ceTypes.Anomaly) entity.CostAnomaly {
+	anomaly := entity.CostAnomaly{
+		ID:      aws.ToString(a.AnomalyId),
+		Source:  entity.AnomalySourceCostExplorer,
+		Monitor: aws.ToString(a.MonitorArn),
+		Service: aws.ToString(a.DimensionValue),
+	}
+	anomaly.StartDate, _ = time.Parse("2006-01-02", aws.ToString(a.AnomalyStartDate))
+	anomaly.EndDate, _ = time.Parse("2006-01-02", aws.ToString(a.AnomalyEndDate))
+	if a.AnomalyScore != nil {
+		anomaly.Score = a.AnomalyScore.MaxScore
+	}
+	if a.Impact != nil {
+		anomaly.Impact = a.Impact.TotalImpact
+		anomaly.ActualSpend = aws.ToFloat64(a.Impact.TotalActualSpend)
+		anomaly.ExpectedSpend = aws.ToFloat64(a.Impact.TotalExpectedSpend)
+		anomaly.ImpactPercent = aws.ToFloat64(a.Impact.TotalImpactPercentage)
+	}
+
+	for _, rc := range a.RootCauses {
+		cause := entity.AnomalyRootCause{
+			Service:     aws.ToString(rc.Service),
+			AccountID:   aws.ToString(rc.LinkedAccount),
+			AccountName: aws.ToString(rc.LinkedAccountName),
+			Region:      aws.ToString(rc.Region),
+			UsageType:   aws.ToString(rc.UsageType),
+		}
+		if rc.Impact != nil {
+			cause.Impact = rc.Impact.Contribution
+		}
+		anomaly.RootCauses = append(anomaly.RootCauses, cause)
+	}
+	sort.SliceStable(anomaly.RootCauses, func(i, j int) bool {
+		return anomaly.RootCauses[i].Impact > anomaly.RootCauses[j].Impact
+	})
+
+	if len(anomaly.RootCauses) > 0 {
+		top := anomaly.RootCauses[0]
+		if top.Service != "" {
+			anomaly.Service = top.Service
+		}
+		anomaly.AccountID = top.AccountID
+		anomaly.Region = top.Region
+	}
+	return anomaly
+}
+
+// GetDailyServiceCosts retorna o custo diário de cada serviço no período, precedido de
+// entity.AnomalyBaselineDays dias de histórico para o detector local. Dias sem custo valem zero.
+// Os tipos de registro de exclude (ex: créditos lançados em um único dia) ficam fora das séries.
+func (r *AWSRepositoryImpl) GetDailyServiceCosts(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, tags []string, exclude []entity.RecordType) ([]entity.ServiceDailyCosts, error) {
+	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
+	if err != nil {
+		return nil, err
+	}
+	ceClient := client.(*costexplorer.Client)
+
+	metric = metric.OrDefault()
+	filter, err := parseCostFilter(tags)
+	if err != nil {
+		return nil, err
+	}
+	filter = excludeRecordTypes(filter, exclude)
+
+	start := period.Start.AddDate(0, 0, -entity.AnomalyBaselineDays)
+	input := &costexplorer.GetCostAndUsageInput{
+		TimePeriod: &ceTypes.DateInterval{
+			Start: aws.String(start.Format("2006-01-02")),
+			End:   aws.String(period.EndDate()),
+		},
+		Granularity: ceTypes.GranularityDaily,
+		Metrics:     []string{string(metric)},
+		GroupBy: []ceTypes.GroupDefinition{
+			{Type: ceTypes.GroupDefinitionTypeDimension, Key: aws.String("SERVICE")},
+		},
+		Filter: filter,
+	}
+
+	// As páginas repetem os dias com outros grupos; os custos são indexados por serviço e dia.
+	costs := make(map[string]map[string]float64)
+	for {
+		result, err := ceClient.GetCostAndUsage(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get daily cost by service: %w", err)
+		}
+		for _, byTime := range result.ResultsByTime {
+			day := aws.ToString(byTime.TimePeriod.Start)
+			for _, group := range byTime.Groups {
+				amount := group.Metrics[string(metric)].Amount
+				if amount == nil || len(group.Keys) == 0 {
+					continue
+				}
+				cost, _ := strconv.ParseFloat(*amount, 64)
+				if costs[group.Keys[0]] == nil {
+					costs[group.Keys[0]] = make(map[string]float64)
+				}
+				costs[group.Keys[0]][day] += cost
+			}
+		}
+		if result.NextPageToken == nil {
+			break
+		}
+		input.NextPageToken = result.NextPageToken
+	}
+
+	services := make([]string, 0, len(costs))
+	for service := range costs {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	series := make([]entity.ServiceDailyCosts, 0, len(services))
+	for _, service := range services {
+		s := entity.ServiceDailyCosts{Service: service}
+		for day := start; day.Before(period.End); day = day.AddDate(0, 0, 1) {
+			s.Costs = append(s.Costs, entity.DailyCost{Date: day, Cost: costs[service][day.Format("2006-01-02")]})
+		}
+		series = append(series, s)
+	}
+	return series, nil
+}
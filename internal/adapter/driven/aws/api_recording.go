@@ -0,0 +1,503 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// Layout de uma gravação: o manifesto com os perfis e, em responses/, um arquivo JSON por chamada.
+const (
+	recordingManifest  = "recording.json"
+	recordingResponses = "responses"
+	recordingVersion   = 1
+	redacted           = "REDACTED"
+)
+
+// Credenciais e segredos removidos das gravações: cabeçalhos de assinatura, credenciais devolvidas
+// pelo STS (XML) ou por outros serviços (JSON) e parâmetros de AssumeRole.
+var (
+	redactedHeaders = []string{"Authorization", "X-Amz-Security-Token"}
+	xmlSecrets      = regexp.MustCompile(`<(AccessKeyId|SecretAccessKey|SessionToken)>[^<]*<`)
+	jsonSecrets     = regexp.MustCompile(`"(?i)(accessKeyId|secretAccessKey|sessionToken)"\s*:\s*"[^"]*"`)
+	formSecrets     = regexp.MustCompile(`\b(ExternalId|SerialNumber|TokenCode)=[^&]*`)
+	// recordedDates casa as datas das requisições, que mudam com o dia em que o relatório roda.
+	recordedDates = regexp.MustCompile(`\d{4}-\d{2}-\d{2}(T[0-9:.]+Z?)?`)
+)
+
+// recordingManifestFile é o manifesto de uma gravação: os perfis usados e a região padrão de cada um.
+type recordingManifestFile struct {
+	Version    int               `json:"version"`
+	RecordedAt time.Time         `json:"recorded_at"`
+	Profiles   map[string]string `json:"profiles"`
+}
+
+// recordedExchange é uma chamada gravada, com a requisição e a resposta sem credenciais.
+type recordedExchange struct {
+	Seq       int             `json:"seq"`
+	Profile   string          `json:"profile"`
+	Operation string          `json:"operation"`
+	Request   recordedMessage `json:"request"`
+	Response  recordedMessage `json:"response"`
+}
+
+// recordedMessage é uma requisição ou resposta HTTP; corpos binários ficam em base64.
+type recordedMessage struct {
+	Method     string      `json:"method,omitempty"`
+	URL        string      `json:"url,omitempty"`
+	StatusCode int         `json:"status_code,omitempty"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 string      `json:"body_base64,omitempty"`
+}
+
+// apiRecorder grava em disco as chamadas feitas pelos clientes do repositório.
+type apiRecorder struct {
+	dir      string
+	mu       sync.Mutex
+	seq      int
+	manifest recordingManifestFile
+	// failed conta as chamadas que não puderam ser gravadas; firstErr é o erro da primeira delas.
+	failed   int
+	firstErr error
+}
+
+// apiReplayer devolve as respostas de uma gravação. Cada requisição é procurada primeiro pelo conteúdo
+// exato e depois com as datas ignoradas, para que um relatório possa ser reproduzido em outro dia.
+type apiReplayer struct {
+	manifest recordingManifestFile
+	mu       sync.Mutex
+	exact    map[string][]*recordedExchange
+	undated  map[string][]*recordedExchange
+	served   map[string]int
+}
+
+// RecordAPIResponses records every AWS API response of the following calls under dir, with the
+// credentials redacted, so that the run can be replayed with ReplayAPIResponses.
+func (r *AWSRepositoryImpl) RecordAPIResponses(dir string) error {
+	if err := os.MkdirAll(filepath.Join(dir, recordingResponses), 0o700); err != nil {
+		return fmt.Errorf("error creating recording directory '%s': %w", dir, err)
+	}
+	rec := &apiRecorder{
+		dir:      dir,
+		manifest: recordingManifestFile{Version: recordingVersion, RecordedAt: time.Now().UTC(), Profiles: make(map[string]string)},
+	}
+	if err := rec.saveManifest(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recorder, r.replayer = rec, nil
+	r.resetClientsLocked()
+	return nil
+}
+
+// RecordingErr returns an error describing the API responses that could not be written to the
+// recording started by RecordAPIResponses, or nil when all of them were recorded.
+func (r *AWSRepositoryImpl) RecordingErr() error {
+	r.mu.Lock()
+	rec := r.recorder
+	r.mu.Unlock()
+	if rec == nil {
+		return nil
+	}
+	return rec.err()
+}
+
+// ReplayAPIResponses serves the AWS API calls from a recording made with RecordAPIResponses, without
+// loading AWS credentials or using the network.
+func (r *AWSRepositoryImpl) ReplayAPIResponses(dir string) error {
+	rep, err := loadRecording(dir)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recorder, r.replayer = nil, rep
+	r.resetClientsLocked()
+	return nil
+}
+
+// resetClientsLocked descarta configs e clientes criados antes da troca de modo; r.mu deve estar travado.
+func (r *AWSRepositoryImpl) resetClientsLocked() {
+	clear(r.cfgCache)
+	clear(r.clientCache)
+	clear(r.accountIDs)
+}
+
+// replayConfig monta a config de um perfil gravado: região da gravação, credenciais fictícias (as
+// requisições continuam sendo assinadas) e o cliente HTTP que devolve as respostas gravadas.
+func (p *apiReplayer) replayConfig(profile string) (aws.Config, error) {
+	region, ok := p.manifest.Profiles[profile]
+	if !ok {
+		return aws.Config{}, fmt.Errorf("%w: profile %s is not in the recording", entity.ErrNotRecorded, profile)
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+	return aws.Config{
+		Region:      region,
+		Credentials: aws.NewCredentialsCache(credentials.NewStaticCredentialsProvider(redacted, redacted, "")),
+		HTTPClient:  &replayingClient{profile: profile, replayer: p},
+	}, nil
+}
+
+// profiles retorna os perfis da gravação, em ordem alfabética.
+func (p *apiReplayer) profiles() []string {
+	names := make([]string, 0, len(p.manifest.Profiles))
+	for name := range p.manifest.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// tapeClientLocked devolve o cliente HTTP que grava ou reproduz as chamadas de profile, a partir do cliente
+// da config; r.mu deve estar travado. Sem gravação nem reprodução, devolve o próprio cliente.
+func (r *AWSRepositoryImpl) tapeClientLocked(profile string, client aws.HTTPClient) aws.HTTPClient {
+	switch {
+	case r.recorder != nil:
+		if rc, ok := client.(*recordingClient); ok {
+			client = rc.next
+		}
+		if client == nil {
+			client = http.DefaultClient
+		}
+		return &recordingClient{profile: profile, next: client, recorder: r.recorder}
+	case r.replayer != nil:
+		return &replayingClient{profile: profile, replayer: r.replayer}
+	default:
+		return client
+	}
+}
+
+// recordingClient repassa as chamadas de um perfil ao cliente original e grava cada resposta.
+type recordingClient struct {
+	profile  string
+	next     aws.HTTPClient
+	recorder *apiRecorder
+}
+
+func (c *recordingClient) Do(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.next.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	// Uma falha ao gravar não interrompe o relatório: a chamada fica fora da gravação e o erro é
+	// informado ao fim da execução (RecordingErr).
+	if err := c.recorder.save(c.profile, req, body, resp, respBody); err != nil {
+		c.recorder.fail(err)
+	}
+	return resp, nil
+}
+
+// replayingClient atende as chamadas de um perfil com as respostas gravadas.
+type replayingClient struct {
+	profile  string
+	replayer *apiReplayer
+}
+
+func (c *replayingClient) Do(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	ex := c.replayer.next(c.profile, req, redactBody(body))
+	if ex == nil {
+		return nil, &notRecordedError{profile: c.profile, operation: requestOperation(req, body)}
+	}
+	respBody, err := ex.Response.body()
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", ex.Response.StatusCode, http.StatusText(ex.Response.StatusCode)),
+		StatusCode:    ex.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        ex.Response.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+// notRecordedError indica uma requisição ausente da gravação. Não é repetida pelo SDK, já que a
+// resposta nunca vai aparecer.
+type notRecordedError struct {
+	profile   string
+	operation string
+}
+
+func (e *notRecordedError) Error() string {
+	return fmt.Sprintf("%s: %s for profile %s", entity.ErrNotRecorded, e.operation, e.profile)
+}
+
+func (e *notRecordedError) Unwrap() error { return entity.ErrNotRecorded }
+
+func (e *notRecordedError) RetryableError() bool { return false }
+
+// save grava uma chamada em responses/, com as credenciais removidas.
+func (rec *apiRecorder) save(profile string, req *http.Request, reqBody []byte, resp *http.Response, respBody []byte) error {
+	rec.mu.Lock()
+	rec.seq++
+	seq := rec.seq
+	rec.mu.Unlock()
+
+	header := req.Header.Clone()
+	for _, h := range redactedHeaders {
+		if header.Get(h) != "" {
+			header.Set(h, redacted)
+		}
+	}
+	operation := requestOperation(req, reqBody)
+	ex := recordedExchange{
+		Seq:       seq,
+		Profile:   profile,
+		Operation: operation,
+		Request:   newRecordedMessage(header, redactBody(reqBody)),
+		Response:  newRecordedMessage(resp.Header.Clone(), redactBody(respBody)),
+	}
+	ex.Request.Method, ex.Request.URL = req.Method, req.URL.String()
+	ex.Response.StatusCode = resp.StatusCode
+
+	data, err := json.MarshalIndent(ex, "", "  ")
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%06d-%s.json", seq, sanitizeFileName(operation))
+	return os.WriteFile(filepath.Join(rec.dir, recordingResponses, name), data, 0o600)
+}
+
+// fail registra uma chamada que não pôde ser gravada.
+func (rec *apiRecorder) fail(err error) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.failed++
+	if rec.firstErr == nil {
+		rec.firstErr = err
+	}
+}
+
+// err descreve as chamadas que ficaram fora da gravação; nil se todas foram gravadas.
+func (rec *apiRecorder) err() error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if rec.failed == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d API responses were not recorded, the first because of: %w", rec.failed, rec.firstErr)
+}
+
+// addProfile registra no manifesto um perfil e a região padrão dele.
+func (rec *apiRecorder) addProfile(profile, region string) error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if current, ok := rec.manifest.Profiles[profile]; ok && current == region {
+		return nil
+	}
+	rec.manifest.Profiles[profile] = region
+	return rec.saveManifestLocked()
+}
+
+func (rec *apiRecorder) saveManifest() error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return rec.saveManifestLocked()
+}
+
+func (rec *apiRecorder) saveManifestLocked() error {
+	data, err := json.MarshalIndent(rec.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(rec.dir, recordingManifest), data, 0o600); err != nil {
+		return fmt.Errorf("error writing recording manifest: %w", err)
+	}
+	return nil
+}
+
+// loadRecording lê o manifesto e as chamadas de uma gravação, indexadas pela requisição.
+func loadRecording(dir string) (*apiReplayer, error) {
+	data, err := os.ReadFile(filepath.Join(dir, recordingManifest))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", entity.ErrInvalidRecording, dir, err)
+	}
+	var manifest recordingManifestFile
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", entity.ErrInvalidRecording, recordingManifest, err)
+	}
+	if manifest.Version != recordingVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", entity.ErrInvalidRecording, manifest.Version)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, recordingResponses, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidRecording, err)
+	}
+	var exchanges []*recordedExchange
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", entity.ErrInvalidRecording, err)
+		}
+		ex := &recordedExchange{}
+		if err := json.Unmarshal(data, ex); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", entity.ErrInvalidRecording, filepath.Base(file), err)
+		}
+		exchanges = append(exchanges, ex)
+	}
+	sort.Slice(exchanges, func(i, j int) bool { return exchanges[i].Seq < exchanges[j].Seq })
+
+	p := &apiReplayer{
+		manifest: manifest,
+		exact:    make(map[string][]*recordedExchange),
+		undated:  make(map[string][]*recordedExchange),
+		served:   make(map[string]int),
+	}
+	for _, ex := range exchanges {
+		u, err := url.Parse(ex.Request.URL)
+		if err != nil {
+			return nil, fmt.Errorf("%w: request #%d: %v", entity.ErrInvalidRecording, ex.Seq, err)
+		}
+		body, err := ex.Request.body()
+		if err != nil {
+			return nil, fmt.Errorf("%w: request #%d: %v", entity.ErrInvalidRecording, ex.Seq, err)
+		}
+		key := exchangeKey(ex.Profile, ex.Request.Method, u.Host, u.Path, u.RawQuery, ex.Request.Header.Get("X-Amz-Target"), body)
+		p.exact[key] = append(p.exact[key], ex)
+		p.undated[undatedKey(key)] = append(p.undated[undatedKey(key)], ex)
+	}
+	return p, nil
+}
+
+// next devolve a resposta gravada para a requisição. Chamadas repetidas recebem as respostas na ordem
+// da gravação (ex: um erro de throttling seguido do sucesso) e, esgotadas, a última de novo.
+func (p *apiReplayer) next(profile string, req *http.Request, body []byte) *recordedExchange {
+	key := exchangeKey(profile, req.Method, req.URL.Host, req.URL.Path, req.URL.RawQuery, req.Header.Get("X-Amz-Target"), body)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if ex := p.take("exact|"+key, p.exact[key]); ex != nil {
+		return ex
+	}
+	undated := undatedKey(key)
+	return p.take("undated|"+undated, p.undated[undated])
+}
+
+// take devolve a próxima resposta da lista, contando as já servidas por servedKey; p.mu deve estar travado.
+func (p *apiReplayer) take(servedKey string, list []*recordedExchange) *recordedExchange {
+	if len(list) == 0 {
+		return nil
+	}
+	i := min(p.served[servedKey], len(list)-1)
+	p.served[servedKey]++
+	return list[i]
+}
+
+// exchangeKey identifica uma requisição pelo perfil, endpoint, operação e corpo (já sem segredos).
+func exchangeKey(profile, method, host, path, query, target string, body []byte) string {
+	return strings.Join([]string{profile, method, host, path, query, target, string(body)}, "\x00")
+}
+
+// undatedKey troca as datas da chave por um marcador.
+func undatedKey(key string) string {
+	return recordedDates.ReplaceAllString(key, "<date>")
+}
+
+// requestOperation descreve a operação da requisição: X-Amz-Target (JSON), Action (Query) ou x-id (REST).
+func requestOperation(req *http.Request, body []byte) string {
+	if target := req.Header.Get("X-Amz-Target"); target != "" {
+		return target[strings.LastIndex(target, ".")+1:]
+	}
+	if id := req.URL.Query().Get("x-id"); id != "" {
+		return id
+	}
+	for _, part := range strings.Split(string(body), "&") {
+		if action, ok := strings.CutPrefix(part, "Action="); ok {
+			return action
+		}
+	}
+	return req.Method + " " + req.URL.Host
+}
+
+// readRequestBody lê o corpo da requisição e o recoloca para o envio.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	return body, nil
+}
+
+// redactBody remove credenciais e segredos de um corpo de requisição ou resposta.
+func redactBody(body []byte) []byte {
+	body = xmlSecrets.ReplaceAll(body, []byte("<$1>"+redacted+"<"))
+	body = jsonSecrets.ReplaceAll(body, []byte(`"$1":"`+redacted+`"`))
+	return formSecrets.ReplaceAll(body, []byte("$1="+redacted))
+}
+
+func newRecordedMessage(header http.Header, body []byte) recordedMessage {
+	m := recordedMessage{Header: header}
+	if utf8.Valid(body) {
+		m.Body = string(body)
+	} else {
+		m.BodyBase64 = base64.StdEncoding.EncodeToString(body)
+	}
+	return m
+}
+
+func (m recordedMessage) body() ([]byte, error) {
+	if m.BodyBase64 != "" {
+		return base64.StdEncoding.DecodeString(m.BodyBase64)
+	}
+	return []byte(m.Body), nil
+}
+
+// sanitizeFileName mantém apenas letras, números, '-' e '_' no nome do arquivo da chamada.
+func sanitizeFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// assumeRoleSessionName identifica as sessões da ferramenta no CloudTrail das contas alvo.
+const assumeRoleSessionName = "aws-finops-dashboard"
+
+// assumeRoleTarget é uma conta acessada assumindo uma role a partir de um perfil base.
+type assumeRoleTarget struct {
+	BaseProfile string
+	AccountID   string
+	Role        entity.AssumeRoleOptions
+}
+
+// AssumeRoleProfile registra o acesso a uma conta via AssumeRole e retorna o nome do perfil
+// que deve ser usado nos demais métodos do repositório para operar dentro dela.
+// As credenciais assumidas ficam em cache por conta, role e opções, e são renovadas quando expiram.
+func (r *AWSRepositoryImpl) AssumeRoleProfile(baseProfile, accountID string, role entity.AssumeRoleOptions) string {
+	key := role.ProfileName(baseProfile, accountID)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.roleTargets[key] = assumeRoleTarget{BaseProfile: baseProfile, AccountID: accountID, Role: role}
+	return key
+}
+
+// assumeRoleConfig deriva da config do perfil base uma config que assume a role na conta alvo.
+func assumeRoleConfig(base aws.Config, target assumeRoleTarget) aws.Config {
+	stsCfg := base.Copy()
+	if stsCfg.Region == "" {
+		stsCfg.Region = "us-east-1"
+	}
+	roleARN := fmt.Sprintf("arn:%s:iam::%s:role/%s", entity.Partition(stsCfg.Region), target.AccountID, target.Role.RoleName)
+
+	provider := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(stsCfg), roleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = assumeRoleSessionName
+		if target.Role.ExternalID != "" {
+			o.ExternalID = aws.String(target.Role.ExternalID)
+		}
+		if target.Role.Duration > 0 {
+			o.Duration = target.Role.Duration
+		}
+	})
+
+	cfg := base.Copy()
+	cfg.Credentials = aws.NewCredentialsCache(provider)
+	return cfg
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -20,11 +21,17 @@ import (
 	ceTypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
 	"github.com/diillson/aws-finops-dashboard-go/internal/domain/repository"
@@ -34,7 +41,15 @@ import (
 type AWSRepositoryImpl struct {
 	cfgCache    map[string]aws.Config
 	clientCache map[string]interface{}
-	mu          sync.Mutex
+	roleTargets map[string]assumeRoleTarget
+	priceCache  map[string]entity.RegionPrices
+	accountIDs  map[string]string
+	// respCache é o cache em disco das respostas do Cost Explorer; nil quando desligado.
+	respCache *responseCache
+	// recorder e replayer gravam ou reproduzem as chamadas à AWS (--record/--replay); no máximo um é usado.
+	recorder *apiRecorder
+	replayer *apiReplayer
+	mu       sync.Mutex
 }
 
 // NewAWSRepository cria uma nova implementação do AWSRepository.
@@ -42,6 +57,9 @@ func NewAWSRepository() repository.AWSRepository {
 	return &AWSRepositoryImpl{
 		cfgCache:    make(map[string]aws.Config),
 		clientCache: make(map[string]interface{}),
+		roleTargets: make(map[string]assumeRoleTarget),
+		priceCache:  make(map[string]entity.RegionPrices),
+		accountIDs:  make(map[string]string),
 	}
 }
 
@@ -63,15 +81,47 @@ func (r *AWSRepositoryImpl) GetAllRegions(ctx context.Context, profile string) (
 func (r *AWSRepositoryImpl) getAWSConfig(ctx context.Context, profile string) (aws.Config, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	return r.loadAWSConfigLocked(ctx, profile)
+}
 
+// loadAWSConfigLocked carrega (ou lê do cache) a config de um perfil; r.mu deve estar travado.
+// Perfis registrados por AssumeRoleProfile usam as credenciais da role assumida na conta alvo.
+func (r *AWSRepositoryImpl) loadAWSConfigLocked(ctx context.Context, profile string) (aws.Config, error) {
 	if cfg, ok := r.cfgCache[profile]; ok {
 		return cfg, nil
 	}
 
+	if target, ok := r.roleTargets[profile]; ok {
+		base, err := r.loadAWSConfigLocked(ctx, target.BaseProfile)
+		if err != nil {
+			return aws.Config{}, err
+		}
+		cfg := assumeRoleConfig(base, target)
+		// As chamadas na conta alvo são gravadas com o perfil dela, não com o do perfil base.
+		cfg.HTTPClient = r.tapeClientLocked(profile, cfg.HTTPClient)
+		r.cfgCache[profile] = cfg
+		return cfg, nil
+	}
+
+	if r.replayer != nil {
+		cfg, err := r.replayer.replayConfig(profile)
+		if err != nil {
+			return aws.Config{}, err
+		}
+		r.cfgCache[profile] = cfg
+		return cfg, nil
+	}
+
 	cfg, err := config.LoadDefaultConfig(ctx, config.WithSharedConfigProfile(profile))
 	if err != nil {
 		return aws.Config{}, fmt.Errorf("failed to load AWS config for profile %s: %w", profile, err)
 	}
+	if r.recorder != nil {
+		if err := r.recorder.addProfile(profile, cfg.Region); err != nil {
+			return aws.Config{}, err
+		}
+		cfg.HTTPClient = r.tapeClientLocked(profile, cfg.HTTPClient)
+	}
 
 	r.cfgCache[profile] = cfg
 	return cfg, nil
@@ -109,7 +159,9 @@ func (r *AWSRepositoryImpl) getServiceClient(ctx context.Context, profile, regio
 		client = cloudwatchlogs.NewFromConfig(regionalCfg)
 	case "costexplorer":
 		regionalCfg.Region = "us-east-1"
-		client = costexplorer.NewFromConfig(regionalCfg)
+		client = costexplorer.NewFromConfig(regionalCfg, func(o *costexplorer.Options) {
+			o.APIOptions = append(o.APIOptions, r.responseCacheMiddleware(profile))
+		})
 	case "budgets":
 		regionalCfg.Region = "us-east-1"
 		client = budgets.NewFromConfig(regionalCfg)
@@ -117,8 +169,23 @@ func (r *AWSRepositoryImpl) getServiceClient(ctx context.Context, profile, regio
 		client = rds.NewFromConfig(regionalCfg)
 	case "lambda":
 		client = lambda.NewFromConfig(regionalCfg)
+	case "elasticache":
+		client = elasticache.NewFromConfig(regionalCfg)
+	case "opensearch":
+		client = opensearch.NewFromConfig(regionalCfg)
+	case "redshift":
+		client = redshift.NewFromConfig(regionalCfg)
 	case "elbv2":
 		client = elasticloadbalancingv2.NewFromConfig(regionalCfg)
+	case "organizations":
+		regionalCfg.Region = "us-east-1"
+		client = organizations.NewFromConfig(regionalCfg)
+	case "savingsplans":
+		regionalCfg.Region = "us-east-1"
+		client = savingsplans.NewFromConfig(regionalCfg)
+	case "pricing":
+		regionalCfg.Region = pricingRegion
+		client = pricing.NewFromConfig(regionalCfg)
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", service)
 	}
@@ -131,6 +198,14 @@ func (r *AWSRepositoryImpl) getServiceClient(ctx context.Context, profile, regio
 }
 
 func (r *AWSRepositoryImpl) GetAWSProfiles() []string {
+	// Na reprodução, os perfis são os da gravação, e não os da máquina atual.
+	r.mu.Lock()
+	replayer := r.replayer
+	r.mu.Unlock()
+	if replayer != nil {
+		return replayer.profiles()
+	}
+
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		return []string{"default"}
@@ -252,47 +327,36 @@ func (r *AWSRepositoryImpl) GetEC2Summary(ctx context.Context, profile string, r
 	return summary, nil
 }
 
-func (r *AWSRepositoryImpl) GetCostData(ctx context.Context, profile string, timeRange *int, tags []string, breakdown bool) (entity.CostData, error) {
+func (r *AWSRepositoryImpl) GetCostData(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, groupBy entity.Grouping, tags []string, exclude []entity.RecordType, breakdown bool) (entity.CostData, error) {
 	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
 	if err != nil {
 		return entity.CostData{}, err
 	}
 	ceClient := client.(*costexplorer.Client)
 
-	today := time.Now().UTC()
-	var startDate, endDate, prevStartDate, prevEndDate time.Time
-	currentPeriodName, previousPeriodName := "Current month's cost", "Last month's cost"
-
-	if timeRange != nil && *timeRange > 0 {
-		endDate = today
-		startDate = today.AddDate(0, 0, -(*timeRange))
-		prevEndDate = startDate.AddDate(0, 0, -1)
-		prevStartDate = prevEndDate.AddDate(0, 0, -(*timeRange))
-		currentPeriodName = fmt.Sprintf("Current %d days cost", *timeRange)
-		previousPeriodName = fmt.Sprintf("Previous %d days cost", *timeRange)
-	} else {
-		startDate = time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
-		endDate = today
-		if startDate.Day() == endDate.Day() && startDate.Month() == endDate.Month() && startDate.Year() == endDate.Year() {
-			endDate = endDate.AddDate(0, 0, 1)
-		}
-		prevEndDate = startDate.AddDate(0, 0, -1)
-		prevStartDate = time.Date(prevEndDate.Year(), prevEndDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	prevPeriod := period.Previous()
+	startDate, endDate := period.Start, period.End
+	prevStartDate, prevEndDate := prevPeriod.Start, prevPeriod.End
+	currentPeriodName, previousPeriodName := period.Name("cost"), prevPeriod.Name("cost")
+	metric = metric.OrDefault()
+	if len(groupBy) == 0 {
+		groupBy = entity.DefaultGrouping()
 	}
 
-	filter, err := parseTagFilter(tags)
+	baseFilter, err := parseCostFilter(tags)
 	if err != nil {
 		return entity.CostData{}, err
 	}
+	filter := excludeRecordTypes(baseFilter, exclude)
 
 	var costData entity.CostData
 	var wg sync.WaitGroup
-	errChan := make(chan error, 3)
+	errChan := make(chan error, 4)
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		cost, err := r.getCostForPeriod(ctx, ceClient, startDate, endDate, filter)
+		cost, err := r.getCostForPeriod(ctx, ceClient, startDate, endDate, metric, filter)
 		if err != nil {
 			errChan <- fmt.Errorf("failed to get current period cost: %w", err)
 			return
@@ -303,7 +367,7 @@ func (r *AWSRepositoryImpl) GetCostData(ctx context.Context, profile string, tim
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		cost, err := r.getCostForPeriod(ctx, ceClient, prevStartDate, prevEndDate, filter)
+		cost, err := r.getCostForPeriod(ctx, ceClient, prevStartDate, prevEndDate, metric, filter)
 		if err != nil {
 			errChan <- fmt.Errorf("failed to get previous period cost: %w", err)
 			return
@@ -314,15 +378,28 @@ func (r *AWSRepositoryImpl) GetCostData(ctx context.Context, profile string, tim
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		// Passa a flag 'breakdown' para a função de busca
-		services, err := r.getCostByService(ctx, ceClient, startDate, endDate, filter, breakdown)
+		// O detalhamento por usage type só se aplica ao agrupamento padrão por serviço
+		services, err := r.getCostByGroup(ctx, ceClient, startDate, endDate, metric, groupBy, filter, breakdown && groupBy.IsDefault())
 		if err != nil {
-			errChan <- fmt.Errorf("failed to get cost by service: %w", err)
+			errChan <- fmt.Errorf("failed to get grouped costs: %w", err)
 			return
 		}
 		costData.CurrentMonthCostByService = services
 	}()
 
+	if len(exclude) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			excluded, err := r.getExcludedCosts(ctx, ceClient, startDate, endDate, metric, baseFilter, exclude)
+			if err != nil {
+				errChan <- fmt.Errorf("failed to get excluded costs: %w", err)
+				return
+			}
+			costData.ExcludedCosts = excluded
+		}()
+	}
+
 	wg.Wait()
 	close(errChan)
 
@@ -335,21 +412,21 @@ func (r *AWSRepositoryImpl) GetCostData(ctx context.Context, profile string, tim
 	costData.CurrentPeriodName, costData.PreviousPeriodName = currentPeriodName, previousPeriodName
 	costData.CurrentPeriodStart, costData.CurrentPeriodEnd = startDate, endDate
 	costData.PreviousPeriodStart, costData.PreviousPeriodEnd = prevStartDate, prevEndDate
-	if timeRange != nil {
-		costData.TimeRange = *timeRange
-	}
+	costData.TimeRange = period.LastDays()
+	costData.Metric = metric
+	costData.GroupBy = groupBy
 
 	return costData, nil
 }
 
-func (r *AWSRepositoryImpl) getCostForPeriod(ctx context.Context, client *costexplorer.Client, start, end time.Time, filter *ceTypes.Expression) (float64, error) {
+func (r *AWSRepositoryImpl) getCostForPeriod(ctx context.Context, client *costexplorer.Client, start, end time.Time, metric entity.CostMetric, filter *ceTypes.Expression) (float64, error) {
 	input := &costexplorer.GetCostAndUsageInput{
 		TimePeriod: &ceTypes.DateInterval{
 			Start: aws.String(start.Format("2006-01-02")),
 			End:   aws.String(end.Format("2006-01-02")),
 		},
 		Granularity: ceTypes.GranularityMonthly,
-		Metrics:     []string{"UnblendedCost"},
+		Metrics:     []string{string(metric)},
 		Filter:      filter,
 	}
 
@@ -358,62 +435,187 @@ func (r *AWSRepositoryImpl) getCostForPeriod(ctx context.Context, client *costex
 		return 0, err
 	}
 
+	// Períodos que cruzam meses retornam um resultado por mês.
 	var totalCost float64
-	if len(result.ResultsByTime) > 0 && result.ResultsByTime[0].Total != nil {
-		if val, ok := result.ResultsByTime[0].Total["UnblendedCost"]; ok {
+	for _, byTime := range result.ResultsByTime {
+		if val, ok := byTime.Total[string(metric)]; ok && val.Amount != nil {
 			cost, _ := strconv.ParseFloat(*val.Amount, 64)
-			totalCost = cost
+			totalCost += cost
 		}
 	}
 	return totalCost, nil
 }
 
-func (r *AWSRepositoryImpl) getCostByService(ctx context.Context, client *costexplorer.Client, start, end time.Time, filter *ceTypes.Expression, breakdown bool) ([]entity.ServiceCost, error) {
-	input := &costexplorer.GetCostAndUsageInput{
+// getExcludedCosts retorna o valor de cada tipo de registro excluído no período, para que o total
+// líquido possa ser conciliado com a fatura. Créditos e reembolsos vêm com valor negativo.
+func (r *AWSRepositoryImpl) getExcludedCosts(ctx context.Context, client *costexplorer.Client, start, end time.Time, metric entity.CostMetric, filter *ceTypes.Expression, exclude []entity.RecordType) ([]entity.ServiceCost, error) {
+	result, err := client.GetCostAndUsage(ctx, &costexplorer.GetCostAndUsageInput{
 		TimePeriod: &ceTypes.DateInterval{
 			Start: aws.String(start.Format("2006-01-02")),
 			End:   aws.String(end.Format("2006-01-02")),
 		},
 		Granularity: ceTypes.GranularityMonthly,
-		Metrics:     []string{"UnblendedCost"},
+		Metrics:     []string{string(metric)},
 		GroupBy: []ceTypes.GroupDefinition{
-			{Type: ceTypes.GroupDefinitionTypeDimension, Key: aws.String("SERVICE")},
+			{Type: ceTypes.GroupDefinitionTypeDimension, Key: aws.String(string(ceTypes.DimensionRecordType))},
 		},
-		Filter: filter,
-	}
-
-	result, err := client.GetCostAndUsage(ctx, input)
+		Filter: onlyRecordTypes(filter, exclude),
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	costs := make(map[string]float64)
+	for _, g := range sumGroupsByKeys(result.ResultsByTime, metric) {
+		if len(g.Keys) > 0 {
+			costs[g.Keys[0]] += g.Cost
+		}
+	}
+
+	// Mantém a ordem de --exclude e omite os tipos sem valor no período.
+	var excluded []entity.ServiceCost
+	for _, t := range exclude {
+		if cost := costs[string(t)]; math.Abs(cost) > 0.001 {
+			excluded = append(excluded, entity.ServiceCost{ServiceName: string(t), Cost: cost})
+		}
+	}
+	return excluded, nil
+}
+
+// groupCost é o custo de um grupo do Cost Explorer somado em todos os meses do período.
+type groupCost struct {
+	Keys []string
+	Cost float64
+}
+
+// sumGroupsByKeys soma os grupos com as mesmas chaves nos resultados de cada mês,
+// preservando a ordem em que aparecem.
+func sumGroupsByKeys(results []ceTypes.ResultByTime, metric entity.CostMetric) []groupCost {
+	var groups []groupCost
+	index := make(map[string]int)
+	for _, byTime := range results {
+		for _, group := range byTime.Groups {
+			amount := group.Metrics[string(metric)].Amount
+			if amount == nil {
+				continue
+			}
+			cost, _ := strconv.ParseFloat(*amount, 64)
+			key := strings.Join(group.Keys, "\x00")
+			if i, ok := index[key]; ok {
+				groups[i].Cost += cost
+				continue
+			}
+			index[key] = len(groups)
+			groups = append(groups, groupCost{Keys: group.Keys, Cost: cost})
+		}
+	}
+	return groups
+}
+
+// getCostByGroup retorna o custo do período agrupado por até dois níveis. No segundo
+// nível, os custos ficam em SubCosts do grupo do primeiro nível.
+func (r *AWSRepositoryImpl) getCostByGroup(ctx context.Context, client *costexplorer.Client, start, end time.Time, metric entity.CostMetric, groupBy entity.Grouping, filter *ceTypes.Expression, breakdown bool) ([]entity.ServiceCost, error) {
+	groupDefs := make([]ceTypes.GroupDefinition, 0, len(groupBy))
+	for _, d := range groupBy {
+		groupDefs = append(groupDefs, ceTypes.GroupDefinition{
+			Type: ceTypes.GroupDefinitionType(d.Kind),
+			Key:  aws.String(d.Key),
+		})
+	}
+
+	input := &costexplorer.GetCostAndUsageInput{
+		TimePeriod: &ceTypes.DateInterval{
+			Start: aws.String(start.Format("2006-01-02")),
+			End:   aws.String(end.Format("2006-01-02")),
+		},
+		Granularity: ceTypes.GranularityMonthly,
+		Metrics:     []string{string(metric)},
+		GroupBy:     groupDefs,
+		Filter:      filter,
+	}
+
+	var groups []ceTypes.Group
+	for {
+		result, err := client.GetCostAndUsage(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		// Períodos que cruzam meses retornam um resultado por mês; os grupos são somados abaixo.
+		for _, byTime := range result.ResultsByTime {
+			groups = append(groups, byTime.Groups...)
+		}
+		if result.NextPageToken == nil {
+			break
+		}
+		input.NextPageToken = result.NextPageToken
+	}
+
+	servicesToBreakdown := map[string]bool{
+		"EC2-Other":                    true,
+		"Amazon API Gateway":           true,
+		"Amazon Virtual Private Cloud": true,
+	}
+
 	var serviceCosts []entity.ServiceCost
-	if len(result.ResultsByTime) > 0 {
-		for _, group := range result.ResultsByTime[0].Groups {
-			cost, _ := strconv.ParseFloat(*group.Metrics["UnblendedCost"].Amount, 64)
-			if cost > 0.001 {
-				sc := entity.ServiceCost{
-					ServiceName: group.Keys[0],
-					Cost:        cost,
-				}
+	index := make(map[string]int)
+	subIndex := make(map[string]map[string]int)
+	for _, group := range groups {
+		amount := group.Metrics[string(metric)].Amount
+		if amount == nil || len(group.Keys) < len(groupBy) {
+			continue
+		}
+		cost, _ := strconv.ParseFloat(*amount, 64)
+
+		name := groupKeyValue(groupBy[0], group.Keys[0])
+		i, ok := index[name]
+		if !ok {
+			i = len(serviceCosts)
+			index[name] = i
+			serviceCosts = append(serviceCosts, entity.ServiceCost{ServiceName: name})
+		}
+		serviceCosts[i].Cost += cost
 
-				servicesToBreakdown := map[string]bool{
-					"EC2-Other":                    true,
-					"Amazon API Gateway":           true,
-					"Amazon Virtual Private Cloud": true,
-				}
+		if len(groupBy) > 1 {
+			subName := groupKeyValue(groupBy[1], group.Keys[1])
+			if subIndex[name] == nil {
+				subIndex[name] = make(map[string]int)
+			}
+			j, ok := subIndex[name][subName]
+			if !ok {
+				j = len(serviceCosts[i].SubCosts)
+				subIndex[name][subName] = j
+				serviceCosts[i].SubCosts = append(serviceCosts[i].SubCosts, entity.ServiceCost{ServiceName: subName})
+			}
+			serviceCosts[i].SubCosts[j].Cost += cost
+		}
+	}
 
-				if breakdown && servicesToBreakdown[sc.ServiceName] {
-					breakdownCosts, err := r.getCostBreakdownForService(ctx, client, start, end, filter, sc.ServiceName)
-					if err == nil {
-						sc.SubCosts = breakdownCosts
-					}
-				}
+	// Contas-membro com créditos ou reembolsos podem ter custo líquido negativo e continuam no resultado.
+	keepNegative := groupBy[0] == entity.LinkedAccountDimension()
+
+	filtered := serviceCosts[:0]
+	for _, sc := range serviceCosts {
+		if math.Abs(sc.Cost) <= 0.001 || (sc.Cost < 0 && !keepNegative) {
+			continue
+		}
+		subs := sc.SubCosts[:0]
+		for _, sub := range sc.SubCosts {
+			if sub.Cost > 0.001 {
+				subs = append(subs, sub)
+			}
+		}
+		sc.SubCosts = subs
+		sort.Slice(sc.SubCosts, func(i, j int) bool { return sc.SubCosts[i].Cost > sc.SubCosts[j].Cost })
 
-				serviceCosts = append(serviceCosts, sc)
+		if breakdown && servicesToBreakdown[sc.ServiceName] {
+			breakdownCosts, err := r.getCostBreakdownForService(ctx, client, start, end, metric, filter, sc.ServiceName)
+			if err == nil {
+				sc.SubCosts = breakdownCosts
 			}
 		}
+		filtered = append(filtered, sc)
 	}
+	serviceCosts = filtered
 
 	sort.Slice(serviceCosts, func(i, j int) bool {
 		return serviceCosts[i].Cost > serviceCosts[j].Cost
@@ -422,6 +624,27 @@ func (r *AWSRepositoryImpl) getCostByService(ctx context.Context, client *costex
 	return serviceCosts, nil
 }
 
+// groupKeyValue converte a chave de um grupo do Cost Explorer em um nome de exibição.
+// Tags e cost categories vêm no formato "chave$valor"; um valor vazio indica recursos sem a tag.
+func groupKeyValue(d entity.GroupDimension, key string) string {
+	switch d.Kind {
+	case entity.GroupKindTag, entity.GroupKindCostCategory:
+		if _, value, ok := strings.Cut(key, "$"); ok {
+			key = value
+		}
+		if key == "" {
+			if d.Kind == entity.GroupKindTag {
+				return fmt.Sprintf("(no %s tag)", d.Key)
+			}
+			return "(uncategorized)"
+		}
+	}
+	if key == "" {
+		return "(none)"
+	}
+	return key
+}
+
 func (r *AWSRepositoryImpl) GetUnusedVpcEndpoints(ctx context.Context, profile string, regions []string) (entity.UnusedVpcEndpoints, error) {
 	unusedEndpoints := make(entity.UnusedVpcEndpoints)
 	var wg sync.WaitGroup
@@ -448,12 +671,16 @@ func (r *AWSRepositoryImpl) GetUnusedVpcEndpoints(ctx context.Context, profile s
 				return
 			}
 
-			var regionUnusedEndpoints []string
+			var regionUnusedEndpoints []entity.VpcEndpoint
 			for _, ep := range endpoints.VpcEndpoints {
 				// Um Interface Endpoint funcional deve ter pelo menos uma Network Interface.
 				// Se a lista de IDs de Network Interface estiver vazia, o endpoint não está servindo tráfego.
 				if len(ep.NetworkInterfaceIds) == 0 {
-					regionUnusedEndpoints = append(regionUnusedEndpoints, *ep.VpcEndpointId)
+					regionUnusedEndpoints = append(regionUnusedEndpoints, entity.VpcEndpoint{
+						ID:          aws.ToString(ep.VpcEndpointId),
+						ServiceName: aws.ToString(ep.ServiceName),
+						Tags:        ec2TagMap(ep.Tags),
+					})
 				}
 			}
 
@@ -469,7 +696,7 @@ func (r *AWSRepositoryImpl) GetUnusedVpcEndpoints(ctx context.Context, profile s
 	return unusedEndpoints, nil
 }
 
-func (r *AWSRepositoryImpl) getCostBreakdownForService(ctx context.Context, client *costexplorer.Client, start, end time.Time, filter *ceTypes.Expression, serviceName string) ([]entity.ServiceCost, error) {
+func (r *AWSRepositoryImpl) getCostBreakdownForService(ctx context.Context, client *costexplorer.Client, start, end time.Time, metric entity.CostMetric, filter *ceTypes.Expression, serviceName string) ([]entity.ServiceCost, error) {
 	serviceFilter := &ceTypes.Expression{
 		Dimensions: &ceTypes.DimensionValues{
 			Key:    "SERVICE",
@@ -490,7 +717,7 @@ func (r *AWSRepositoryImpl) getCostBreakdownForService(ctx context.Context, clie
 			End:   aws.String(end.Format("2006-01-02")),
 		},
 		Granularity: ceTypes.GranularityMonthly,
-		Metrics:     []string{"UnblendedCost"},
+		Metrics:     []string{string(metric)},
 		GroupBy: []ceTypes.GroupDefinition{
 			{Type: ceTypes.GroupDefinitionTypeDimension, Key: aws.String("USAGE_TYPE")},
 		},
@@ -503,24 +730,22 @@ func (r *AWSRepositoryImpl) getCostBreakdownForService(ctx context.Context, clie
 	}
 
 	var breakdownCosts []entity.ServiceCost
-	if len(result.ResultsByTime) > 0 {
-		for _, group := range result.ResultsByTime[0].Groups {
-			cost, _ := strconv.ParseFloat(*group.Metrics["UnblendedCost"].Amount, 64)
-			if cost > 0.001 {
-				usageType := group.Keys[0]
-				parts := strings.Split(usageType, "-")
-				if len(parts) > 1 {
-					// Remove o prefixo da região (ex: USE2-DataTransfer-Out-Bytes -> DataTransfer-Out-Bytes)
-					if len(parts[0]) == 4 && (strings.HasPrefix(parts[0], "U") || strings.HasPrefix(parts[0], "E") || strings.HasPrefix(parts[0], "AP")) {
-						usageType = strings.Join(parts[1:], "-")
-					}
+	for _, group := range sumGroupsByKeys(result.ResultsByTime, metric) {
+		cost := group.Cost
+		if cost > 0.001 {
+			usageType := group.Keys[0]
+			parts := strings.Split(usageType, "-")
+			if len(parts) > 1 {
+				// Remove o prefixo da região (ex: USE2-DataTransfer-Out-Bytes -> DataTransfer-Out-Bytes)
+				if len(parts[0]) == 4 && (strings.HasPrefix(parts[0], "U") || strings.HasPrefix(parts[0], "E") || strings.HasPrefix(parts[0], "AP")) {
+					usageType = strings.Join(parts[1:], "-")
 				}
-
-				breakdownCosts = append(breakdownCosts, entity.ServiceCost{
-					ServiceName: usageType,
-					Cost:        cost,
-				})
 			}
+
+			breakdownCosts = append(breakdownCosts, entity.ServiceCost{
+				ServiceName: usageType,
+				Cost:        cost,
+			})
 		}
 	}
 
@@ -568,82 +793,8 @@ func (r *AWSRepositoryImpl) GetBudgets(ctx context.Context, profile string) ([]e
 	return budgetsData, nil
 }
 
-func parseTagFilter(tags []string) (*ceTypes.Expression, error) {
-	if len(tags) == 0 {
-		return nil, nil
-	}
-
-	var expressions []ceTypes.Expression
-	for _, t := range tags {
-		parts := strings.SplitN(t, "=", 2)
-		if len(parts) != 2 {
-			return nil, fmt.Errorf("invalid tag format: %s", t)
-		}
-		expressions = append(expressions, ceTypes.Expression{
-			Tags: &ceTypes.TagValues{
-				Key:    aws.String(parts[0]),
-				Values: []string{parts[1]},
-			},
-		})
-	}
-
-	if len(expressions) == 1 {
-		return &expressions[0], nil
-	}
-
-	return &ceTypes.Expression{And: expressions}, nil
-}
-
-func (r *AWSRepositoryImpl) GetTrendData(ctx context.Context, profile string, tags []string) (map[string]interface{}, error) {
-	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
-	if err != nil {
-		return nil, err
-	}
-	ceClient := client.(*costexplorer.Client)
-
-	accountID, _ := r.GetAccountID(ctx, profile)
-
-	today := time.Now().UTC()
-	endDate := today
-	startDate := today.AddDate(0, -6, 0)
-	startDate = time.Date(startDate.Year(), startDate.Month(), 1, 0, 0, 0, 0, time.UTC)
-
-	filter, err := parseTagFilter(tags)
-	if err != nil {
-		return nil, err
-	}
-
-	input := &costexplorer.GetCostAndUsageInput{
-		TimePeriod: &ceTypes.DateInterval{
-			Start: aws.String(startDate.Format("2006-01-02")),
-			End:   aws.String(endDate.Format("2006-01-02")),
-		},
-		Granularity: ceTypes.GranularityMonthly,
-		Metrics:     []string{"UnblendedCost"},
-		Filter:      filter,
-	}
-
-	result, err := ceClient.GetCostAndUsage(ctx, input)
-	if err != nil {
-		return nil, err
-	}
-
-	monthlyCosts := []entity.MonthlyCost{}
-	for _, period := range result.ResultsByTime {
-		month, _ := time.Parse("2006-01-02", *period.TimePeriod.Start)
-		cost, _ := strconv.ParseFloat(*period.Total["UnblendedCost"].Amount, 64)
-		monthlyCosts = append(monthlyCosts, entity.MonthlyCost{
-			Month: month.Format("Jan 2006"),
-			Cost:  cost,
-		})
-	}
-
-	return map[string]interface{}{
-		"monthly_costs": monthlyCosts,
-		"account_id":    accountID,
-	}, nil
-}
-
+// GetStoppedInstances retorna as instâncias paradas de cada região com os volumes EBS ainda anexados,
+// que continuam sendo cobrados.
 func (r *AWSRepositoryImpl) GetStoppedInstances(ctx context.Context, profile string, regions []string) (entity.StoppedEC2Instances, error) {
 	stopped := make(entity.StoppedEC2Instances)
 	var wg sync.WaitGroup
@@ -659,24 +810,55 @@ func (r *AWSRepositoryImpl) GetStoppedInstances(ctx context.Context, profile str
 			}
 			ec2Client := client.(*ec2.Client)
 
-			result, err := ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+			var instances []entity.StoppedInstance
+			paginator := ec2.NewDescribeInstancesPaginator(ec2Client, &ec2.DescribeInstancesInput{
 				Filters: []ec2Types.Filter{{Name: aws.String("instance-state-name"), Values: []string{"stopped"}}},
 			})
-			if err != nil {
+			for paginator.HasMorePages() {
+				page, err := paginator.NextPage(ctx)
+				if err != nil {
+					return
+				}
+				for _, res := range page.Reservations {
+					for _, inst := range res.Instances {
+						instances = append(instances, entity.StoppedInstance{
+							ID:           aws.ToString(inst.InstanceId),
+							InstanceType: string(inst.InstanceType),
+							Tags:         ec2TagMap(inst.Tags),
+						})
+					}
+				}
+			}
+			if len(instances) == 0 {
 				return
 			}
 
-			var instanceIDs []string
-			for _, res := range result.Reservations {
-				for _, inst := range res.Instances {
-					instanceIDs = append(instanceIDs, *inst.InstanceId)
+			// Volumes anexados, buscados em lotes (o filtro aceita até 200 valores).
+			byInstance := make(map[string][]entity.EBSVolume)
+			const batchSize = 200
+			for i := 0; i < len(instances); i += batchSize {
+				ids := make([]string, 0, batchSize)
+				for _, inst := range instances[i:min(i+batchSize, len(instances))] {
+					ids = append(ids, inst.ID)
+				}
+				volumes, err := describeVolumes(ctx, ec2Client, ec2Types.Filter{Name: aws.String("attachment.instance-id"), Values: ids})
+				if err != nil {
+					break
+				}
+				for _, vol := range volumes {
+					for _, att := range vol.Attachments {
+						id := aws.ToString(att.InstanceId)
+						byInstance[id] = append(byInstance[id], toEBSVolume(vol))
+					}
 				}
 			}
-			if len(instanceIDs) > 0 {
-				mu.Lock()
-				stopped[rgn] = instanceIDs
-				mu.Unlock()
+			for i := range instances {
+				instances[i].Volumes = byInstance[instances[i].ID]
 			}
+
+			mu.Lock()
+			stopped[rgn] = instances
+			mu.Unlock()
 		}(region)
 	}
 	wg.Wait()
@@ -698,20 +880,18 @@ func (r *AWSRepositoryImpl) GetUnusedVolumes(ctx context.Context, profile string
 			}
 			ec2Client := client.(*ec2.Client)
 
-			result, err := ec2Client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
-				Filters: []ec2Types.Filter{{Name: aws.String("status"), Values: []string{"available"}}},
-			})
+			result, err := describeVolumes(ctx, ec2Client, ec2Types.Filter{Name: aws.String("status"), Values: []string{"available"}})
 			if err != nil {
 				return
 			}
 
-			var volIDs []string
-			for _, vol := range result.Volumes {
-				volIDs = append(volIDs, *vol.VolumeId)
+			var volumes []entity.EBSVolume
+			for _, vol := range result {
+				volumes = append(volumes, toEBSVolume(vol))
 			}
-			if len(volIDs) > 0 {
+			if len(volumes) > 0 {
 				mu.Lock()
-				unused[rgn] = volIDs
+				unused[rgn] = volumes
 				mu.Unlock()
 			}
 		}(region)
@@ -720,6 +900,43 @@ func (r *AWSRepositoryImpl) GetUnusedVolumes(ctx context.Context, profile string
 	return unused, nil
 }
 
+// describeVolumes lista todos os volumes EBS que atendem ao filtro, percorrendo as páginas.
+func describeVolumes(ctx context.Context, client *ec2.Client, filter ec2Types.Filter) ([]ec2Types.Volume, error) {
+	var volumes []ec2Types.Volume
+	paginator := ec2.NewDescribeVolumesPaginator(client, &ec2.DescribeVolumesInput{Filters: []ec2Types.Filter{filter}})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		volumes = append(volumes, page.Volumes...)
+	}
+	return volumes, nil
+}
+
+func toEBSVolume(vol ec2Types.Volume) entity.EBSVolume {
+	return entity.EBSVolume{
+		ID:              aws.ToString(vol.VolumeId),
+		Type:            string(vol.VolumeType),
+		SizeGiB:         aws.ToInt32(vol.Size),
+		IOPS:            aws.ToInt32(vol.Iops),
+		ThroughputMiBps: aws.ToInt32(vol.Throughput),
+		Tags:            ec2TagMap(vol.Tags),
+	}
+}
+
+// ec2TagMap converte as tags do EC2 em um mapa chave/valor; nil quando não há tags.
+func ec2TagMap(tags []ec2Types.Tag) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	return m
+}
+
 func (r *AWSRepositoryImpl) GetUnusedEIPs(ctx context.Context, profile string, regions []string) (entity.UnusedEIPs, error) {
 	eips := make(entity.UnusedEIPs)
 	var wg sync.WaitGroup
@@ -740,10 +957,14 @@ func (r *AWSRepositoryImpl) GetUnusedEIPs(ctx context.Context, profile string, r
 				return
 			}
 
-			var freeIPs []string
+			var freeIPs []entity.ElasticIP
 			for _, addr := range result.Addresses {
 				if addr.AssociationId == nil {
-					freeIPs = append(freeIPs, *addr.PublicIp)
+					freeIPs = append(freeIPs, entity.ElasticIP{
+						PublicIP:     aws.ToString(addr.PublicIp),
+						AllocationID: aws.ToString(addr.AllocationId),
+						Tags:         ec2TagMap(addr.Tags),
+					})
 				}
 			}
 			if len(freeIPs) > 0 {
@@ -758,23 +979,14 @@ func (r *AWSRepositoryImpl) GetUnusedEIPs(ctx context.Context, profile string, r
 }
 
 // GetNatGatewayCost retorna o custo de processamento de dados para cada NAT Gateway.
-func (r *AWSRepositoryImpl) GetNatGatewayCost(ctx context.Context, profile string, timeRange *int, tags []string) ([]entity.NatGatewayCost, error) {
+func (r *AWSRepositoryImpl) GetNatGatewayCost(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, tags []string) ([]entity.NatGatewayCost, error) {
 	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
 	if err != nil {
 		return nil, err
 	}
 	ceClient := client.(*costexplorer.Client)
 
-	// Define o período de tempo (usa a mesma lógica do GetCostData)
-	today := time.Now().UTC()
-	var startDate, endDate time.Time
-	if timeRange != nil && *timeRange > 0 {
-		endDate = today
-		startDate = today.AddDate(0, 0, -(*timeRange))
-	} else {
-		startDate = time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
-		endDate = today
-	}
+	metric = metric.OrDefault()
 
 	// Filtro para pegar apenas custos de processamento de NAT Gateway
 	usageTypeFilter := &ceTypes.Expression{
@@ -786,7 +998,7 @@ func (r *AWSRepositoryImpl) GetNatGatewayCost(ctx context.Context, profile strin
 	}
 
 	finalFilter := usageTypeFilter
-	tagFilter, err := parseTagFilter(tags)
+	tagFilter, err := parseCostFilter(tags)
 	if err == nil && tagFilter != nil {
 		finalFilter = &ceTypes.Expression{
 			And: []ceTypes.Expression{*tagFilter, *usageTypeFilter},
@@ -795,11 +1007,11 @@ func (r *AWSRepositoryImpl) GetNatGatewayCost(ctx context.Context, profile strin
 
 	input := &costexplorer.GetCostAndUsageInput{
 		TimePeriod: &ceTypes.DateInterval{
-			Start: aws.String(startDate.Format("2006-01-02")),
-			End:   aws.String(endDate.Format("2006-01-02")),
+			Start: aws.String(period.StartDate()),
+			End:   aws.String(period.EndDate()),
 		},
 		Granularity: ceTypes.GranularityMonthly,
-		Metrics:     []string{"UnblendedCost"},
+		Metrics:     []string{string(metric)},
 		Filter:      finalFilter,
 		GroupBy: []ceTypes.GroupDefinition{
 			{Type: ceTypes.GroupDefinitionTypeDimension, Key: aws.String("RESOURCE_ID")},
@@ -813,20 +1025,18 @@ func (r *AWSRepositoryImpl) GetNatGatewayCost(ctx context.Context, profile strin
 	}
 
 	var natCosts []entity.NatGatewayCost
-	if len(result.ResultsByTime) > 0 {
-		for _, group := range result.ResultsByTime[0].Groups {
-			cost, _ := strconv.ParseFloat(*group.Metrics["UnblendedCost"].Amount, 64)
-			// Ignora NAT Gateways sem custo significativo
-			if cost > 0.1 { // Limiar de $0.10 para ser relevante
-				resourceID := group.Keys[0]
-				region := group.Keys[1]
-
-				natCosts = append(natCosts, entity.NatGatewayCost{
-					ResourceID: resourceID,
-					Cost:       cost,
-					Region:     region,
-				})
-			}
+	for _, group := range sumGroupsByKeys(result.ResultsByTime, metric) {
+		cost := group.Cost
+		// Ignora NAT Gateways sem custo significativo
+		if cost > 0.1 { // Limiar de $0.10 para ser relevante
+			resourceID := group.Keys[0]
+			region := group.Keys[1]
+
+			natCosts = append(natCosts, entity.NatGatewayCost{
+				ResourceID: resourceID,
+				Cost:       cost,
+				Region:     region,
+			})
 		}
 	}
 
@@ -942,11 +1152,11 @@ func (r *AWSRepositoryImpl) GetIdleLoadBalancers(ctx context.Context, profile st
 				return
 			}
 
-			var regionIdleLBs []string
+			var regionIdleLBs []entity.LoadBalancer
 
 			for _, lb := range lbsOutput.LoadBalancers {
 				lbArn := *lb.LoadBalancerArn
-				lbName := *lb.LoadBalancerName
+				idleLB := entity.LoadBalancer{Name: aws.ToString(lb.LoadBalancerName), ARN: lbArn, Type: string(lb.Type)}
 
 				// 2. Encontrar os Target Groups associados a este LB
 				tgOutput, err := elbv2Client.DescribeTargetGroups(ctx, &elasticloadbalancingv2.DescribeTargetGroupsInput{
@@ -954,7 +1164,7 @@ func (r *AWSRepositoryImpl) GetIdleLoadBalancers(ctx context.Context, profile st
 				})
 				if err != nil || len(tgOutput.TargetGroups) == 0 {
 					// Se não tem target groups, é ocioso por definição.
-					regionIdleLBs = append(regionIdleLBs, lbName)
+					regionIdleLBs = append(regionIdleLBs, idleLB)
 					continue
 				}
 
@@ -978,11 +1188,12 @@ func (r *AWSRepositoryImpl) GetIdleLoadBalancers(ctx context.Context, profile st
 				}
 
 				if isCompletelyIdle {
-					regionIdleLBs = append(regionIdleLBs, lbName)
+					regionIdleLBs = append(regionIdleLBs, idleLB)
 				}
 			}
 
 			if len(regionIdleLBs) > 0 {
+				addLoadBalancerTags(ctx, elbv2Client, regionIdleLBs)
 				mu.Lock()
 				idleLBs[rgn] = regionIdleLBs
 				mu.Unlock()
@@ -994,35 +1205,52 @@ func (r *AWSRepositoryImpl) GetIdleLoadBalancers(ctx context.Context, profile st
 	return idleLBs, nil
 }
 
+// addLoadBalancerTags preenche as tags dos load balancers, em lotes de 20 ARNs (limite do DescribeTags).
+// Falhas deixam os load balancers sem tags.
+func addLoadBalancerTags(ctx context.Context, client *elasticloadbalancingv2.Client, lbs []entity.LoadBalancer) {
+	const batchSize = 20
+	for i := 0; i < len(lbs); i += batchSize {
+		batch := lbs[i:min(i+batchSize, len(lbs))]
+		arns := make([]string, 0, len(batch))
+		for _, lb := range batch {
+			arns = append(arns, lb.ARN)
+		}
+		out, err := client.DescribeTags(ctx, &elasticloadbalancingv2.DescribeTagsInput{ResourceArns: arns})
+		if err != nil {
+			return
+		}
+		byARN := make(map[string]map[string]string, len(out.TagDescriptions))
+		for _, d := range out.TagDescriptions {
+			if len(d.Tags) == 0 {
+				continue
+			}
+			tags := make(map[string]string, len(d.Tags))
+			for _, t := range d.Tags {
+				tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+			}
+			byARN[aws.ToString(d.ResourceArn)] = tags
+		}
+		for j := range batch {
+			batch[j].Tags = byARN[batch[j].ARN]
+		}
+	}
+}
+
 // GetDataTransferBreakdown retorna um relatório detalhado de custos de Data Transfer.
 // Ele agrega por categorias (Internet, Inter-Region, Cross-AZ/Regional, NAT Gateway, Other)
 // e também retorna as Top Lines por (Service, UsageType).
-func (r *AWSRepositoryImpl) GetDataTransferBreakdown(ctx context.Context, profile string, timeRange *int, tags []string) (entity.DataTransferReport, error) {
+func (r *AWSRepositoryImpl) GetDataTransferBreakdown(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, tags []string) (entity.DataTransferReport, error) {
 	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
 	if err != nil {
 		return entity.DataTransferReport{}, err
 	}
 	ceClient := client.(*costexplorer.Client)
 
-	// Define período (mesma lógica de GetCostData)
-	today := time.Now().UTC()
-	var startDate, endDate time.Time
-	periodName := "Current month's data transfer"
-
-	if timeRange != nil && *timeRange > 0 {
-		endDate = today
-		startDate = today.AddDate(0, 0, -(*timeRange))
-		periodName = fmt.Sprintf("Current %d days data transfer", *timeRange)
-	} else {
-		startDate = time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
-		endDate = today
-		// ajuste para incluir hoje quando estamos no primeiro dia, mesma abordagem do GetCostData
-		if startDate.Day() == endDate.Day() && startDate.Month() == endDate.Month() && startDate.Year() == endDate.Year() {
-			endDate = endDate.AddDate(0, 0, 1)
-		}
-	}
+	startDate, endDate := period.Start, period.End
+	periodName := period.Name("data transfer")
+	metric = metric.OrDefault()
 
-	filter, err := parseTagFilter(tags)
+	filter, err := parseCostFilter(tags)
 	if err != nil {
 		return entity.DataTransferReport{}, err
 	}
@@ -1033,7 +1261,7 @@ func (r *AWSRepositoryImpl) GetDataTransferBreakdown(ctx context.Context, profil
 			End:   aws.String(endDate.Format("2006-01-02")),
 		},
 		Granularity: ceTypes.GranularityMonthly,
-		Metrics:     []string{"UnblendedCost"},
+		Metrics:     []string{string(metric)},
 		GroupBy: []ceTypes.GroupDefinition{
 			{Type: ceTypes.GroupDefinitionTypeDimension, Key: aws.String("SERVICE")},
 			{Type: ceTypes.GroupDefinitionTypeDimension, Key: aws.String("USAGE_TYPE")},
@@ -1056,37 +1284,31 @@ func (r *AWSRepositoryImpl) GetDataTransferBreakdown(ctx context.Context, profil
 	var total float64
 	var lines []entity.DataTransferLine
 
-	if len(result.ResultsByTime) > 0 {
-		for _, group := range result.ResultsByTime[0].Groups {
-			if len(group.Keys) < 2 {
-				continue
-			}
-			service := group.Keys[0]
-			usage := group.Keys[1]
-			amountStr := group.Metrics["UnblendedCost"].Amount
-			if amountStr == nil {
-				continue
-			}
-			cost, _ := strconv.ParseFloat(*amountStr, 64)
-			if cost < 0.001 {
-				continue
-			}
-
-			// Classifica a linha
-			category, relevant := classifyUsageType(usage)
-			if !relevant {
-				// Ignora completamente itens irrelevantes ao tema "transfer"
-				continue
-			}
+	for _, group := range sumGroupsByKeys(result.ResultsByTime, metric) {
+		if len(group.Keys) < 2 {
+			continue
+		}
+		service := group.Keys[0]
+		usage := group.Keys[1]
+		cost := group.Cost
+		if cost < 0.001 {
+			continue
+		}
 
-			categoryTotals[category] += cost
-			total += cost
-			lines = append(lines, entity.DataTransferLine{
-				Service:   service,
-				UsageType: usage,
-				Cost:      cost,
-			})
+		// Classifica a linha
+		category, relevant := classifyUsageType(usage)
+		if !relevant {
+			// Ignora completamente itens irrelevantes ao tema "transfer"
+			continue
 		}
+
+		categoryTotals[category] += cost
+		total += cost
+		lines = append(lines, entity.DataTransferLine{
+			Service:   service,
+			UsageType: usage,
+			Cost:      cost,
+		})
 	}
 
 	// Ordena top lines por custo desc e limita (ex.: 10)
@@ -1120,6 +1342,7 @@ func (r *AWSRepositoryImpl) GetDataTransferBreakdown(ctx context.Context, profil
 		PeriodStart: startDate,
 		PeriodEnd:   endDate,
 		PeriodName:  periodName,
+		Metric:      metric,
 	}, nil
 }
 
@@ -1429,28 +1652,15 @@ func (r *AWSRepositoryImpl) inspectBucketLifecycle(ctx context.Context, profile
 
 // GetSavingsPlansSummary consulta cobertura (por serviço) e utilização (total) de Savings Plans.
 // Em caso de DataUnavailableException, retorna dados zerados e a flag DataUnavailable = true.
-func (r *AWSRepositoryImpl) GetSavingsPlansSummary(ctx context.Context, profile string, timeRange *int, tags []string) (entity.SPSummary, error) {
+func (r *AWSRepositoryImpl) GetSavingsPlansSummary(ctx context.Context, profile string, period entity.Period, tags []string) (entity.SPSummary, error) {
 	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
 	if err != nil {
 		return entity.SPSummary{}, err
 	}
 	ceClient := client.(*costexplorer.Client)
 
-	// Período
-	today := time.Now().UTC()
-	var startDate, endDate time.Time
-	periodName := "Current month's SP"
-	if timeRange != nil && *timeRange > 0 {
-		endDate = today
-		startDate = today.AddDate(0, 0, -(*timeRange))
-		periodName = fmt.Sprintf("Current %d days SP", *timeRange)
-	} else {
-		startDate = time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
-		endDate = today
-		if startDate.Day() == endDate.Day() && startDate.Month() == endDate.Month() && startDate.Year() == endDate.Year() {
-			endDate = endDate.AddDate(0, 0, 1)
-		}
-	}
+	startDate, endDate := period.Start, period.End
+	periodName := period.Name("SP")
 
 	// Relatório base com DataUnavailable
 	accountID, _ := r.GetAccountID(ctx, profile)
@@ -1462,7 +1672,7 @@ func (r *AWSRepositoryImpl) GetSavingsPlansSummary(ctx context.Context, profile
 		DataUnavailable: false,
 	}
 
-	filter, _ := parseTagFilter(tags)
+	filter, _ := parseCostFilter(tags)
 
 	// 1) Cobertura por serviço
 	coverageInput := &costexplorer.GetSavingsPlansCoverageInput{
@@ -1601,28 +1811,15 @@ func (r *AWSRepositoryImpl) GetSavingsPlansSummary(ctx context.Context, profile
 
 // GetReservationSummary consulta cobertura (por família de instância) e utilização (total) de Reserved Instances.
 // Em caso de ValidationException ou DataUnavailableException, retorna dados zerados e a flag DataUnavailable = true.
-func (r *AWSRepositoryImpl) GetReservationSummary(ctx context.Context, profile string, timeRange *int, tags []string) (entity.RISummary, error) {
+func (r *AWSRepositoryImpl) GetReservationSummary(ctx context.Context, profile string, period entity.Period, tags []string) (entity.RISummary, error) {
 	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
 	if err != nil {
 		return entity.RISummary{}, err
 	}
 	ceClient := client.(*costexplorer.Client)
 
-	// Período
-	today := time.Now().UTC()
-	var startDate, endDate time.Time
-	periodName := "Current month's RI"
-	if timeRange != nil && *timeRange > 0 {
-		endDate = today
-		startDate = today.AddDate(0, 0, -(*timeRange))
-		periodName = fmt.Sprintf("Current %d days RI", *timeRange)
-	} else {
-		startDate = time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
-		endDate = today
-		if startDate.Day() == endDate.Day() && startDate.Month() == endDate.Month() && startDate.Year() == endDate.Year() {
-			endDate = endDate.AddDate(0, 0, 1)
-		}
-	}
+	startDate, endDate := period.Start, period.End
+	periodName := period.Name("RI")
 
 	accountID, _ := r.GetAccountID(ctx, profile)
 	baseSummary := entity.RISummary{
@@ -1633,7 +1830,7 @@ func (r *AWSRepositoryImpl) GetReservationSummary(ctx context.Context, profile s
 		DataUnavailable: false,
 	}
 
-	filter, _ := parseTagFilter(tags)
+	filter, _ := parseCostFilter(tags)
 
 	// 1) Cobertura — tentar por INSTANCE_TYPE_FAMILY (suportado)
 	riCovInput := &costexplorer.GetReservationCoverageInput{
@@ -0,0 +1,337 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	elasticacheTypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	opensearchTypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdsTypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	redshiftTypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
+	spTypes "github.com/aws/aws-sdk-go-v2/service/savingsplans/types"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// GetActiveCommitments retorna os Savings Plans ativos da conta e as reservas ativas de EC2, RDS,
+// ElastiCache, OpenSearch e Redshift nas regiões informadas, com as datas de início e fim. Os Savings Plans vêm da API de Savings
+// Plans, que é global; as reservas, das APIs de cada serviço em cada região.
+// Regiões com erro são ignoradas; só retorna erro quando um serviço falha em todas as regiões.
+func (r *AWSRepositoryImpl) GetActiveCommitments(ctx context.Context, profile string, regions []string) ([]entity.CommitmentExpiration, error) {
+	commitments, err := r.getActiveSavingsPlans(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, svc := range []struct {
+		label string
+		get   func(ctx context.Context, profile, region string) ([]entity.CommitmentExpiration, error)
+	}{
+		{"Amazon EC2", r.getActiveEC2Reservations},
+		{"Amazon RDS", r.getActiveRDSReservations},
+		{"Amazon ElastiCache", r.getActiveElastiCacheReservations},
+		{"Amazon OpenSearch", r.getActiveOpenSearchReservations},
+		{"Amazon Redshift", r.getActiveRedshiftReservations},
+	} {
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var firstErr error
+		failed := 0
+
+		for _, region := range regions {
+			wg.Add(1)
+			go func(rgn string) {
+				defer wg.Done()
+				found, err := svc.get(ctx, profile, rgn)
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					failed++
+					if firstErr == nil {
+						firstErr = fmt.Errorf("%s: %w", rgn, err)
+					}
+					return
+				}
+				commitments = append(commitments, found...)
+			}(region)
+		}
+		wg.Wait()
+
+		if len(regions) > 0 && failed == len(regions) {
+			return nil, fmt.Errorf("failed to list %s reservations: %w", svc.label, firstErr)
+		}
+	}
+
+	return commitments, nil
+}
+
+// getActiveSavingsPlans lista os Savings Plans no estado active.
+func (r *AWSRepositoryImpl) getActiveSavingsPlans(ctx context.Context, profile string) ([]entity.CommitmentExpiration, error) {
+	client, err := r.getServiceClient(ctx, profile, "", "savingsplans")
+	if err != nil {
+		return nil, err
+	}
+	spClient := client.(*savingsplans.Client)
+
+	var commitments []entity.CommitmentExpiration
+	input := &savingsplans.DescribeSavingsPlansInput{States: []spTypes.SavingsPlanState{spTypes.SavingsPlanStateActive}}
+	for {
+		out, err := spClient.DescribeSavingsPlans(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Savings Plans: %w", err)
+		}
+		for _, sp := range out.SavingsPlans {
+			commitments = append(commitments, toSavingsPlanExpiration(sp))
+		}
+		if aws.ToString(out.NextToken) == "" {
+			return commitments, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// getActiveEC2Reservations lista as reservas de EC2 no estado active de uma região.
+func (r *AWSRepositoryImpl) getActiveEC2Reservations(ctx context.Context, profile, region string) ([]entity.CommitmentExpiration, error) {
+	client, err := r.getServiceClient(ctx, profile, region, "ec2")
+	if err != nil {
+		return nil, err
+	}
+	out, err := client.(*ec2.Client).DescribeReservedInstances(ctx, &ec2.DescribeReservedInstancesInput{
+		Filters: []ec2Types.Filter{{Name: aws.String("state"), Values: []string{string(ec2Types.ReservedInstanceStateActive)}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	commitments := make([]entity.CommitmentExpiration, 0, len(out.ReservedInstances))
+	for _, ri := range out.ReservedInstances {
+		commitments = append(commitments, toEC2ReservationExpiration(ri, region))
+	}
+	return commitments, nil
+}
+
+// getActiveRDSReservations lista as reservas de RDS no estado active de uma região. A API não filtra
+// pelo estado, então o filtro é feito aqui.
+func (r *AWSRepositoryImpl) getActiveRDSReservations(ctx context.Context, profile, region string) ([]entity.CommitmentExpiration, error) {
+	client, err := r.getServiceClient(ctx, profile, region, "rds")
+	if err != nil {
+		return nil, err
+	}
+	var commitments []entity.CommitmentExpiration
+	paginator := rds.NewDescribeReservedDBInstancesPaginator(client.(*rds.Client), &rds.DescribeReservedDBInstancesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, ri := range page.ReservedDBInstances {
+			if aws.ToString(ri.State) == "active" {
+				commitments = append(commitments, toRDSReservationExpiration(ri, region))
+			}
+		}
+	}
+	return commitments, nil
+}
+
+// getActiveElastiCacheReservations lista os nós reservados de ElastiCache no estado active de uma região.
+func (r *AWSRepositoryImpl) getActiveElastiCacheReservations(ctx context.Context, profile, region string) ([]entity.CommitmentExpiration, error) {
+	client, err := r.getServiceClient(ctx, profile, region, "elasticache")
+	if err != nil {
+		return nil, err
+	}
+	var commitments []entity.CommitmentExpiration
+	paginator := elasticache.NewDescribeReservedCacheNodesPaginator(client.(*elasticache.Client), &elasticache.DescribeReservedCacheNodesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, ri := range page.ReservedCacheNodes {
+			if aws.ToString(ri.State) == "active" {
+				commitments = append(commitments, toElastiCacheReservationExpiration(ri, region))
+			}
+		}
+	}
+	return commitments, nil
+}
+
+// getActiveOpenSearchReservations lista as instâncias reservadas de OpenSearch no estado active de uma região.
+func (r *AWSRepositoryImpl) getActiveOpenSearchReservations(ctx context.Context, profile, region string) ([]entity.CommitmentExpiration, error) {
+	client, err := r.getServiceClient(ctx, profile, region, "opensearch")
+	if err != nil {
+		return nil, err
+	}
+	var commitments []entity.CommitmentExpiration
+	paginator := opensearch.NewDescribeReservedInstancesPaginator(client.(*opensearch.Client), &opensearch.DescribeReservedInstancesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, ri := range page.ReservedInstances {
+			if strings.EqualFold(aws.ToString(ri.State), "active") {
+				commitments = append(commitments, toOpenSearchReservationExpiration(ri, region))
+			}
+		}
+	}
+	return commitments, nil
+}
+
+// getActiveRedshiftReservations lista os nós reservados de Redshift no estado active de uma região.
+func (r *AWSRepositoryImpl) getActiveRedshiftReservations(ctx context.Context, profile, region string) ([]entity.CommitmentExpiration, error) {
+	client, err := r.getServiceClient(ctx, profile, region, "redshift")
+	if err != nil {
+		return nil, err
+	}
+	var commitments []entity.CommitmentExpiration
+	paginator := redshift.NewDescribeReservedNodesPaginator(client.(*redshift.Client), &redshift.DescribeReservedNodesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, ri := range page.ReservedNodes {
+			if aws.ToString(ri.State) == "active" {
+				commitments = append(commitments, toRedshiftReservationExpiration(ri, region))
+			}
+		}
+	}
+	return commitments, nil
+}
+
+func toSavingsPlanExpiration(sp spTypes.SavingsPlan) entity.CommitmentExpiration {
+	return entity.CommitmentExpiration{
+		Kind:             entity.PurchaseSavingsPlans,
+		ID:               aws.ToString(sp.SavingsPlanArn),
+		Type:             savingsPlanTypeLabel(string(sp.SavingsPlanType)),
+		Details:          joinNonEmpty(aws.ToString(sp.Ec2InstanceFamily), aws.ToString(sp.Region), string(sp.PaymentOption), termLabel(sp.TermDurationInSeconds)),
+		HourlyCommitment: parseAmount(sp.Commitment),
+		Start:            parseCommitmentTime(aws.ToString(sp.Start)),
+		End:              parseCommitmentTime(aws.ToString(sp.End)),
+	}
+}
+
+// savingsPlanTypeLabel traduz o tipo da API (ex: "EC2Instance") para o rótulo exibido.
+func savingsPlanTypeLabel(t string) string {
+	switch t {
+	case "":
+		return "Savings Plan"
+	case "EC2Instance":
+		return "EC2 Instance"
+	default:
+		return t
+	}
+}
+
+func toEC2ReservationExpiration(ri ec2Types.ReservedInstances, region string) entity.CommitmentExpiration {
+	return entity.CommitmentExpiration{
+		Kind:     entity.PurchaseReservedInstances,
+		ID:       aws.ToString(ri.ReservedInstancesId),
+		Type:     "Amazon EC2",
+		Details:  joinNonEmpty(string(ri.InstanceType), region, string(ri.ProductDescription), string(ri.OfferingClass), string(ri.OfferingType)),
+		Quantity: int(aws.ToInt32(ri.InstanceCount)),
+		Start:    aws.ToTime(ri.Start),
+		End:      aws.ToTime(ri.End),
+	}
+}
+
+// toRDSReservationExpiration converte uma reserva de RDS; a API não informa o fim, que é o início
+// mais a duração em segundos.
+func toRDSReservationExpiration(ri rdsTypes.ReservedDBInstance, region string) entity.CommitmentExpiration {
+	start := aws.ToTime(ri.StartTime)
+	deployment := ""
+	if aws.ToBool(ri.MultiAZ) {
+		deployment = "Multi-AZ"
+	}
+	return entity.CommitmentExpiration{
+		Kind:     entity.PurchaseReservedInstances,
+		ID:       aws.ToString(ri.ReservedDBInstanceId),
+		Type:     "Amazon RDS",
+		Details:  joinNonEmpty(aws.ToString(ri.DBInstanceClass), region, aws.ToString(ri.ProductDescription), deployment, aws.ToString(ri.OfferingType)),
+		Quantity: int(aws.ToInt32(ri.DBInstanceCount)),
+		Start:    start,
+		End:      start.Add(time.Duration(aws.ToInt32(ri.Duration)) * time.Second),
+	}
+}
+
+// toElastiCacheReservationExpiration converte um nó reservado de ElastiCache; como no RDS (e no OpenSearch
+// e no Redshift), o fim é o início mais a duração em segundos.
+func toElastiCacheReservationExpiration(ri elasticacheTypes.ReservedCacheNode, region string) entity.CommitmentExpiration {
+	start := aws.ToTime(ri.StartTime)
+	return entity.CommitmentExpiration{
+		Kind:     entity.PurchaseReservedInstances,
+		ID:       aws.ToString(ri.ReservedCacheNodeId),
+		Type:     "Amazon ElastiCache",
+		Details:  joinNonEmpty(aws.ToString(ri.CacheNodeType), region, aws.ToString(ri.ProductDescription), aws.ToString(ri.OfferingType)),
+		Quantity: int(aws.ToInt32(ri.CacheNodeCount)),
+		Start:    start,
+		End:      start.Add(time.Duration(aws.ToInt32(ri.Duration)) * time.Second),
+	}
+}
+
+func toOpenSearchReservationExpiration(ri opensearchTypes.ReservedInstance, region string) entity.CommitmentExpiration {
+	start := aws.ToTime(ri.StartTime)
+	return entity.CommitmentExpiration{
+		Kind:     entity.PurchaseReservedInstances,
+		ID:       aws.ToString(ri.ReservedInstanceId),
+		Type:     "Amazon OpenSearch",
+		Details:  joinNonEmpty(string(ri.InstanceType), region, aws.ToString(ri.ReservationName), string(ri.PaymentOption)),
+		Quantity: int(ri.InstanceCount),
+		Start:    start,
+		End:      start.Add(time.Duration(ri.Duration) * time.Second),
+	}
+}
+
+func toRedshiftReservationExpiration(ri redshiftTypes.ReservedNode, region string) entity.CommitmentExpiration {
+	start := aws.ToTime(ri.StartTime)
+	return entity.CommitmentExpiration{
+		Kind:     entity.PurchaseReservedInstances,
+		ID:       aws.ToString(ri.ReservedNodeId),
+		Type:     "Amazon Redshift",
+		Details:  joinNonEmpty(aws.ToString(ri.NodeType), region, aws.ToString(ri.OfferingType)),
+		Quantity: int(aws.ToInt32(ri.NodeCount)),
+		Start:    start,
+		End:      start.Add(time.Duration(aws.ToInt32(ri.Duration)) * time.Second),
+	}
+}
+
+// termLabel descreve a duração de um Savings Plan em anos (ex: "1 year").
+func termLabel(seconds int64) string {
+	const year = 365 * 24 * 60 * 60
+	switch years := seconds / year; {
+	case seconds <= 0:
+		return ""
+	case years == 1:
+		return "1 year"
+	default:
+		return fmt.Sprintf("%d years", years)
+	}
+}
+
+func parseCommitmentTime(s string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func joinNonEmpty(parts ...string) string {
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return strings.Join(out, " | ")
+}
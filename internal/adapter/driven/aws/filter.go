@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ceTypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// parseCostFilter converte as expressões de --tag em um filtro do Cost Explorer. Sem expressões, retorna nil.
+func parseCostFilter(tags []string) (*ceTypes.Expression, error) {
+	f, err := entity.ParseCostFilter(tags)
+	if err != nil || f == nil {
+		return nil, err
+	}
+	expr := costFilterExpression(*f)
+	return &expr, nil
+}
+
+// costFilterExpression compila a árvore do filtro na Expression equivalente do Cost Explorer.
+func costFilterExpression(f entity.CostFilter) ceTypes.Expression {
+	switch {
+	case len(f.And) > 0:
+		return ceTypes.Expression{And: costFilterExpressions(f.And)}
+	case len(f.Or) > 0:
+		return ceTypes.Expression{Or: costFilterExpressions(f.Or)}
+	case f.Not != nil:
+		inner := costFilterExpression(*f.Not)
+		return ceTypes.Expression{Not: &inner}
+	}
+
+	c := f.Condition
+	switch c.Key.Kind {
+	case entity.GroupKindDimension:
+		return ceTypes.Expression{Dimensions: &ceTypes.DimensionValues{Key: ceTypes.Dimension(c.Key.Key), Values: c.Values}}
+	case entity.GroupKindCostCategory:
+		values := &ceTypes.CostCategoryValues{Key: aws.String(c.Key.Key), Values: c.Values}
+		if c.Absent {
+			values.MatchOptions = []ceTypes.MatchOption{ceTypes.MatchOptionAbsent}
+		}
+		return ceTypes.Expression{CostCategories: values}
+	default:
+		values := &ceTypes.TagValues{Key: aws.String(c.Key.Key), Values: c.Values}
+		if c.Absent {
+			values.MatchOptions = []ceTypes.MatchOption{ceTypes.MatchOptionAbsent}
+		}
+		return ceTypes.Expression{Tags: values}
+	}
+}
+
+func costFilterExpressions(filters []entity.CostFilter) []ceTypes.Expression {
+	exprs := make([]ceTypes.Expression, len(filters))
+	for i, f := range filters {
+		exprs[i] = costFilterExpression(f)
+	}
+	return exprs
+}
+
+// excludeRecordTypes adiciona ao filtro a exclusão dos tipos de registro (dimensão RECORD_TYPE).
+func excludeRecordTypes(filter *ceTypes.Expression, exclude []entity.RecordType) *ceTypes.Expression {
+	if len(exclude) == 0 {
+		return filter
+	}
+	exclusion := ceTypes.Expression{Not: recordTypeExpression(exclude)}
+	if filter == nil {
+		return &exclusion
+	}
+	return &ceTypes.Expression{And: []ceTypes.Expression{*filter, exclusion}}
+}
+
+// onlyRecordTypes restringe o filtro aos tipos de registro informados.
+func onlyRecordTypes(filter *ceTypes.Expression, types []entity.RecordType) *ceTypes.Expression {
+	only := recordTypeExpression(types)
+	if filter == nil {
+		return only
+	}
+	return &ceTypes.Expression{And: []ceTypes.Expression{*filter, *only}}
+}
+
+func recordTypeExpression(types []entity.RecordType) *ceTypes.Expression {
+	values := make([]string, len(types))
+	for i, t := range types {
+		values[i] = string(t)
+	}
+	return &ceTypes.Expression{Dimensions: &ceTypes.DimensionValues{Key: ceTypes.DimensionRecordType, Values: values}}
+}
@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	ceTypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// forecastHistoryDays é a janela de custos diários usada pela projeção linear.
+const forecastHistoryDays = 30
+
+// forecastMetrics traduz as métricas do GetCostAndUsage para os nomes usados pelo GetCostForecast.
+var forecastMetrics = map[entity.CostMetric]ceTypes.Metric{
+	entity.CostMetricUnblended:    ceTypes.MetricUnblendedCost,
+	entity.CostMetricBlended:      ceTypes.MetricBlendedCost,
+	entity.CostMetricAmortized:    ceTypes.MetricAmortizedCost,
+	entity.CostMetricNetAmortized: ceTypes.MetricNetAmortizedCost,
+	entity.CostMetricNetUnblended: ceTypes.MetricNetUnblendedCost,
+}
+
+// GetCostForecast retorna a previsão do custo total do mês corrente (gasto até hoje + previsão até o fim do mês).
+// Quando o Cost Explorer não tem dados suficientes para prever (ex: conta nova), projeta linearmente
+// os custos diários dos últimos 30 dias.
+func (r *AWSRepositoryImpl) GetCostForecast(ctx context.Context, profile string, metric entity.CostMetric, tags []string, exclude []entity.RecordType) (entity.CostForecast, error) {
+	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
+	if err != nil {
+		return entity.CostForecast{}, err
+	}
+	ceClient := client.(*costexplorer.Client)
+
+	metric = metric.OrDefault()
+	filter, err := parseCostFilter(tags)
+	if err != nil {
+		return entity.CostForecast{}, err
+	}
+	filter = excludeRecordTypes(filter, exclude)
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	monthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, 0)
+
+	var monthToDate float64
+	if today.After(monthStart) {
+		monthToDate, err = r.getCostForPeriod(ctx, ceClient, monthStart, today, metric, filter)
+		if err != nil {
+			return entity.CostForecast{}, fmt.Errorf("failed to get month-to-date cost: %w", err)
+		}
+	}
+
+	result, err := ceClient.GetCostForecast(ctx, &costexplorer.GetCostForecastInput{
+		TimePeriod: &ceTypes.DateInterval{
+			Start: aws.String(today.Format("2006-01-02")),
+			End:   aws.String(monthEnd.Format("2006-01-02")),
+		},
+		Granularity:             ceTypes.GranularityMonthly,
+		Metric:                  forecastMetrics[metric],
+		Filter:                  filter,
+		PredictionIntervalLevel: aws.Int32(80),
+	})
+	if err != nil {
+		if !r.isCEDataUnavailable(err) && !r.isCEValidationException(err) {
+			return entity.CostForecast{}, fmt.Errorf("failed to get cost forecast: %w", err)
+		}
+		forecast, err := r.linearCostForecast(ctx, ceClient, today, monthEnd, monthToDate, metric, filter)
+		if err != nil {
+			return entity.CostForecast{}, fmt.Errorf("cost forecast unavailable and linear projection failed: %w", err)
+		}
+		return forecast, nil
+	}
+
+	forecast := entity.CostForecast{MonthToDate: monthToDate, PeriodEnd: monthEnd, Source: entity.ForecastSourceCostExplorer}
+	if result.Total != nil && result.Total.Amount != nil {
+		mean, _ := strconv.ParseFloat(*result.Total.Amount, 64)
+		forecast.Amount = monthToDate + mean
+	}
+	// Sem intervalo de previsão na resposta, os limites ficam iguais à previsão.
+	forecast.LowerBound, forecast.UpperBound = forecast.Amount, forecast.Amount
+	var lower, upper float64
+	hasBounds := false
+	for _, f := range result.ForecastResultsByTime {
+		if f.PredictionIntervalLowerBound == nil || f.PredictionIntervalUpperBound == nil {
+			continue
+		}
+		l, _ := strconv.ParseFloat(*f.PredictionIntervalLowerBound, 64)
+		u, _ := strconv.ParseFloat(*f.PredictionIntervalUpperBound, 64)
+		lower += l
+		upper += u
+		hasBounds = true
+	}
+	if hasBounds {
+		forecast.LowerBound, forecast.UpperBound = monthToDate+lower, monthToDate+upper
+	}
+	return forecast, nil
+}
+
+// linearCostForecast projeta o restante do mês a partir dos custos diários anteriores a hoje.
+func (r *AWSRepositoryImpl) linearCostForecast(ctx context.Context, client *costexplorer.Client, today, monthEnd time.Time, monthToDate float64, metric entity.CostMetric, filter *ceTypes.Expression) (entity.CostForecast, error) {
+	// A janela de 30 dias sempre cobre o início do mês.
+	start := today.AddDate(0, 0, -forecastHistoryDays)
+
+	result, err := client.GetCostAndUsage(ctx, &costexplorer.GetCostAndUsageInput{
+		TimePeriod: &ceTypes.DateInterval{
+			Start: aws.String(start.Format("2006-01-02")),
+			End:   aws.String(today.Format("2006-01-02")),
+		},
+		Granularity: ceTypes.GranularityDaily,
+		Metrics:     []string{string(metric)},
+		Filter:      filter,
+	})
+	if err != nil {
+		return entity.CostForecast{}, err
+	}
+
+	daily := make([]float64, 0, len(result.ResultsByTime))
+	for _, byTime := range result.ResultsByTime {
+		var cost float64
+		if val, ok := byTime.Total[string(metric)]; ok && val.Amount != nil {
+			cost, _ = strconv.ParseFloat(*val.Amount, 64)
+		}
+		daily = append(daily, cost)
+	}
+
+	remainingDays := int(monthEnd.Sub(today).Hours() / 24)
+	forecast, err := entity.LinearForecast(daily, monthToDate, remainingDays)
+	if err != nil {
+		return entity.CostForecast{}, err
+	}
+	forecast.PeriodEnd = monthEnd
+	return forecast, nil
+}
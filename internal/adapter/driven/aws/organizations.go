@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// GetOrganizationAccounts lista as contas-membro da organização com o caminho da OU de cada uma.
+// O perfil precisa ser da conta de gerenciamento (ou de um administrador delegado).
+func (r *AWSRepositoryImpl) GetOrganizationAccounts(ctx context.Context, profile string) ([]entity.OrgAccount, error) {
+	client, err := r.getServiceClient(ctx, profile, "us-east-1", "organizations")
+	if err != nil {
+		return nil, err
+	}
+	orgClient := client.(*organizations.Client)
+
+	var accounts []entity.OrgAccount
+	roots := organizations.NewListRootsPaginator(orgClient, &organizations.ListRootsInput{})
+	for roots.HasMorePages() {
+		page, err := roots.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organization roots for profile %s: %w", profile, err)
+		}
+		for _, root := range page.Roots {
+			found, err := r.listAccountsUnder(ctx, orgClient, aws.ToString(root.Id), aws.ToString(root.Name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to list organization accounts for profile %s: %w", profile, err)
+			}
+			accounts = append(accounts, found...)
+		}
+	}
+	return accounts, nil
+}
+
+// listAccountsUnder percorre recursivamente a árvore de OUs a partir de parentID.
+func (r *AWSRepositoryImpl) listAccountsUnder(ctx context.Context, client *organizations.Client, parentID, path string) ([]entity.OrgAccount, error) {
+	var accounts []entity.OrgAccount
+
+	accountPages := organizations.NewListAccountsForParentPaginator(client, &organizations.ListAccountsForParentInput{ParentId: aws.String(parentID)})
+	for accountPages.HasMorePages() {
+		page, err := accountPages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range page.Accounts {
+			status := string(a.State)
+			if status == "" {
+				status = string(a.Status)
+			}
+			accounts = append(accounts, entity.OrgAccount{
+				ID:     aws.ToString(a.Id),
+				Name:   aws.ToString(a.Name),
+				Email:  aws.ToString(a.Email),
+				Status: status,
+				OUPath: path,
+			})
+		}
+	}
+
+	ouPages := organizations.NewListOrganizationalUnitsForParentPaginator(client, &organizations.ListOrganizationalUnitsForParentInput{ParentId: aws.String(parentID)})
+	for ouPages.HasMorePages() {
+		page, err := ouPages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, ou := range page.OrganizationalUnits {
+			children, err := r.listAccountsUnder(ctx, client, aws.ToString(ou.Id), path+"/"+aws.ToString(ou.Name))
+			if err != nil {
+				return nil, err
+			}
+			accounts = append(accounts, children...)
+		}
+	}
+	return accounts, nil
+}
+
+// GetLinkedAccountCosts retorna o custo de cada conta-membro visto pela conta de gerenciamento.
+// O período atual vem de uma única consulta agrupada por LINKED_ACCOUNT e pela dimensão de
+// detalhamento (SERVICE por padrão); o período anterior só precisa do total por conta.
+func (r *AWSRepositoryImpl) GetLinkedAccountCosts(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, groupBy entity.Grouping, tags []string, exclude []entity.RecordType) ([]entity.LinkedAccountCost, error) {
+	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
+	if err != nil {
+		return nil, err
+	}
+	ceClient := client.(*costexplorer.Client)
+
+	metric = metric.OrDefault()
+	if len(groupBy) == 0 {
+		groupBy = entity.DefaultGrouping()
+	}
+	filter, err := parseCostFilter(tags)
+	if err != nil {
+		return nil, err
+	}
+	filter = excludeRecordTypes(filter, exclude)
+
+	current, err := r.getCostByGroup(ctx, ceClient, period.Start, period.End, metric, entity.Grouping{entity.LinkedAccountDimension(), groupBy[0]}, filter, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current period cost by linked account: %w", err)
+	}
+
+	prev := period.Previous()
+	previous, err := r.getCostByGroup(ctx, ceClient, prev.Start, prev.End, metric, entity.Grouping{entity.LinkedAccountDimension()}, filter, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get previous period cost by linked account: %w", err)
+	}
+
+	costs := make([]entity.LinkedAccountCost, 0, len(current))
+	index := make(map[string]int, len(current))
+	for _, c := range current {
+		index[c.ServiceName] = len(costs)
+		costs = append(costs, entity.LinkedAccountCost{
+			AccountID:    c.ServiceName,
+			CurrentCost:  c.Cost,
+			ServiceCosts: c.SubCosts,
+		})
+	}
+	// Contas sem custo no período atual ainda aparecem com o custo do período anterior.
+	for _, p := range previous {
+		i, ok := index[p.ServiceName]
+		if !ok {
+			i = len(costs)
+			index[p.ServiceName] = i
+			costs = append(costs, entity.LinkedAccountCost{AccountID: p.ServiceName})
+		}
+		costs[i].PreviousCost = p.Cost
+	}
+	return costs, nil
+}
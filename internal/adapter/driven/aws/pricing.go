@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	pricingTypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// A Price List Query API só existe em algumas regiões; us-east-1 atende todas as regiões de preço.
+const pricingRegion = "us-east-1"
+
+// Famílias de produto do AWSELB e o tipo de load balancer correspondente no ELBv2.
+var loadBalancerFamilies = map[string]string{
+	"Load Balancer-Application": "application",
+	"Load Balancer-Network":     "network",
+	"Load Balancer-Gateway":     "gateway",
+}
+
+// GetResourcePrices consulta na AWS Pricing API os preços sob demanda de EBS, Elastic IP e load
+// balancers das regiões informadas. Os preços não dependem da conta e ficam em cache entre perfis.
+func (r *AWSRepositoryImpl) GetResourcePrices(ctx context.Context, profile string, regions []string) (entity.PriceList, error) {
+	client, err := r.getServiceClient(ctx, profile, "", "pricing")
+	if err != nil {
+		return nil, err
+	}
+	pc := client.(*pricing.Client)
+
+	prices := make(entity.PriceList, len(regions))
+	for _, region := range regions {
+		r.mu.Lock()
+		cached, ok := r.priceCache[region]
+		r.mu.Unlock()
+		if ok {
+			prices[region] = cached
+			continue
+		}
+
+		rp, err := regionPrices(ctx, pc, region)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get prices for %s: %w", region, err)
+		}
+		r.mu.Lock()
+		r.priceCache[region] = rp
+		r.mu.Unlock()
+		prices[region] = rp
+	}
+	return prices, nil
+}
+
+// regionPrices monta a tabela de preços de uma região a partir dos produtos de EC2, VPC e ELB.
+func regionPrices(ctx context.Context, client *pricing.Client, region string) (entity.RegionPrices, error) {
+	rp := entity.RegionPrices{
+		EBSStorageGBMonth:       make(map[string]float64),
+		EBSIOPSMonth:            make(map[string]float64),
+		EBSThroughputMiBpsMonth: make(map[string]float64),
+		LoadBalancerHour:        make(map[string]float64),
+	}
+	setOnce := func(m map[string]float64, key string, price float64) {
+		if _, ok := m[key]; !ok && key != "" {
+			m[key] = price
+		}
+	}
+
+	// Armazenamento EBS, por GB-mês.
+	storage, err := getProducts(ctx, client, "AmazonEC2", termMatch("regionCode", region), termMatch("productFamily", "Storage"))
+	if err != nil {
+		return rp, err
+	}
+	for _, p := range storage {
+		if price, ok := p.onDemandPrice("GB-Mo"); ok {
+			setOnce(rp.EBSStorageGBMonth, p.attribute("volumeApiName"), price)
+		}
+	}
+
+	// IOPS provisionado (gp3, io1, io2).
+	ops, err := getProducts(ctx, client, "AmazonEC2", termMatch("regionCode", region), termMatch("productFamily", "System Operation"))
+	if err != nil {
+		return rp, err
+	}
+	for _, p := range ops {
+		if price, ok := p.onDemandPrice("IOPS-Mo"); ok {
+			setOnce(rp.EBSIOPSMonth, p.attribute("volumeApiName"), price)
+		}
+	}
+
+	// Throughput provisionado (gp3), publicado por GiBps-mês.
+	throughput, err := getProducts(ctx, client, "AmazonEC2", termMatch("regionCode", region), termMatch("productFamily", "Provisioned Throughput"))
+	if err != nil {
+		return rp, err
+	}
+	for _, p := range throughput {
+		if price, ok := p.onDemandPrice("GiBps-mo"); ok {
+			setOnce(rp.EBSThroughputMiBpsMonth, p.attribute("volumeApiName"), price/1024)
+		} else if price, ok := p.onDemandPrice("MiBps-Mo"); ok {
+			setOnce(rp.EBSThroughputMiBpsMonth, p.attribute("volumeApiName"), price)
+		}
+	}
+
+	// Endereço IPv4 público ocioso, cobrado por hora desde 2024. O usagetype tem o prefixo da região
+	// (ex: "USW2-PublicIPv4:IdleAddress", sem prefixo em us-east-1), por isso o filtro é por conteúdo.
+	vpc, err := getProducts(ctx, client, "AmazonVPC",
+		termMatch("regionCode", region),
+		termMatch("productFamily", "VPC Public IPv4 Address"),
+		usageTypeContains("IdleAddress"))
+	if err != nil {
+		return rp, err
+	}
+	for _, p := range vpc {
+		if price, ok := p.onDemandPrice("Hrs"); ok {
+			rp.ElasticIPHour = price
+			break
+		}
+	}
+
+	// Hora de load balancer, sem as unidades de capacidade (LCU), que dependem do tráfego.
+	for family, lbType := range loadBalancerFamilies {
+		elb, err := getProducts(ctx, client, "AWSELB",
+			termMatch("regionCode", region),
+			termMatch("productFamily", family),
+			usageTypeContains("LoadBalancerUsage"))
+		if err != nil {
+			return rp, err
+		}
+		for _, p := range elb {
+			if price, ok := p.onDemandPrice("Hrs"); ok {
+				setOnce(rp.LoadBalancerHour, lbType, price)
+			}
+		}
+	}
+
+	return rp, nil
+}
+
+// priceListProduct é um item da lista de preços no formato aws_v1 (o mesmo dos arquivos de oferta).
+type priceListProduct struct {
+	Product struct {
+		ProductFamily string            `json:"productFamily"`
+		Attributes    map[string]string `json:"attributes"`
+	} `json:"product"`
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				Unit         string            `json:"unit"`
+				BeginRange   string            `json:"beginRange"`
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+func (p priceListProduct) attribute(key string) string {
+	return p.Product.Attributes[key]
+}
+
+// onDemandPrice retorna o preço em USD da primeira faixa paga na unidade informada. Faixas gratuitas
+// (como a linha de base incluída no gp3) são ignoradas.
+func (p priceListProduct) onDemandPrice(unit string) (float64, bool) {
+	best, bestBegin := 0.0, math.Inf(1)
+	for _, term := range p.Terms.OnDemand {
+		for _, dim := range term.PriceDimensions {
+			if !strings.EqualFold(dim.Unit, unit) {
+				continue
+			}
+			price, err := strconv.ParseFloat(dim.PricePerUnit["USD"], 64)
+			if err != nil || price <= 0 {
+				continue
+			}
+			begin, err := strconv.ParseFloat(dim.BeginRange, 64)
+			if err != nil {
+				begin = 0
+			}
+			if begin < bestBegin {
+				best, bestBegin = price, begin
+			}
+		}
+	}
+	return best, !math.IsInf(bestBegin, 1)
+}
+
+func termMatch(field, value string) pricingTypes.Filter {
+	return pricingTypes.Filter{Type: pricingTypes.FilterTypeTermMatch, Field: aws.String(field), Value: aws.String(value)}
+}
+
+// usageTypeContains filtra pelo sufixo do usagetype, que vem com o prefixo da região.
+func usageTypeContains(value string) pricingTypes.Filter {
+	return pricingTypes.Filter{Type: pricingTypes.FilterTypeContains, Field: aws.String("usagetype"), Value: aws.String(value)}
+}
+
+// getProducts lista os produtos de um serviço que atendem aos filtros, percorrendo as páginas.
+func getProducts(ctx context.Context, client *pricing.Client, serviceCode string, filters ...pricingTypes.Filter) ([]priceListProduct, error) {
+	paginator := pricing.NewGetProductsPaginator(client, &pricing.GetProductsInput{
+		ServiceCode:   aws.String(serviceCode),
+		Filters:       filters,
+		FormatVersion: aws.String("aws_v1"),
+	})
+
+	var products []priceListProduct
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s products: %w", serviceCode, err)
+		}
+		for _, item := range out.PriceList {
+			var p priceListProduct
+			if err := json.Unmarshal([]byte(item), &p); err != nil {
+				return nil, fmt.Errorf("failed to decode %s price list: %w", serviceCode, err)
+			}
+			products = append(products, p)
+		}
+	}
+	return products, nil
+}
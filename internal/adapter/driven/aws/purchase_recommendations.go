@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	ceTypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// reservationServices são os serviços consultados nas recomendações de reserva, com o nome
+// exigido pelo Cost Explorer e o rótulo exibido.
+var reservationServices = []struct{ Name, Label string }{
+	{"Amazon Elastic Compute Cloud - Compute", "Amazon EC2"},
+	{"Amazon Relational Database Service", "Amazon RDS"},
+	{"Amazon ElastiCache", "Amazon ElastiCache"},
+	{"Amazon OpenSearch Service", "Amazon OpenSearch"},
+	{"Amazon Redshift", "Amazon Redshift"},
+}
+
+// savingsPlansTypes são os tipos de Savings Plans consultados nas recomendações, com o rótulo exibido.
+var savingsPlansTypes = []struct {
+	Type  ceTypes.SupportedSavingsPlansType
+	Label string
+}{
+	{ceTypes.SupportedSavingsPlansTypeComputeSp, "Compute"},
+	{ceTypes.SupportedSavingsPlansTypeEc2InstanceSp, "EC2 Instance"},
+}
+
+// GetPurchaseRecommendations retorna as recomendações de compra de Savings Plans (Compute e EC2
+// Instance) e de reservas (EC2, RDS, ElastiCache, OpenSearch e Redshift) para o prazo, a forma de
+// pagamento e o período de análise informados, ordenadas pela economia estimada.
+// Cada tipo é consultado separadamente: os que falham ficam em Unavailable com o motivo, e só
+// retorna erro quando todas as consultas falham.
+func (r *AWSRepositoryImpl) GetPurchaseRecommendations(ctx context.Context, profile string, opts entity.PurchaseOptions) (entity.PurchaseRecommendations, error) {
+	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
+	if err != nil {
+		return entity.PurchaseRecommendations{}, err
+	}
+	ceClient := client.(*costexplorer.Client)
+
+	term := ceTypes.TermInYearsOneYear
+	if opts.Term == entity.TermThreeYears {
+		term = ceTypes.TermInYearsThreeYears
+	}
+	payment := ceTypes.PaymentOptionNoUpfront
+	switch opts.Payment {
+	case entity.PaymentPartialUpfront:
+		payment = ceTypes.PaymentOptionPartialUpfront
+	case entity.PaymentAllUpfront:
+		payment = ceTypes.PaymentOptionAllUpfront
+	}
+	lookback := ceTypes.LookbackPeriodInDaysThirtyDays
+	switch opts.LookbackDays {
+	case 7:
+		lookback = ceTypes.LookbackPeriodInDaysSevenDays
+	case 60:
+		lookback = ceTypes.LookbackPeriodInDaysSixtyDays
+	}
+
+	result := entity.PurchaseRecommendations{Options: opts}
+	var errs []error
+	unavailable := func(kind, label string, err error) {
+		result.Unavailable = append(result.Unavailable, entity.UnavailableRecommendation{Kind: kind, Type: label, Error: err.Error()})
+		errs = append(errs, fmt.Errorf("%s %s: %w", label, kind, err))
+	}
+
+	for _, sp := range savingsPlansTypes {
+		recs, err := getSavingsPlansRecommendations(ctx, ceClient, &costexplorer.GetSavingsPlansPurchaseRecommendationInput{
+			SavingsPlansType:     sp.Type,
+			TermInYears:          term,
+			PaymentOption:        payment,
+			LookbackPeriodInDays: lookback,
+		}, sp.Label, opts.Term)
+		if err != nil {
+			unavailable(entity.PurchaseSavingsPlans, sp.Label, err)
+			continue
+		}
+		result.SavingsPlans = append(result.SavingsPlans, recs...)
+	}
+
+	for _, svc := range reservationServices {
+		recs, err := getReservationRecommendations(ctx, ceClient, &costexplorer.GetReservationPurchaseRecommendationInput{
+			Service:              aws.String(svc.Name),
+			TermInYears:          term,
+			PaymentOption:        payment,
+			LookbackPeriodInDays: lookback,
+		}, svc.Label, opts.Term)
+		if err != nil {
+			unavailable(entity.PurchaseReservedInstances, svc.Label, err)
+			continue
+		}
+		result.ReservedInstances = append(result.ReservedInstances, recs...)
+	}
+
+	if len(errs) == len(savingsPlansTypes)+len(reservationServices) {
+		return entity.PurchaseRecommendations{}, fmt.Errorf("failed to get purchase recommendations: %w", errors.Join(errs...))
+	}
+
+	for _, recs := range [][]entity.PurchaseRecommendation{result.SavingsPlans, result.ReservedInstances} {
+		sort.SliceStable(recs, func(i, j int) bool {
+			return recs[i].EstimatedMonthlySavings > recs[j].EstimatedMonthlySavings
+		})
+	}
+	return result, nil
+}
+
+// getSavingsPlansRecommendations percorre as páginas de recomendações de um tipo de Savings Plan.
+func getSavingsPlansRecommendations(ctx context.Context, client *costexplorer.Client, input *costexplorer.GetSavingsPlansPurchaseRecommendationInput, label string, term entity.CommitmentTerm) ([]entity.PurchaseRecommendation, error) {
+	var recs []entity.PurchaseRecommendation
+	for {
+		out, err := client.GetSavingsPlansPurchaseRecommendation(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if out.SavingsPlansPurchaseRecommendation != nil {
+			for _, d := range out.SavingsPlansPurchaseRecommendation.SavingsPlansPurchaseRecommendationDetails {
+				recs = append(recs, toSavingsPlansRecommendation(d, label, term))
+			}
+		}
+		if out.NextPageToken == nil {
+			return recs, nil
+		}
+		input.NextPageToken = out.NextPageToken
+	}
+}
+
+// getReservationRecommendations percorre as páginas de recomendações de reserva de um serviço.
+func getReservationRecommendations(ctx context.Context, client *costexplorer.Client, input *costexplorer.GetReservationPurchaseRecommendationInput, service string, term entity.CommitmentTerm) ([]entity.PurchaseRecommendation, error) {
+	var recs []entity.PurchaseRecommendation
+	for {
+		out, err := client.GetReservationPurchaseRecommendation(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, rec := range out.Recommendations {
+			for _, d := range rec.RecommendationDetails {
+				recs = append(recs, toReservationRecommendation(d, service, term))
+			}
+		}
+		if out.NextPageToken == nil {
+			return recs, nil
+		}
+		input.NextPageToken = out.NextPageToken
+	}
+}
+
+func toSavingsPlansRecommendation(d ceTypes.SavingsPlansPurchaseRecommendationDetail, label string, term entity.CommitmentTerm) entity.PurchaseRecommendation {
+	rec := entity.PurchaseRecommendation{
+		Kind:                    entity.PurchaseSavingsPlans,
+		Type:                    label,
+		Details:                 "All regions and instance families",
+		HourlyCommitment:        parseAmount(d.HourlyCommitmentToPurchase),
+		UpfrontCost:             parseAmount(d.UpfrontCost),
+		EstimatedMonthlySavings: parseAmount(d.EstimatedMonthlySavingsAmount),
+		EstimatedSavingsPercent: parseAmount(d.EstimatedSavingsPercentage),
+		EstimatedROI:            parseAmount(d.EstimatedROI),
+	}
+	if sp := d.SavingsPlansDetails; sp != nil {
+		var parts []string
+		for _, p := range []string{aws.ToString(sp.InstanceFamily), aws.ToString(sp.Region)} {
+			if p != "" {
+				parts = append(parts, p)
+			}
+		}
+		if len(parts) > 0 {
+			rec.Details = strings.Join(parts, " | ")
+		}
+	}
+	// O Cost Explorer não informa o ponto de equilíbrio dos Savings Plans.
+	rec.BreakEvenMonths = entity.BreakEvenMonths(rec.UpfrontCost, rec.EstimatedMonthlySavings, term)
+	return rec
+}
+
+func toReservationRecommendation(d ceTypes.ReservationPurchaseRecommendationDetail, service string, term entity.CommitmentTerm) entity.PurchaseRecommendation {
+	rec := entity.PurchaseRecommendation{
+		Kind:                    entity.PurchaseReservedInstances,
+		Type:                    service,
+		Details:                 reservationDetails(d.InstanceDetails),
+		Quantity:                int(parseAmount(d.RecommendedNumberOfInstancesToPurchase)),
+		UpfrontCost:             parseAmount(d.UpfrontCost),
+		EstimatedMonthlySavings: parseAmount(d.EstimatedMonthlySavingsAmount),
+		EstimatedSavingsPercent: parseAmount(d.EstimatedMonthlySavingsPercentage),
+		BreakEvenMonths:         parseAmount(d.EstimatedBreakEvenInMonths),
+	}
+	// As reservas não têm compromisso por hora; usa o custo mensal efetivo (adiantado amortizado + recorrente).
+	monthlyCost := rec.UpfrontCost/float64(term.Months()) + parseAmount(d.RecurringStandardMonthlyCost)
+	rec.HourlyCommitment = monthlyCost / entity.HoursPerMonth
+	if monthlyCost > 0 {
+		rec.EstimatedROI = rec.EstimatedMonthlySavings / monthlyCost * 100
+	}
+	return rec
+}
+
+// reservationDetails descreve a reserva recomendada: tipo de instância ou nó, região e, quando houver,
+// plataforma, engine ou implantação.
+func reservationDetails(details *ceTypes.InstanceDetails) string {
+	if details == nil {
+		return ""
+	}
+	var parts []*string
+	switch {
+	case details.EC2InstanceDetails != nil:
+		d := details.EC2InstanceDetails
+		parts = []*string{d.InstanceType, d.Region, d.Platform, d.Tenancy}
+	case details.RDSInstanceDetails != nil:
+		d := details.RDSInstanceDetails
+		parts = []*string{d.InstanceType, d.Region, d.DatabaseEngine, d.DeploymentOption}
+	case details.ElastiCacheInstanceDetails != nil:
+		d := details.ElastiCacheInstanceDetails
+		parts = []*string{d.NodeType, d.Region, d.ProductDescription}
+	case details.ESInstanceDetails != nil:
+		d := details.ESInstanceDetails
+		parts = []*string{d.InstanceClass, d.InstanceSize, d.Region}
+	case details.RedshiftInstanceDetails != nil:
+		d := details.RedshiftInstanceDetails
+		parts = []*string{d.NodeType, d.Region}
+	}
+	var out []string
+	for _, p := range parts {
+		if v := aws.ToString(p); v != "" {
+			out = append(out, v)
+		}
+	}
+	return strings.Join(out, " | ")
+}
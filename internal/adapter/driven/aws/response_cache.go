@@ -0,0 +1,272 @@
+package aws
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// responseCacheExt é a extensão dos arquivos de resposta no diretório do cache.
+const responseCacheExt = ".json"
+
+// cachedOperations são as operações do Cost Explorer cujas respostas vão para o cache, com o tipo da
+// saída de cada uma. Operações fora da lista (ex: as que alteram dados) sempre chamam a API.
+var cachedOperations = map[string]func() any{
+	"GetCostAndUsage":                       func() any { return &costexplorer.GetCostAndUsageOutput{} },
+	"GetCostForecast":                       func() any { return &costexplorer.GetCostForecastOutput{} },
+	"GetReservationCoverage":                func() any { return &costexplorer.GetReservationCoverageOutput{} },
+	"GetReservationUtilization":             func() any { return &costexplorer.GetReservationUtilizationOutput{} },
+	"GetReservationPurchaseRecommendation":  func() any { return &costexplorer.GetReservationPurchaseRecommendationOutput{} },
+	"GetSavingsPlansCoverage":               func() any { return &costexplorer.GetSavingsPlansCoverageOutput{} },
+	"GetSavingsPlansUtilization":            func() any { return &costexplorer.GetSavingsPlansUtilizationOutput{} },
+	"GetSavingsPlansPurchaseRecommendation": func() any { return &costexplorer.GetSavingsPlansPurchaseRecommendationOutput{} },
+	"GetRightsizingRecommendation":          func() any { return &costexplorer.GetRightsizingRecommendationOutput{} },
+	"GetAnomalies":                          func() any { return &costexplorer.GetAnomaliesOutput{} },
+	"GetAnomalyMonitors":                    func() any { return &costexplorer.GetAnomalyMonitorsOutput{} },
+	"GetDimensionValues":                    func() any { return &costexplorer.GetDimensionValuesOutput{} },
+}
+
+// responseCache guarda em disco as respostas do Cost Explorer, uma por arquivo, e conta os acertos
+// da execução.
+type responseCache struct {
+	opts  entity.ResponseCacheOptions
+	mu    sync.Mutex
+	stats entity.ResponseCacheStats
+}
+
+// responseCacheEntry é o conteúdo de um arquivo do cache.
+type responseCacheEntry struct {
+	Operation string          `json:"operation"`
+	Profile   string          `json:"profile"`
+	AccountID string          `json:"account_id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Output    json.RawMessage `json:"output"`
+}
+
+// EnableResponseCache turns on the on-disk cache of Cost Explorer responses for the clients of this
+// repository and resets its statistics. Respostas de dias anteriores são removidas do diretório.
+func (r *AWSRepositoryImpl) EnableResponseCache(opts entity.ResponseCacheOptions) {
+	pruneResponseCache(opts.Dir, time.Now())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.respCache = &responseCache{
+		opts:  opts,
+		stats: entity.ResponseCacheStats{HitsByOperation: make(map[string]int)},
+	}
+}
+
+// ResponseCacheStats returns the cache hits and misses since the cache was enabled.
+func (r *AWSRepositoryImpl) ResponseCacheStats() entity.ResponseCacheStats {
+	r.mu.Lock()
+	cache := r.respCache
+	r.mu.Unlock()
+	if cache == nil {
+		return entity.ResponseCacheStats{}
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	stats := cache.stats
+	stats.HitsByOperation = maps.Clone(cache.stats.HitsByOperation)
+	return stats
+}
+
+// ClearResponseCache removes every cached response from dir and returns how many were removed.
+func (r *AWSRepositoryImpl) ClearResponseCache(dir string) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error reading cache directory '%s': %w", dir, err)
+	}
+
+	removed := 0
+	for _, e := range entries {
+		if e.IsDir() || !isResponseCacheFile(e.Name()) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, e.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return removed, fmt.Errorf("error removing cached response: %w", err)
+		}
+		if strings.HasSuffix(e.Name(), responseCacheExt) {
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// responseCacheMiddleware devolve o middleware que atende as chamadas do Cost Explorer de um perfil a
+// partir do cache. O cache é consultado a cada chamada, então vale também para clientes já criados.
+func (r *AWSRepositoryImpl) responseCacheMiddleware(profile string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("FinOpsResponseCache", func(
+			ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+		) (middleware.InitializeOutput, middleware.Metadata, error) {
+			r.mu.Lock()
+			cache := r.respCache
+			r.mu.Unlock()
+
+			operation := middleware.GetOperationName(ctx)
+			newOutput, ok := cachedOperations[operation]
+			if cache == nil || !ok {
+				return next.HandleInitialize(ctx, in)
+			}
+			// Sem a conta não há como separar as respostas de credenciais diferentes do mesmo perfil.
+			accountID, err := r.cachedAccountID(ctx, profile)
+			if err != nil {
+				return next.HandleInitialize(ctx, in)
+			}
+			key, err := responseCacheKey(profile, accountID, operation, in.Parameters, time.Now())
+			if err != nil {
+				return next.HandleInitialize(ctx, in)
+			}
+
+			output := newOutput()
+			if cache.get(key, output, time.Now()) {
+				cache.record(operation, true)
+				return middleware.InitializeOutput{Result: output}, middleware.Metadata{}, nil
+			}
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+			cache.record(operation, false)
+			// Erros não vão para o cache: a próxima execução tenta de novo.
+			if err == nil {
+				cache.put(key, responseCacheEntry{Operation: operation, Profile: profile, AccountID: accountID, CreatedAt: time.Now()}, out.Result)
+			}
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
+
+// cachedAccountID retorna a conta do perfil, consultando o STS apenas na primeira vez.
+func (r *AWSRepositoryImpl) cachedAccountID(ctx context.Context, profile string) (string, error) {
+	r.mu.Lock()
+	accountID, ok := r.accountIDs[profile]
+	r.mu.Unlock()
+	if ok {
+		return accountID, nil
+	}
+
+	accountID, err := r.GetAccountID(ctx, profile)
+	if err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	r.accountIDs[profile] = accountID
+	r.mu.Unlock()
+	return accountID, nil
+}
+
+// responseCacheKey identifica uma resposta pelo perfil, conta, operação, parâmetros da requisição
+// (incluindo o token de página) e dia em UTC.
+func responseCacheKey(profile, accountID, operation string, params any, now time.Time) (string, error) {
+	input, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	for _, part := range []string{profile, accountID, operation, now.UTC().Format("2006-01-02")} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	hash.Write(input)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// get lê a resposta da chave em output; respostas vencidas ou ilegíveis contam como ausentes.
+func (c *responseCache) get(key string, output any, now time.Time) bool {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return false
+	}
+	var entry responseCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return false
+	}
+	if now.Sub(entry.CreatedAt) > c.opts.TTL {
+		return false
+	}
+	return json.Unmarshal(entry.Output, output) == nil
+}
+
+// put grava a resposta da chave. O arquivo é escrito à parte e renomeado, para que uma leitura
+// simultânea nunca veja uma resposta pela metade. Falhas apenas deixam a resposta fora do cache.
+func (c *responseCache) put(key string, entry responseCacheEntry, output any) {
+	var err error
+	if entry.Output, err = json.Marshal(output); err != nil {
+		return
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(c.opts.Dir, 0o700); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(c.opts.Dir, key+".*.tmp")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(data)
+	if err := errors.Join(writeErr, tmp.Close()); err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// record conta um acerto ou uma falta do cache.
+func (c *responseCache) record(operation string, hit bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if hit {
+		c.stats.Hits++
+		c.stats.HitsByOperation[operation]++
+	} else {
+		c.stats.Misses++
+	}
+}
+
+func (c *responseCache) path(key string) string {
+	return filepath.Join(c.opts.Dir, key+responseCacheExt)
+}
+
+// pruneResponseCache remove as respostas gravadas antes do dia corrente (UTC), que não podem mais ser
+// reaproveitadas porque o dia faz parte da chave.
+func pruneResponseCache(dir string, now time.Time) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	today := now.UTC().Truncate(24 * time.Hour)
+	for _, e := range entries {
+		if e.IsDir() || !isResponseCacheFile(e.Name()) {
+			continue
+		}
+		if info, err := e.Info(); err == nil && info.ModTime().Before(today) {
+			os.Remove(filepath.Join(dir, e.Name()))
+		}
+	}
+}
+
+// isResponseCacheFile indica se o arquivo é uma resposta do cache ou uma escrita interrompida.
+func isResponseCacheFile(name string) bool {
+	return strings.HasSuffix(name, responseCacheExt) || strings.HasSuffix(name, ".tmp")
+}
@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	ceTypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// regionLongNames traduz os códigos de região para os nomes usados pelo GetRightsizingRecommendation,
+// que filtra e retorna a região pelo nome completo (ex: "US East (N. Virginia)").
+var regionLongNames = map[string]string{
+	"us-east-1":      "US East (N. Virginia)",
+	"us-east-2":      "US East (Ohio)",
+	"us-west-1":      "US West (N. California)",
+	"us-west-2":      "US West (Oregon)",
+	"af-south-1":     "Africa (Cape Town)",
+	"ap-east-1":      "Asia Pacific (Hong Kong)",
+	"ap-south-1":     "Asia Pacific (Mumbai)",
+	"ap-south-2":     "Asia Pacific (Hyderabad)",
+	"ap-southeast-1": "Asia Pacific (Singapore)",
+	"ap-southeast-2": "Asia Pacific (Sydney)",
+	"ap-southeast-3": "Asia Pacific (Jakarta)",
+	"ap-southeast-4": "Asia Pacific (Melbourne)",
+	"ap-southeast-5": "Asia Pacific (Malaysia)",
+	"ap-northeast-1": "Asia Pacific (Tokyo)",
+	"ap-northeast-2": "Asia Pacific (Seoul)",
+	"ap-northeast-3": "Asia Pacific (Osaka)",
+	"ca-central-1":   "Canada (Central)",
+	"ca-west-1":      "Canada West (Calgary)",
+	"eu-central-1":   "EU (Frankfurt)",
+	"eu-central-2":   "EU (Zurich)",
+	"eu-west-1":      "EU (Ireland)",
+	"eu-west-2":      "EU (London)",
+	"eu-west-3":      "EU (Paris)",
+	"eu-south-1":     "EU (Milan)",
+	"eu-south-2":     "EU (Spain)",
+	"eu-north-1":     "EU (Stockholm)",
+	"il-central-1":   "Israel (Tel Aviv)",
+	"me-south-1":     "Middle East (Bahrain)",
+	"me-central-1":   "Middle East (UAE)",
+	"sa-east-1":      "South America (Sao Paulo)",
+	"us-gov-east-1":  "AWS GovCloud (US-East)",
+	"us-gov-west-1":  "AWS GovCloud (US-West)",
+}
+
+// GetRightsizingRecommendations retorna as recomendações de encerramento ou troca de tipo das
+// instâncias EC2, ordenadas pela economia estimada. Sem regiões, todas as regiões são consideradas.
+func (r *AWSRepositoryImpl) GetRightsizingRecommendations(ctx context.Context, profile string, regions []string, tags []string) ([]entity.RightsizingRecommendation, error) {
+	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
+	if err != nil {
+		return nil, err
+	}
+	ceClient := client.(*costexplorer.Client)
+
+	filter, err := parseCostFilter(tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(regions) > 0 {
+		names := make([]string, len(regions))
+		for i, region := range regions {
+			names[i] = region
+			if name, ok := regionLongNames[region]; ok {
+				names[i] = name
+			}
+		}
+		byRegion := ceTypes.Expression{Dimensions: &ceTypes.DimensionValues{Key: ceTypes.DimensionRegion, Values: names}}
+		if filter == nil {
+			filter = &byRegion
+		} else {
+			filter = &ceTypes.Expression{And: []ceTypes.Expression{*filter, byRegion}}
+		}
+	}
+
+	input := &costexplorer.GetRightsizingRecommendationInput{
+		Service: aws.String("AmazonEC2"),
+		Configuration: &ceTypes.RightsizingRecommendationConfiguration{
+			RecommendationTarget: ceTypes.RecommendationTargetSameInstanceFamily,
+			BenefitsConsidered:   true,
+		},
+		Filter: filter,
+	}
+
+	var recommendations []entity.RightsizingRecommendation
+	for {
+		result, err := ceClient.GetRightsizingRecommendation(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rightsizing recommendations: %w", err)
+		}
+		for _, rec := range result.RightsizingRecommendations {
+			recommendations = append(recommendations, toRightsizingRecommendation(rec))
+		}
+		if result.NextPageToken == nil {
+			break
+		}
+		input.NextPageToken = result.NextPageToken
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].EstimatedMonthlySavings > recommendations[j].EstimatedMonthlySavings
+	})
+	return recommendations, nil
+}
+
+func toRightsizingRecommendation(rec ceTypes.RightsizingRecommendation) entity.RightsizingRecommendation {
+	out := entity.RightsizingRecommendation{AccountID: aws.ToString(rec.AccountId)}
+	for _, reason := range rec.FindingReasonCodes {
+		out.FindingReasons = append(out.FindingReasons, string(reason))
+	}
+
+	if cur := rec.CurrentInstance; cur != nil {
+		out.InstanceID = aws.ToString(cur.ResourceId)
+		out.InstanceName = aws.ToString(cur.InstanceName)
+		out.CurrentMonthlyCost = parseAmount(cur.MonthlyCost)
+		if cur.ResourceDetails != nil && cur.ResourceDetails.EC2ResourceDetails != nil {
+			out.CurrentType = aws.ToString(cur.ResourceDetails.EC2ResourceDetails.InstanceType)
+			out.Region = aws.ToString(cur.ResourceDetails.EC2ResourceDetails.Region)
+		}
+		if cur.ResourceUtilization != nil && cur.ResourceUtilization.EC2ResourceUtilization != nil {
+			u := cur.ResourceUtilization.EC2ResourceUtilization
+			out.MaxCPUUtilization = parsePercent(u.MaxCpuUtilizationPercentage)
+			out.MaxMemoryUtilization = parsePercent(u.MaxMemoryUtilizationPercentage)
+			out.MaxStorageUtilization = parsePercent(u.MaxStorageUtilizationPercentage)
+		}
+	}
+
+	switch rec.RightsizingType {
+	case ceTypes.RightsizingTypeTerminate:
+		out.Action = entity.RightsizingTerminate
+		if rec.TerminateRecommendationDetail != nil {
+			out.EstimatedMonthlySavings = parseAmount(rec.TerminateRecommendationDetail.EstimatedMonthlySavings)
+		}
+	case ceTypes.RightsizingTypeModify:
+		out.Action = entity.RightsizingModify
+		if rec.ModifyRecommendationDetail != nil {
+			// O Cost Explorer pode sugerir vários tipos; usa o padrão ou, sem ele, o de maior economia.
+			var target *ceTypes.TargetInstance
+			for i, t := range rec.ModifyRecommendationDetail.TargetInstances {
+				if t.DefaultTargetInstance {
+					target = &rec.ModifyRecommendationDetail.TargetInstances[i]
+					break
+				}
+				if target == nil || parseAmount(t.EstimatedMonthlySavings) > parseAmount(target.EstimatedMonthlySavings) {
+					target = &rec.ModifyRecommendationDetail.TargetInstances[i]
+				}
+			}
+			if target != nil {
+				out.EstimatedMonthlySavings = parseAmount(target.EstimatedMonthlySavings)
+				if target.ResourceDetails != nil && target.ResourceDetails.EC2ResourceDetails != nil {
+					out.TargetType = aws.ToString(target.ResourceDetails.EC2ResourceDetails.InstanceType)
+				}
+			}
+		}
+	default:
+		out.Action = string(rec.RightsizingType)
+	}
+	return out
+}
+
+func parseAmount(s *string) float64 {
+	v, _ := strconv.ParseFloat(aws.ToString(s), 64)
+	return v
+}
+
+// parsePercent retorna nil quando o Cost Explorer não tem a métrica (ex: memória sem CloudWatch agent).
+func parsePercent(s *string) *float64 {
+	if aws.ToString(s) == "" {
+		return nil
+	}
+	v, err := strconv.ParseFloat(*s, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	ceTypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// GetTrendData retorna o histórico de custos do período, um ponto por mês, semana ou dia.
+// Com seriesBy, cada ponto é dividido pelos valores da dimensão (serviço, tag...).
+// O Cost Explorer não tem granularidade semanal; as semanas são somadas a partir dos custos diários.
+func (r *AWSRepositoryImpl) GetTrendData(ctx context.Context, profile string, period entity.Period, granularity entity.TrendGranularity, metric entity.CostMetric, seriesBy *entity.GroupDimension, tags []string, exclude []entity.RecordType) (entity.CostTrend, error) {
+	client, err := r.getServiceClient(ctx, profile, "", "costexplorer")
+	if err != nil {
+		return entity.CostTrend{}, err
+	}
+	ceClient := client.(*costexplorer.Client)
+
+	metric = metric.OrDefault()
+	baseFilter, err := parseCostFilter(tags)
+	if err != nil {
+		return entity.CostTrend{}, err
+	}
+	filter := excludeRecordTypes(baseFilter, exclude)
+
+	ceGranularity := ceTypes.GranularityMonthly
+	if granularity != entity.TrendMonthly {
+		ceGranularity = ceTypes.GranularityDaily
+	}
+
+	input := &costexplorer.GetCostAndUsageInput{
+		TimePeriod: &ceTypes.DateInterval{
+			Start: aws.String(period.StartDate()),
+			End:   aws.String(period.EndDate()),
+		},
+		Granularity: ceGranularity,
+		Metrics:     []string{string(metric)},
+		Filter:      filter,
+	}
+	if seriesBy != nil {
+		input.GroupBy = []ceTypes.GroupDefinition{
+			{Type: ceTypes.GroupDefinitionType(seriesBy.Kind), Key: aws.String(seriesBy.Key)},
+		}
+	}
+
+	var samples []entity.TrendSample
+	for {
+		result, err := ceClient.GetCostAndUsage(ctx, input)
+		if err != nil {
+			return entity.CostTrend{}, fmt.Errorf("failed to get cost trend: %w", err)
+		}
+		for _, byTime := range result.ResultsByTime {
+			date, _ := time.Parse("2006-01-02", aws.ToString(byTime.TimePeriod.Start))
+			// Com agrupamento, o Cost Explorer só preenche os grupos; sem ele, só o total.
+			if seriesBy == nil {
+				if val, ok := byTime.Total[string(metric)]; ok && val.Amount != nil {
+					cost, _ := strconv.ParseFloat(*val.Amount, 64)
+					samples = append(samples, entity.TrendSample{Date: date, Cost: cost})
+				}
+				continue
+			}
+			for _, group := range byTime.Groups {
+				amount := group.Metrics[string(metric)].Amount
+				if amount == nil || len(group.Keys) == 0 {
+					continue
+				}
+				cost, _ := strconv.ParseFloat(*amount, 64)
+				samples = append(samples, entity.TrendSample{Date: date, Series: groupKeyValue(*seriesBy, group.Keys[0]), Cost: cost})
+			}
+		}
+		if result.NextPageToken == nil {
+			break
+		}
+		input.NextPageToken = result.NextPageToken
+	}
+
+	trend := entity.BuildCostTrend(granularity, period, samples)
+	if len(exclude) > 0 {
+		trend.ExcludedCosts, err = r.getExcludedCosts(ctx, ceClient, period.Start, period.End, metric, baseFilter, exclude)
+		if err != nil {
+			return entity.CostTrend{}, fmt.Errorf("failed to get excluded costs: %w", err)
+		}
+	}
+	trend.AccountID, _ = r.GetAccountID(ctx, profile)
+	trend.Metric = metric
+	trend.SeriesBy = seriesBy
+	return trend, nil
+}
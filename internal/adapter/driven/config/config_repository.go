@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
 	"github.com/diillson/aws-finops-dashboard-go/internal/domain/repository"
 	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
 	"github.com/pelletier/go-toml"
@@ -23,43 +24,121 @@ func NewConfigRepository() repository.ConfigRepository {
 
 // LoadConfigFile carrega um arquivo de configuração TOML, YAML ou JSON.
 func (r *ConfigRepositoryImpl) LoadConfigFile(filePath string) (*types.Config, error) {
+	var config types.Config
+	if err := decodeFile(filePath, "config", &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// suppressionsFile é o formato do arquivo de supressões da auditoria.
+type suppressionsFile struct {
+	Suppressions []entity.Suppression `json:"suppressions" yaml:"suppressions" toml:"suppressions"`
+}
+
+// LoadSuppressions carrega o arquivo de supressões (TOML, YAML ou JSON) dos achados da auditoria.
+func (r *ConfigRepositoryImpl) LoadSuppressions(filePath string) ([]entity.Suppression, error) {
+	var file suppressionsFile
+	if err := decodeFile(filePath, "suppressions", &file); err != nil {
+		return nil, err
+	}
+	for i, s := range file.Suppressions {
+		if err := s.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: suppression #%d: %w", filePath, i+1, err)
+		}
+	}
+	return file.Suppressions, nil
+}
+
+// decodeFile lê um arquivo TOML, YAML ou JSON, conforme a extensão, em out. kind nomeia o arquivo nas
+// mensagens de erro.
+func decodeFile(filePath, kind string, out any) error {
 	fileExtension := filepath.Ext(filePath)
 	fileExtension = strings.ToLower(fileExtension)
 
 	// Verifica se o arquivo existe
 	fileInfo, err := os.Stat(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("error accessing config file: %w", err)
+		return fmt.Errorf("error accessing %s file: %w", kind, err)
 	}
 
 	if fileInfo.IsDir() {
-		return nil, fmt.Errorf("%s is a directory, not a file", filePath)
+		return fmt.Errorf("%s is a directory, not a file", filePath)
 	}
 
 	// Lê o arquivo
 	fileData, err := os.ReadFile(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("error reading config file: %w", err)
+		return fmt.Errorf("error reading %s file: %w", kind, err)
 	}
 
-	var config types.Config
-
 	switch fileExtension {
 	case ".toml":
-		if err := toml.Unmarshal(fileData, &config); err != nil {
-			return nil, fmt.Errorf("error parsing TOML file: %w", err)
+		if err := toml.Unmarshal(fileData, out); err != nil {
+			return fmt.Errorf("error parsing TOML file: %w", err)
 		}
 	case ".yaml", ".yml":
-		if err := yaml.Unmarshal(fileData, &config); err != nil {
-			return nil, fmt.Errorf("error parsing YAML file: %w", err)
+		if err := yaml.Unmarshal(fileData, out); err != nil {
+			return fmt.Errorf("error parsing YAML file: %w", err)
 		}
 	case ".json":
-		if err := json.Unmarshal(fileData, &config); err != nil {
-			return nil, fmt.Errorf("error parsing JSON file: %w", err)
+		if err := json.Unmarshal(fileData, out); err != nil {
+			return fmt.Errorf("error parsing JSON file: %w", err)
 		}
 	default:
-		return nil, fmt.Errorf("unsupported config file format: %s", fileExtension)
+		return fmt.Errorf("unsupported %s file format: %s", kind, fileExtension)
 	}
 
-	return &config, nil
+	return nil
+}
+
+// LoadPriceList carrega uma lista de preços offline em JSON, usada no lugar da AWS Pricing API em
+// ambientes sem acesso à internet.
+func (r *ConfigRepositoryImpl) LoadPriceList(filePath string) (entity.PriceList, error) {
+	fileData, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading price list: %w", err)
+	}
+
+	var prices entity.PriceList
+	if err := json.Unmarshal(fileData, &prices); err != nil {
+		return nil, fmt.Errorf("%w: error parsing %s: %v", entity.ErrInvalidPriceList, filePath, err)
+	}
+	if err := prices.Validate(); err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
+// baselineReport aceita um item da exportação JSON do audit (AuditData) ou do full-audit
+// (FullAuditReport, com a auditoria principal em main_audit).
+type baselineReport struct {
+	entity.AuditData
+	MainAudit *entity.AuditData `json:"main_audit"`
+}
+
+// LoadAuditBaseline carrega a exportação JSON de um audit ou full-audit anterior, usada por --baseline.
+func (r *ConfigRepositoryImpl) LoadAuditBaseline(filePath string) ([]entity.AuditData, error) {
+	fileData, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading baseline: %w", err)
+	}
+
+	var reports []baselineReport
+	if err := json.Unmarshal(fileData, &reports); err != nil {
+		return nil, fmt.Errorf("%w: error parsing %s: %v", entity.ErrInvalidBaseline, filePath, err)
+	}
+
+	audits := make([]entity.AuditData, 0, len(reports))
+	for i, report := range reports {
+		switch {
+		case report.MainAudit != nil:
+			audits = append(audits, *report.MainAudit)
+		case report.Checks != nil || report.Findings != nil:
+			audits = append(audits, report.AuditData)
+		default:
+			return nil, fmt.Errorf("%w: %s: item #%d is not from an audit or full-audit JSON export", entity.ErrInvalidBaseline, filePath, i+1)
+		}
+	}
+	return audits, nil
 }
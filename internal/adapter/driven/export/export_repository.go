@@ -4,10 +4,12 @@ import (
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -45,7 +47,15 @@ func (r *ExportRepositoryImpl) ExportToCSV(data []entity.ProfileData, filename,
 		"CLI Profile", "AWS Account ID",
 		fmt.Sprintf("Cost for period (%s)", previousPeriodDates),
 		fmt.Sprintf("Cost for period (%s)", currentPeriodDates),
-		"Cost By Service", "Budget Status", "EC2 Instances",
+		costColumnTitle(data), "Budget Status", "EC2 Instances", "Cost Metric",
+	}
+	withOrg := hasOrganizationData(data)
+	if withOrg {
+		headers = append(headers, "Account Name", "OU Path")
+	}
+	withExcluded := hasExcludedCosts(data)
+	if withExcluded {
+		headers = append(headers, "Excluded Charges")
 	}
 	writer.Write(headers)
 
@@ -67,6 +77,13 @@ func (r *ExportRepositoryImpl) ExportToCSV(data []entity.ProfileData, filename,
 			strings.Join(row.BudgetInfo, "\n"),
 			// Remove quaisquer códigos ANSI que tenham “sobrado” em strings (por segurança)
 			cleanRichTags(strings.Join(row.EC2SummaryFormatted, "\n")),
+			row.Metric.Label(),
+		}
+		if withOrg {
+			record = append(record, row.AccountName, row.OUPath)
+		}
+		if withExcluded {
+			record = append(record, formatExcludedCosts(row.ExcludedCosts))
 		}
 		writer.Write(record)
 	}
@@ -145,6 +162,10 @@ func (r *ExportRepositoryImpl) ExportToPDF(data []entity.ProfileData, filename,
 		pdf.SetFillColor(240, 240, 240)
 		pdf.SetTextColor(bodyTextColor[0], bodyTextColor[1], bodyTextColor[2])
 		pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Account ID: %s", rowData.AccountID)), "", 1, "L", true, 0, "")
+		if rowData.AccountName != "" || rowData.OUPath != "" {
+			pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Account: %s (OU: %s)", rowData.AccountName, rowData.OUPath)), "", 1, "L", true, 0, "")
+		}
+		pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Cost metric: %s", rowData.Metric.Label())), "", 1, "L", true, 0, "")
 		pdf.Ln(10)
 
 		pdf.SetFont("Arial", "B", 12)
@@ -202,7 +223,20 @@ func (r *ExportRepositoryImpl) ExportToPDF(data []entity.ProfileData, filename,
 				serviceCostsStr += fmt.Sprintf("  └─ %s: $%.2f\n", sub.ServiceName, sub.Cost)
 			}
 		}
-		drawSection("Cost By Service", strings.TrimSpace(serviceCostsStr))
+		if f := rowData.Forecast; f != nil {
+			forecastStr := fmt.Sprintf("$%.2f (%s)", f.Amount, f.BoundsLabel())
+			if f.Source == entity.ForecastSourceLinear {
+				forecastStr += "\nLinear projection from daily costs"
+			}
+			if len(f.ExceededBudgets) > 0 {
+				forecastStr += "\nExceeds budget: " + strings.Join(f.ExceededBudgets, ", ")
+			}
+			drawSection("Forecast (End of Month)", forecastStr)
+		}
+		drawSection(rowData.GroupBy.Title(), strings.TrimSpace(serviceCostsStr))
+		if len(rowData.ExcludedCosts) > 0 {
+			drawSection("Excluded Charges (not in totals)", formatExcludedCosts(rowData.ExcludedCosts))
+		}
 		drawSection("Budget Status", strings.Join(rowData.BudgetInfo, "\n\n"))
 		drawSection("EC2 Instances", cleanRichTags(strings.Join(rowData.EC2SummaryFormatted, "\n")))
 
@@ -221,6 +255,45 @@ func (r *ExportRepositoryImpl) ExportToPDF(data []entity.ProfileData, filename,
 	return filepath.Abs(outputFilename)
 }
 
+// costColumnTitle retorna o título da coluna de custos conforme o agrupamento usado.
+// Perfis com erro não têm agrupamento, por isso usa o primeiro que tiver.
+func costColumnTitle(data []entity.ProfileData) string {
+	for _, row := range data {
+		if len(row.GroupBy) > 0 {
+			return row.GroupBy.Title()
+		}
+	}
+	return entity.DefaultGrouping().Title()
+}
+
+// hasOrganizationData indica se alguma linha veio do modo organização (--org).
+func hasOrganizationData(data []entity.ProfileData) bool {
+	for _, row := range data {
+		if row.AccountName != "" || row.OUPath != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func hasExcludedCosts(data []entity.ProfileData) bool {
+	for _, row := range data {
+		if len(row.ExcludedCosts) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// formatExcludedCosts lista os valores dos tipos de registro removidos dos totais por --exclude.
+func formatExcludedCosts(excluded []entity.ServiceCost) string {
+	lines := make([]string, len(excluded))
+	for i, e := range excluded {
+		lines[i] = fmt.Sprintf("%s: $%.2f", e.ServiceName, e.Cost)
+	}
+	return strings.Join(lines, "\n")
+}
+
 // --- Funções de Exportação do Relatório de Auditoria ---
 
 func (r *ExportRepositoryImpl) ExportAuditReportToCSV(auditData []entity.AuditData, filename, outputDir string) (string, error) {
@@ -241,31 +314,77 @@ func (r *ExportRepositoryImpl) ExportAuditReportToCSV(auditData []entity.AuditDa
 	headers := []string{
 		"Profile",
 		"Account ID",
-		"Budget Alerts",
-		"High-Cost NAT Gateways",
-		"Unused VPC Endpoints",
-		"Idle Load Balancers",
-		"Stopped EC2 Instances",
-		"Unused EBS Volumes",
-		"Unused Elastic IPs",
-		"Untagged Resources",
+		"Check",
+		"Service",
+		"Region",
+		"Resource ID",
+		"ARN",
+		"Severity",
+		"Details",
+		"Estimated Cost",
+		"Tags",
+		"Recommendation",
+		"Cost Metric",
+		"Suppression",
+		"Baseline",
 	}
 	if err := writer.Write(headers); err != nil {
 		return "", fmt.Errorf("error writing CSV header: %w", err)
 	}
 
+	// Uma linha por achado, seguida dos achados suprimidos, dos resolvidos desde a auditoria de --baseline
+	// e, por perfil, de uma linha com a economia mensal estimada.
 	for _, row := range auditData {
+		findingRecord := func(f entity.Finding, suppression string) []string {
+			return []string{
+				row.Profile,
+				f.AccountID,
+				f.CheckID,
+				f.Service,
+				f.Region,
+				f.ResourceID,
+				f.ARN,
+				string(f.Severity),
+				f.Details,
+				formatFindingCost(f.EstimatedCost),
+				formatTags(f.Tags),
+				f.Recommendation,
+				row.Metric.Label(),
+				suppression,
+				string(f.Baseline),
+			}
+		}
+		for _, f := range row.Findings {
+			if err := writer.Write(findingRecord(f, "")); err != nil {
+				return "", fmt.Errorf("error writing CSV record: %w", err)
+			}
+		}
+		for _, sf := range row.Suppressed {
+			if err := writer.Write(findingRecord(sf.Finding, formatSuppression(sf))); err != nil {
+				return "", fmt.Errorf("error writing CSV record: %w", err)
+			}
+		}
+		for _, f := range row.Resolved {
+			if err := writer.Write(findingRecord(f, "")); err != nil {
+				return "", fmt.Errorf("error writing CSV record: %w", err)
+			}
+		}
+
+		var total *float64
+		if row.PotentialSavings != nil {
+			t := row.PotentialSavings.Total()
+			total = &t
+		}
 		record := []string{
 			row.Profile,
 			row.AccountID,
-			cleanRichTags(row.BudgetAlerts),
-			cleanRichTags(row.NatGatewayCosts),
-			cleanRichTags(row.UnusedVpcEndpoints),
-			cleanRichTags(row.IdleLoadBalancers),
-			cleanRichTags(row.StoppedInstances),
-			cleanRichTags(row.UnusedVolumes),
-			cleanRichTags(row.UnusedEIPs),
-			cleanRichTags(row.UntaggedResources),
+			potentialSavingsRow,
+			"", "", "", "", "",
+			formatSavingsEstimate(row.PotentialSavings, row.SavingsError),
+			formatFindingCost(total),
+			"", "",
+			row.Metric.Label(),
+			"", "",
 		}
 		if err := writer.Write(record); err != nil {
 			return "", fmt.Errorf("error writing CSV record: %w", err)
@@ -281,22 +400,6 @@ func (r *ExportRepositoryImpl) ExportAuditReportToJSON(auditData []entity.AuditD
 		return "", err
 	}
 
-	cleanData := make([]entity.AuditData, len(auditData))
-	for i, row := range auditData {
-		cleanData[i] = entity.AuditData{
-			Profile:            row.Profile,
-			AccountID:          row.AccountID,
-			BudgetAlerts:       cleanRichTags(row.BudgetAlerts),
-			NatGatewayCosts:    cleanRichTags(row.NatGatewayCosts),
-			IdleLoadBalancers:  cleanRichTags(row.IdleLoadBalancers),
-			StoppedInstances:   cleanRichTags(row.StoppedInstances),
-			UnusedVolumes:      cleanRichTags(row.UnusedVolumes),
-			UnusedEIPs:         cleanRichTags(row.UnusedEIPs),
-			UntaggedResources:  cleanRichTags(row.UntaggedResources),
-			UnusedVpcEndpoints: cleanRichTags(row.UnusedVpcEndpoints),
-		}
-	}
-
 	file, err := os.Create(outputFilename)
 	if err != nil {
 		return "", fmt.Errorf("error creating audit JSON file: %w", err)
@@ -305,7 +408,7 @@ func (r *ExportRepositoryImpl) ExportAuditReportToJSON(auditData []entity.AuditD
 
 	encoder := json.NewEncoder(file)
 	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(cleanData); err != nil {
+	if err := encoder.Encode(auditData); err != nil {
 		return "", fmt.Errorf("error encoding audit JSON data: %w", err)
 	}
 
@@ -358,17 +461,19 @@ func (r *ExportRepositoryImpl) ExportAuditReportToPDF(auditData []entity.AuditDa
 		pdf.SetFillColor(240, 240, 240)
 		pdf.SetTextColor(bodyTextColor[0], bodyTextColor[1], bodyTextColor[2])
 		pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Account ID: %s", row.AccountID)), "", 1, "L", true, 0, "")
+		pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Cost metric: %s", row.Metric.Label())), "", 1, "L", true, 0, "")
+		if row.Baseline != nil {
+			pdf.CellFormat(0, 8, tr("  Baseline: "+formatBaselineSummary(row.Baseline)), "", 1, "L", true, 0, "")
+		}
 		pdf.Ln(10)
 
 		// Seções da Auditoria — ordem consistente com o terminal
-		drawSection("Budget Alerts", row.BudgetAlerts)
-		drawSection("High-Cost NAT Gateways", row.NatGatewayCosts)
-		drawSection("Unused VPC Endpoints", row.UnusedVpcEndpoints)
-		drawSection("Idle Load Balancers", row.IdleLoadBalancers)
-		drawSection("Stopped EC2 Instances", row.StoppedInstances)
-		drawSection("Unused EBS Volumes", row.UnusedVolumes)
-		drawSection("Unused Elastic IPs", row.UnusedEIPs)
-		drawSection("Untagged Resources", row.UntaggedResources)
+		for _, id := range row.Checks {
+			drawSection(auditSectionTitle(id), formatFindings(entity.FilterFindings(row.Findings, id)))
+		}
+		drawSection("Suppressed Findings", formatSuppressedFindings(row.Suppressed))
+		drawSection("Resolved Since Baseline", formatResolvedFindings(row.Resolved))
+		drawSection("Potential Monthly Savings", formatSavingsEstimate(row.PotentialSavings, row.SavingsError))
 
 		// Rodapé
 		pdf.SetY(-15)
@@ -388,6 +493,144 @@ func (r *ExportRepositoryImpl) ExportAuditReportToPDF(auditData []entity.AuditDa
 
 // --- Funções Auxiliares ---
 
+// formatSavingsEstimate descreve a economia mensal estimada da auditoria, com o detalhamento por
+// verificação e a origem dos preços.
+func formatSavingsEstimate(s *entity.SavingsEstimate, savingsErr string) string {
+	if s == nil {
+		if savingsErr != "" {
+			return "N/A: could not get on-demand prices: " + savingsErr
+		}
+		return "N/A"
+	}
+	out := fmt.Sprintf("$%.2f/mo (stopped EC2 EBS $%.2f, unused volumes $%.2f, Elastic IPs $%.2f, load balancers $%.2f; prices: %s)",
+		s.Total(), s.StoppedInstances, s.UnusedVolumes, s.UnusedEIPs, s.IdleLoadBalancers, s.Source)
+	if s.Unpriced > 0 {
+		out += fmt.Sprintf("\n%d resources without a price in the list", s.Unpriced)
+	}
+	return out
+}
+
+// potentialSavingsRow identifica, no CSV da auditoria, a linha com a economia mensal estimada do perfil.
+const potentialSavingsRow = "potential-monthly-savings"
+
+// auditSectionTitles são os títulos das seções das verificações nativas nos PDFs; as demais usam o ID.
+var auditSectionTitles = map[string]string{
+	entity.CheckBudgetExceeded:     "Budget Alerts",
+	entity.CheckHighCostNatGateway: "High-Cost NAT Gateways",
+	entity.CheckUnusedVpcEndpoint:  "Unused VPC Endpoints",
+	entity.CheckIdleLoadBalancer:   "Idle Load Balancers",
+	entity.CheckStoppedInstance:    "Stopped EC2 Instances",
+	entity.CheckUnusedVolume:       "Unused EBS Volumes",
+	entity.CheckUnusedElasticIP:    "Unused Elastic IPs",
+	entity.CheckUntaggedResource:   "Untagged Resources",
+}
+
+// auditSectionTitle retorna o título da seção de uma verificação.
+func auditSectionTitle(checkID string) string {
+	if title, ok := auditSectionTitles[checkID]; ok {
+		return title
+	}
+	return checkID
+}
+
+// formatFindings descreve os achados de uma verificação por região; os recursos globais ficam em "global"
+// e os novos desde a auditoria de --baseline são marcados com "[new]".
+func formatFindings(findings []entity.Finding) string {
+	if len(findings) == 0 {
+		return "None"
+	}
+	var b strings.Builder
+	for i, f := range findings {
+		if i == 0 || f.Region != findings[i-1].Region {
+			name := f.Region
+			if name == "" {
+				name = "global"
+			}
+			b.WriteString(name + ":\n")
+		}
+		line := "  - " + f.ResourceID
+		if f.Details != "" {
+			line += " (" + f.Details + ")"
+		}
+		if f.EstimatedCost != nil {
+			// O custo dos NAT Gateways é o do período do relatório, não mensal.
+			if f.CheckID == entity.CheckHighCostNatGateway {
+				line += fmt.Sprintf(" - $%.2f", *f.EstimatedCost)
+			} else {
+				line += fmt.Sprintf(" - $%.2f/mo", *f.EstimatedCost)
+			}
+		}
+		if f.Baseline == entity.BaselineNew {
+			line += " [new]"
+		}
+		b.WriteString(line + "\n")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// formatSuppression descreve o motivo de uma supressão e, quando houver, até quando ela vale.
+func formatSuppression(sf entity.SuppressedFinding) string {
+	if sf.Expires == "" {
+		return sf.Reason
+	}
+	return fmt.Sprintf("%s (until %s)", sf.Reason, sf.Expires)
+}
+
+// formatSuppressedFindings lista os achados suprimidos com a verificação e o motivo.
+func formatSuppressedFindings(suppressed []entity.SuppressedFinding) string {
+	if len(suppressed) == 0 {
+		return "None"
+	}
+	lines := make([]string, len(suppressed))
+	for i, sf := range suppressed {
+		region := sf.Region
+		if region == "" {
+			region = "global"
+		}
+		lines[i] = fmt.Sprintf("%s: %s (%s) - %s", sf.CheckID, sf.ResourceID, region, formatSuppression(sf))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatBaselineSummary resume a comparação com a auditoria de --baseline.
+func formatBaselineSummary(b *entity.BaselineSummary) string {
+	return fmt.Sprintf("%d new, %d unchanged, %d resolved since %s", b.New, b.Unchanged, b.Resolved, b.Source)
+}
+
+// formatResolvedFindings lista os achados da auditoria de --baseline que não aparecem mais.
+func formatResolvedFindings(resolved []entity.Finding) string {
+	if len(resolved) == 0 {
+		return "None"
+	}
+	lines := make([]string, len(resolved))
+	for i, f := range resolved {
+		region := f.Region
+		if region == "" {
+			region = "global"
+		}
+		lines[i] = fmt.Sprintf("%s: %s (%s)", f.CheckID, f.ResourceID, region)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatFindingCost formata o custo estimado de um achado; vazio quando não há preço.
+func formatFindingCost(cost *float64) string {
+	if cost == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*cost, 'f', 2, 64)
+}
+
+// formatTags serializa as tags como "chave=valor", separadas por ";" e ordenadas pela chave.
+func formatTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for k, v := range tags {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ";")
+}
+
 // generateFilename cria um nome de arquivo único com timestamp e garante que o diretório exista.
 func generateFilename(base, dir, ext string) (string, error) {
 	if dir == "" {
@@ -435,6 +678,7 @@ func (r *ExportRepositoryImpl) ExportTransferReportToCSV(reports []entity.DataTr
 		"Account ID", "Period", "Total",
 		"Internet", "Inter-Region", "Cross-AZ/Regional", "NAT Gateway", "Other",
 		"Top Lines", // formatado como várias linhas em uma célula
+		"Cost Metric",
 	}
 	if err := writer.Write(headers); err != nil {
 		return "", fmt.Errorf("error writing CSV header: %w", err)
@@ -467,6 +711,7 @@ func (r *ExportRepositoryImpl) ExportTransferReportToCSV(reports []entity.DataTr
 			fmt.Sprintf("$%.2f", getCat("NAT Gateway")),
 			fmt.Sprintf("$%.2f", getCat("Other")),
 			cleanRichTags(strings.Join(topLines, "\n")),
+			rep.Metric.Label(),
 		}
 
 		if err := writer.Write(record); err != nil {
@@ -545,6 +790,7 @@ func (r *ExportRepositoryImpl) ExportTransferReportToPDF(reports []entity.DataTr
 		pdf.SetTextColor(bodyTextColor[0], bodyTextColor[1], bodyTextColor[2])
 		pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Account ID: %s", rep.AccountID)), "", 1, "L", true, 0, "")
 		pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Period: %s to %s", rep.PeriodStart.Format("2006-01-02"), rep.PeriodEnd.Format("2006-01-02"))), "", 1, "L", true, 0, "")
+		pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Cost metric: %s", rep.Metric.Label())), "", 1, "L", true, 0, "")
 		pdf.Ln(8)
 
 		// Resumo por categoria
@@ -956,6 +1202,11 @@ func (r *ExportRepositoryImpl) ExportCommitmentsReportToCSV(reports []entity.Com
 		"RI Coverage %", "RI Util %", "RI Unused (hrs)",
 		"Top SP (Service | Coverage% | OnDemand$)",
 		"Top RI (Family | Coverage% | OnDemandHrs)",
+		"Recommendation Options",
+		"SP Rec. Monthly Savings ($)",
+		"RI Rec. Monthly Savings ($)",
+		"SP Recommendations (Type | Details | Hourly | Upfront | Monthly Savings | ROI | Break-even)",
+		"RI Recommendations (Service | Details | Qty | Hourly | Upfront | Monthly Savings | ROI | Break-even)",
 	}
 	if err := w.Write(headers); err != nil {
 		return "", fmt.Errorf("error writing CSV header: %w", err)
@@ -1030,6 +1281,17 @@ func (r *ExportRepositoryImpl) ExportCommitmentsReportToCSV(reports []entity.Com
 			topSP(rep.SPSummary.PerServiceCoverage, 5),
 			topRI(rep.RISummary.PerServiceCoverage, 5),
 		}
+		if recs := rep.Recommendations; recs != nil {
+			record = append(record,
+				recs.Options.Label(),
+				fmt.Sprintf("%.2f", recs.SavingsPlansMonthlySavings()),
+				fmt.Sprintf("%.2f", recs.ReservedInstancesMonthlySavings()),
+				strings.Join(append(formatPurchaseRecommendations(recs.SavingsPlans), formatUnavailableRecommendations(recs.UnavailableOf(entity.PurchaseSavingsPlans))...), "\n"),
+				strings.Join(append(formatPurchaseRecommendations(recs.ReservedInstances), formatUnavailableRecommendations(recs.UnavailableOf(entity.PurchaseReservedInstances))...), "\n"),
+			)
+		} else {
+			record = append(record, "N/A", "N/A", "N/A", "N/A", "N/A")
+		}
 		if err := w.Write(record); err != nil {
 			return "", fmt.Errorf("error writing CSV record: %w", err)
 		}
@@ -1158,6 +1420,31 @@ func (r *ExportRepositoryImpl) ExportCommitmentsReportToPDF(reports []entity.Com
 			drawSection("Reserved Instances — Top Families (by On-Demand Hours)", b.String())
 		}
 
+		// Purchase Recommendations
+		if recs := rep.Recommendations; recs != nil {
+			for _, group := range []struct {
+				title string
+				list  []entity.PurchaseRecommendation
+				total float64
+			}{
+				{entity.PurchaseSavingsPlans, recs.SavingsPlans, recs.SavingsPlansMonthlySavings()},
+				{entity.PurchaseReservedInstances, recs.ReservedInstances, recs.ReservedInstancesMonthlySavings()},
+			} {
+				content := "No recommendations."
+				if len(group.list) > 0 {
+					lines := formatPurchaseRecommendations(group.list)
+					if len(lines) > 15 {
+						lines = append(lines[:15], fmt.Sprintf("... (+%d more)", len(group.list)-15))
+					}
+					content = fmt.Sprintf("Total estimated monthly savings: $%.2f\n\n%s", group.total, strings.Join(lines, "\n"))
+				}
+				if unavailable := formatUnavailableRecommendations(recs.UnavailableOf(group.title)); len(unavailable) > 0 {
+					content += "\n\n" + strings.Join(unavailable, "\n")
+				}
+				drawSection(fmt.Sprintf("%s — Purchase Recommendations (%s)", group.title, recs.Options.Label()), content)
+			}
+		}
+
 		// Footer
 		pdf.SetY(-15)
 		pdf.SetFont("Arial", "I", 8)
@@ -1172,86 +1459,83 @@ func (r *ExportRepositoryImpl) ExportCommitmentsReportToPDF(reports []entity.Com
 	return filepath.Abs(outputFilename)
 }
 
-// ExportFullAuditReportToCSV gera um pacote de arquivos CSV, um para cada sub-relatório.
-func (r *ExportRepositoryImpl) ExportFullAuditReportToCSV(reports []entity.FullAuditReport, baseFilename, outputDir string) ([]string, error) {
-	var generatedFiles []string
-
-	// Extrai os sub-relatórios
-	mainAudits := make([]entity.AuditData, 0, len(reports))
-	transferAudits := make([]entity.DataTransferReport, 0, len(reports))
-	logsAudits := make([]entity.CloudWatchLogsAudit, 0, len(reports))
-	s3Audits := make([]entity.S3LifecycleAudit, 0, len(reports))
-	commitmentsAudits := make([]entity.CommitmentsReport, 0, len(reports))
-
-	for _, rep := range reports {
-		if rep.MainAudit != nil {
-			mainAudits = append(mainAudits, *rep.MainAudit)
-		}
-		if rep.TransferAudit != nil {
-			transferAudits = append(transferAudits, *rep.TransferAudit)
-		}
-		if rep.LogsAudit != nil {
-			logsAudits = append(logsAudits, *rep.LogsAudit)
-		}
-		if rep.S3Audit != nil {
-			s3Audits = append(s3Audits, *rep.S3Audit)
-		}
-		if rep.CommitmentsAudit != nil {
-			commitmentsAudits = append(commitmentsAudits, *rep.CommitmentsAudit)
-		}
+func (r *ExportRepositoryImpl) ExportExpirationReportToCSV(reports []entity.CommitmentExpirationReport, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "csv")
+	if err != nil {
+		return "", err
 	}
 
-	// Chama os exportadores individuais com nomes de arquivo derivados
-	if len(mainAudits) > 0 {
-		if path, err := r.ExportAuditReportToCSV(mainAudits, baseFilename+"_main", outputDir); err == nil {
-			generatedFiles = append(generatedFiles, path)
-		}
-	}
-	if len(transferAudits) > 0 {
-		if path, err := r.ExportTransferReportToCSV(transferAudits, baseFilename+"_transfer", outputDir); err == nil {
-			generatedFiles = append(generatedFiles, path)
-		}
+	f, err := os.Create(outputFilename)
+	if err != nil {
+		return "", fmt.Errorf("error creating expirations CSV file: %w", err)
 	}
-	if len(logsAudits) > 0 {
-		if path, err := r.ExportLogsAuditToCSV(logsAudits, baseFilename+"_logs", outputDir); err == nil {
-			generatedFiles = append(generatedFiles, path)
-		}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	defer w.Flush()
+
+	// Uma linha por compromisso ativo, na ordem de vencimento.
+	headers := []string{
+		"Profile", "Account ID", "Kind", "Type", "Details", "Commitment Account", "ID", "Quantity",
+		"Hourly Commitment ($)", "Start Date", "End Date", "Days Remaining", "Expiring Soon",
 	}
-	if len(s3Audits) > 0 {
-		if path, err := r.ExportS3LifecycleAuditToCSV(s3Audits, baseFilename+"_s3", outputDir); err == nil {
-			generatedFiles = append(generatedFiles, path)
-		}
+	if err := w.Write(headers); err != nil {
+		return "", fmt.Errorf("error writing CSV header: %w", err)
 	}
-	if len(commitmentsAudits) > 0 {
-		if path, err := r.ExportCommitmentsReportToCSV(commitmentsAudits, baseFilename+"_commitments", outputDir); err == nil {
-			generatedFiles = append(generatedFiles, path)
+
+	for _, rep := range reports {
+		for _, c := range rep.Commitments {
+			quantity, hourly := "", ""
+			if c.Quantity > 0 {
+				quantity = fmt.Sprint(c.Quantity)
+			}
+			if c.Kind == entity.PurchaseSavingsPlans {
+				hourly = fmt.Sprintf("%.3f", c.HourlyCommitment)
+			}
+			record := []string{
+				rep.Profile,
+				rep.AccountID,
+				c.Kind,
+				c.Type,
+				c.Details,
+				c.AccountID,
+				c.ID,
+				quantity,
+				hourly,
+				c.Start.Format("2006-01-02"),
+				c.End.Format("2006-01-02"),
+				fmt.Sprint(c.DaysRemaining),
+				strconv.FormatBool(c.ExpiringSoon),
+			}
+			if err := w.Write(record); err != nil {
+				return "", fmt.Errorf("error writing CSV record: %w", err)
+			}
 		}
 	}
-
-	return generatedFiles, nil
+	return filepath.Abs(outputFilename)
 }
 
-// ExportFullAuditReportToJSON gera um único JSON com todos os dados.
-func (r *ExportRepositoryImpl) ExportFullAuditReportToJSON(reports []entity.FullAuditReport, filename, outputDir string) (string, error) {
+func (r *ExportRepositoryImpl) ExportExpirationReportToJSON(reports []entity.CommitmentExpirationReport, filename, outputDir string) (string, error) {
 	outputFilename, err := generateFilename(filename, outputDir, "json")
 	if err != nil {
 		return "", err
 	}
+
 	f, err := os.Create(outputFilename)
 	if err != nil {
-		return "", fmt.Errorf("error creating full audit JSON file: %w", err)
+		return "", fmt.Errorf("error creating expirations JSON file: %w", err)
 	}
 	defer f.Close()
+
 	enc := json.NewEncoder(f)
 	enc.SetIndent("", "  ")
 	if err := enc.Encode(reports); err != nil {
-		return "", fmt.Errorf("error encoding full audit JSON: %w", err)
+		return "", fmt.Errorf("error encoding expirations JSON: %w", err)
 	}
 	return filepath.Abs(outputFilename)
 }
 
-// ExportFullAuditReportToPDF gera um único PDF com "capítulos" para cada auditoria.
-func (r *ExportRepositoryImpl) ExportFullAuditReportToPDF(reports []entity.FullAuditReport, filename, outputDir string) (string, error) {
+func (r *ExportRepositoryImpl) ExportExpirationReportToPDF(reports []entity.CommitmentExpirationReport, filename, outputDir string) (string, error) {
 	outputFilename, err := generateFilename(filename, outputDir, "pdf")
 	if err != nil {
 		return "", err
@@ -1260,98 +1544,999 @@ func (r *ExportRepositoryImpl) ExportFullAuditReportToPDF(reports []entity.FullA
 	pdf := gofpdf.New("P", "mm", "A4", "")
 	tr := pdf.UnicodeTranslatorFromDescriptor("")
 
-	for _, rep := range reports {
-		// --- Página de Rosto do Relatório para o Perfil ---
+	for i, rep := range reports {
 		pdf.AddPage()
-		pdf.SetFont("Arial", "B", 24)
-		pdf.SetTextColor(0, 0, 0)
-		pdf.Cell(0, 20, "Full FinOps Audit Report")
-		pdf.Ln(15)
-		pdf.SetFont("Arial", "", 14)
-		pdf.Cell(0, 10, fmt.Sprintf("Profile: %s", rep.Profile))
-		pdf.Ln(8)
-		pdf.Cell(0, 10, fmt.Sprintf("Account ID: %s", rep.AccountID))
-		pdf.Ln(8)
-		pdf.Cell(0, 10, fmt.Sprintf("Generated on: %s", time.Now().Format("2006-01-02 15:04:05")))
-		pdf.Ln(20)
-
-		// Índice
-		pdf.SetFont("Arial", "B", 16)
-		pdf.Cell(0, 10, "Table of Contents")
-		pdf.Ln(10)
-		pdf.SetFont("Arial", "", 12)
-		if rep.MainAudit != nil {
-			pdf.Cell(0, 8, "1. Main Audit (Unused, Untagged, etc.)")
-			pdf.Ln(6)
-		}
-		if rep.TransferAudit != nil {
-			pdf.Cell(0, 8, "2. Data Transfer Deep Dive")
-			pdf.Ln(6)
-		}
-		if rep.LogsAudit != nil {
-			pdf.Cell(0, 8, "3. CloudWatch Logs Retention")
-			pdf.Ln(6)
-		}
-		if rep.S3Audit != nil {
-			pdf.Cell(0, 8, "4. S3 Lifecycle & Security")
-			pdf.Ln(6)
-		}
-		if rep.CommitmentsAudit != nil {
-			pdf.Cell(0, 8, "5. Commitments (SP/RI)")
-			pdf.Ln(6)
-		}
-
-		// --- Seções/Capítulos ---
-		drawChapter := func(title string, drawContent func()) {
-			pdf.AddPage()
-			pdf.SetFont("Arial", "B", 18)
-			pdf.SetFillColor(230, 230, 230)
-			pdf.CellFormat(0, 12, fmt.Sprintf("  %s", title), "", 1, "L", true, 0, "")
-			pdf.Ln(8)
-			drawContent()
-		}
+		headerColor := [3]int{51, 51, 51}
+		headerTextColor := [3]int{255, 255, 255}
+		sectionTitleColor := [3]int{0, 0, 0}
+		bodyTextColor := [3]int{50, 50, 50}
+		lineColor := [3]int{200, 200, 200}
 
-		drawSection := func(title string, content string) {
+		drawSection := func(title string, content string, textColor [3]int) {
 			content = cleanRichTags(content)
-			if strings.TrimSpace(content) == "" || content == "None" {
+			if strings.TrimSpace(content) == "" {
 				return
 			}
 			pdf.SetFont("Arial", "B", 12)
+			pdf.SetTextColor(sectionTitleColor[0], sectionTitleColor[1], sectionTitleColor[2])
 			pdf.Cell(0, 8, tr(title))
 			pdf.Ln(7)
-			pdf.SetDrawColor(200, 200, 200)
+			pdf.SetDrawColor(lineColor[0], lineColor[1], lineColor[2])
 			pdf.Line(pdf.GetX(), pdf.GetY(), pdf.GetX()+190, pdf.GetY())
 			pdf.Ln(4)
 			pdf.SetFont("Arial", "", 10)
+			pdf.SetTextColor(textColor[0], textColor[1], textColor[2])
 			pdf.MultiCell(190, 5, tr(content), "", "L", false)
 			pdf.Ln(8)
 		}
 
-		// 1. Main Audit
-		if a := rep.MainAudit; a != nil {
-			drawChapter("1. Main Audit", func() {
-				drawSection("Budget Alerts", a.BudgetAlerts)
-				drawSection("High-Cost NAT Gateways", a.NatGatewayCosts)
-				drawSection("Unused VPC Endpoints", a.UnusedVpcEndpoints)
-				drawSection("Idle Load Balancers", a.IdleLoadBalancers)
-				drawSection("Stopped EC2 Instances", a.StoppedInstances)
-				drawSection("Unused EBS Volumes", a.UnusedVolumes)
-				drawSection("Unused Elastic IPs", a.UnusedEIPs)
-				drawSection("Untagged Resources", a.UntaggedResources)
-			})
-		}
+		// Header
+		pdf.SetFillColor(headerColor[0], headerColor[1], headerColor[2])
+		pdf.SetTextColor(headerTextColor[0], headerTextColor[1], headerTextColor[2])
+		pdf.SetFont("Arial", "B", 14)
+		pdf.CellFormat(0, 12, tr("  Commitment Expirations"), "", 1, "L", true, 0, "")
+		pdf.SetFont("Arial", "", 10)
+		pdf.SetFillColor(240, 240, 240)
+		pdf.SetTextColor(bodyTextColor[0], bodyTextColor[1], bodyTextColor[2])
+		pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Profile: %s  |  Account ID: %s", rep.Profile, rep.AccountID)), "", 1, "L", true, 0, "")
+		pdf.Ln(6)
 
-		// 2. Data Transfer
-		if t := rep.TransferAudit; t != nil {
-			drawChapter("2. Data Transfer Deep Dive", func() {
-				var b strings.Builder
-				b.WriteString(fmt.Sprintf("Total: $%.2f\n\n", t.Total))
-				for _, c := range t.Categories {
-					b.WriteString(fmt.Sprintf("%s: $%.2f\n", c.Category, c.Cost))
-				}
-				drawSection("Category Summary", b.String())
+		for _, section := range expirationSections(rep) {
+			drawSection(section.Title, section.Content, section.Color)
+		}
 
-				if len(t.TopLines) > 0 {
+		// Footer
+		pdf.SetY(-15)
+		pdf.SetFont("Arial", "I", 8)
+		pdf.SetTextColor(128, 128, 128)
+		pdf.CellFormat(0, 10, tr(fmt.Sprintf("Commitment Expirations Report | %s", time.Now().Format("2006-01-02"))), "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 10, tr(fmt.Sprintf("Page %d", i+1)), "", 0, "R", false, 0, "")
+	}
+
+	if err := pdf.OutputFileAndClose(outputFilename); err != nil {
+		return "", fmt.Errorf("error writing expirations PDF file: %w", err)
+	}
+	return filepath.Abs(outputFilename)
+}
+
+// pdfSection é uma seção de texto de um relatório PDF, com a cor do conteúdo.
+type pdfSection struct {
+	Title   string
+	Content string
+	Color   [3]int
+}
+
+// expirationSections monta as seções do relatório de vencimentos: resumo, compromissos que vencem
+// dentro da janela (em vermelho) e os demais. Usado pelo PDF próprio e pelo da auditoria completa.
+func expirationSections(rep entity.CommitmentExpirationReport) []pdfSection {
+	bodyTextColor := [3]int{50, 50, 50}
+	alertTextColor := [3]int{200, 0, 0}
+
+	formatLine := func(c entity.CommitmentExpiration) string {
+		size := ""
+		if c.Kind == entity.PurchaseSavingsPlans {
+			size = fmt.Sprintf(" | $%.3f/h", c.HourlyCommitment)
+		} else if c.Quantity > 0 {
+			size = fmt.Sprintf(" | x%d", c.Quantity)
+		}
+		return fmt.Sprintf("%s (%s)%s\n  Ends %s (%d days left) | %s\n",
+			c.Type, c.Kind, size, c.End.Format("2006-01-02"), c.DaysRemaining, joinDetails(c.Details, c.ID))
+	}
+
+	var soon, later strings.Builder
+	for _, c := range rep.Commitments {
+		if c.ExpiringSoon {
+			soon.WriteString(formatLine(c))
+		} else {
+			later.WriteString(formatLine(c))
+		}
+	}
+
+	sections := []pdfSection{{
+		Title: "Summary",
+		Content: fmt.Sprintf("Active commitments: %d\nEnding within %d days: %d",
+			len(rep.Commitments), rep.WarningDays, rep.ExpiringSoon()),
+		Color: bodyTextColor,
+	}}
+	if len(rep.Commitments) == 0 {
+		sections[0].Content = "No active Savings Plans or reservations."
+		return sections
+	}
+	sections = append(sections,
+		pdfSection{Title: fmt.Sprintf("Ending within %d days", rep.WarningDays), Content: soon.String(), Color: alertTextColor},
+		pdfSection{Title: "Other active commitments", Content: later.String(), Color: bodyTextColor},
+	)
+	return sections
+}
+
+func joinDetails(details, id string) string {
+	if details == "" {
+		return id
+	}
+	return details + " | " + id
+}
+
+// formatUnavailableRecommendations formata os tipos de recomendação que não puderam ser obtidos, com o motivo.
+func formatUnavailableRecommendations(list []entity.UnavailableRecommendation) []string {
+	lines := make([]string, 0, len(list))
+	for _, u := range list {
+		lines = append(lines, fmt.Sprintf("%s | unavailable: %s", u.Type, u.Error))
+	}
+	return lines
+}
+
+// formatPurchaseRecommendations formata cada recomendação de compra em uma linha com tipo, detalhes,
+// quantidade (reservas), compromisso por hora, custo adiantado, economia, ROI e ponto de equilíbrio.
+func formatPurchaseRecommendations(recs []entity.PurchaseRecommendation) []string {
+	lines := make([]string, 0, len(recs))
+	for _, rec := range recs {
+		parts := []string{rec.Type}
+		if rec.Details != "" {
+			parts = append(parts, rec.Details)
+		}
+		if rec.Quantity > 0 {
+			parts = append(parts, fmt.Sprintf("x%d", rec.Quantity))
+		}
+		breakEven := "immediate"
+		if rec.BreakEvenMonths > 0 {
+			breakEven = fmt.Sprintf("%.1f months", rec.BreakEvenMonths)
+		}
+		parts = append(parts,
+			fmt.Sprintf("$%.3f/h", rec.HourlyCommitment),
+			fmt.Sprintf("upfront $%.2f", rec.UpfrontCost),
+			fmt.Sprintf("saves $%.2f/month (%.1f%%)", rec.EstimatedMonthlySavings, rec.EstimatedSavingsPercent),
+			fmt.Sprintf("ROI %.1f%%", rec.EstimatedROI),
+			"break-even "+breakEven,
+		)
+		lines = append(lines, strings.Join(parts, " | "))
+	}
+	return lines
+}
+
+func (r *ExportRepositoryImpl) ExportAnomalyReportToCSV(reports []entity.AnomalyReport, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "csv")
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(outputFilename)
+	if err != nil {
+		return "", fmt.Errorf("error creating anomaly CSV file: %w", err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	defer w.Flush()
+
+	// Uma linha por anomalia; perfis sem anomalias não geram linhas.
+	headers := []string{
+		"Profile", "Account ID", "Source", "Monitor", "Start Date", "End Date", "Service", "Anomaly Account",
+		"Region", "Impact ($)", "Actual Spend ($)", "Expected Spend ($)", "Impact (%)", "Score", "Root Causes",
+	}
+	if err := w.Write(headers); err != nil {
+		return "", fmt.Errorf("error writing CSV header: %w", err)
+	}
+
+	for _, rep := range reports {
+		for _, a := range rep.Anomalies {
+			record := []string{
+				rep.Profile,
+				rep.AccountID,
+				a.Source,
+				a.Monitor,
+				a.StartDate.Format("2006-01-02"),
+				a.EndDate.Format("2006-01-02"),
+				a.Service,
+				a.AccountID,
+				a.Region,
+				fmt.Sprintf("%.2f", a.Impact),
+				fmt.Sprintf("%.2f", a.ActualSpend),
+				fmt.Sprintf("%.2f", a.ExpectedSpend),
+				fmt.Sprintf("%.2f", a.ImpactPercent),
+				fmt.Sprintf("%.2f", a.Score),
+				strings.Join(formatRootCauses(a.RootCauses), "\n"),
+			}
+			if err := w.Write(record); err != nil {
+				return "", fmt.Errorf("error writing CSV record: %w", err)
+			}
+		}
+	}
+	return filepath.Abs(outputFilename)
+}
+
+func (r *ExportRepositoryImpl) ExportAnomalyReportToJSON(reports []entity.AnomalyReport, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "json")
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(outputFilename)
+	if err != nil {
+		return "", fmt.Errorf("error creating anomaly JSON file: %w", err)
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(reports); err != nil {
+		return "", fmt.Errorf("error encoding anomaly JSON: %w", err)
+	}
+	return filepath.Abs(outputFilename)
+}
+
+func (r *ExportRepositoryImpl) ExportAnomalyReportToPDF(reports []entity.AnomalyReport, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "pdf")
+	if err != nil {
+		return "", err
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for i, rep := range reports {
+		pdf.AddPage()
+		headerColor := [3]int{51, 51, 51}
+		headerTextColor := [3]int{255, 255, 255}
+		sectionTitleColor := [3]int{0, 0, 0}
+		bodyTextColor := [3]int{50, 50, 50}
+		lineColor := [3]int{200, 200, 200}
+
+		drawSection := func(title string, content string) {
+			content = cleanRichTags(content)
+			if strings.TrimSpace(content) == "" {
+				return
+			}
+			pdf.SetFont("Arial", "B", 12)
+			pdf.SetTextColor(sectionTitleColor[0], sectionTitleColor[1], sectionTitleColor[2])
+			pdf.Cell(0, 8, tr(title))
+			pdf.Ln(7)
+			pdf.SetDrawColor(lineColor[0], lineColor[1], lineColor[2])
+			pdf.Line(pdf.GetX(), pdf.GetY(), pdf.GetX()+190, pdf.GetY())
+			pdf.Ln(4)
+			pdf.SetFont("Arial", "", 10)
+			pdf.SetTextColor(bodyTextColor[0], bodyTextColor[1], bodyTextColor[2])
+			pdf.MultiCell(190, 5, tr(content), "", "L", false)
+			pdf.Ln(8)
+		}
+
+		// Header
+		pdf.SetFillColor(headerColor[0], headerColor[1], headerColor[2])
+		pdf.SetTextColor(headerTextColor[0], headerTextColor[1], headerTextColor[2])
+		pdf.SetFont("Arial", "B", 14)
+		pdf.CellFormat(0, 12, tr("  Cost Anomalies"), "", 1, "L", true, 0, "")
+		pdf.SetFont("Arial", "", 10)
+		pdf.SetFillColor(240, 240, 240)
+		pdf.SetTextColor(bodyTextColor[0], bodyTextColor[1], bodyTextColor[2])
+		period := fmt.Sprintf("%s to %s", rep.PeriodStart.Format("2006-01-02"), rep.PeriodEnd.AddDate(0, 0, -1).Format("2006-01-02"))
+		pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Profile: %s  |  Account ID: %s  |  Period: %s", rep.Profile, rep.AccountID, period)), "", 1, "L", true, 0, "")
+		pdf.Ln(6)
+
+		// Summary
+		detection := "AWS Cost Anomaly Detection monitors: " + strings.Join(rep.Monitors, ", ")
+		if rep.LocalDetection {
+			detection = fmt.Sprintf("Local detection (no anomaly monitors): daily cost per service, z-score >= %.1f over the previous %d days",
+				entity.AnomalyZScoreThreshold, entity.AnomalyBaselineDays)
+		}
+		drawSection("Summary", fmt.Sprintf("%s\nAnomalies: %d\nTotal Impact: $%.2f", detection, len(rep.Anomalies), rep.TotalImpact))
+
+		// Anomalies
+		if len(rep.Anomalies) > 0 {
+			var b strings.Builder
+			limit := len(rep.Anomalies)
+			if limit > 20 {
+				limit = 20
+			}
+			for _, a := range rep.Anomalies[:limit] {
+				dates := a.StartDate.Format("2006-01-02")
+				if !a.EndDate.Equal(a.StartDate) {
+					dates += " to " + a.EndDate.Format("2006-01-02")
+				}
+				b.WriteString(fmt.Sprintf("%s | %s | Account %s | Region %s\n", dates, a.Service, a.AccountID, a.Region))
+				b.WriteString(fmt.Sprintf("  Impact: $%.2f (%.1f%%)  Actual: $%.2f  Expected: $%.2f  Score: %.2f\n",
+					a.Impact, a.ImpactPercent, a.ActualSpend, a.ExpectedSpend, a.Score))
+				for _, rc := range formatRootCauses(a.RootCauses) {
+					b.WriteString("  - " + rc + "\n")
+				}
+				b.WriteString("\n")
+			}
+			if len(rep.Anomalies) > limit {
+				b.WriteString(fmt.Sprintf("... (+%d more)\n", len(rep.Anomalies)-limit))
+			}
+			drawSection("Anomalies (by impact)", b.String())
+		} else {
+			drawSection("Anomalies (by impact)", "No anomalies found in the period.")
+		}
+
+		// Footer
+		pdf.SetY(-15)
+		pdf.SetFont("Arial", "I", 8)
+		pdf.SetTextColor(128, 128, 128)
+		pdf.CellFormat(0, 10, tr(fmt.Sprintf("Cost Anomalies Report | %s", time.Now().Format("2006-01-02"))), "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 10, tr(fmt.Sprintf("Page %d", i+1)), "", 0, "R", false, 0, "")
+	}
+
+	if err := pdf.OutputFileAndClose(outputFilename); err != nil {
+		return "", fmt.Errorf("error writing anomaly PDF file: %w", err)
+	}
+	return filepath.Abs(outputFilename)
+}
+
+// formatRootCauses formata cada causa raiz como "serviço | conta | região | usage type: $impacto".
+func formatRootCauses(causes []entity.AnomalyRootCause) []string {
+	lines := make([]string, 0, len(causes))
+	for _, rc := range causes {
+		var parts []string
+		for _, p := range []string{rc.Service, rc.AccountName, rc.AccountID, rc.Region, rc.UsageType} {
+			if p != "" {
+				parts = append(parts, p)
+			}
+		}
+		lines = append(lines, fmt.Sprintf("%s: $%.2f", strings.Join(parts, " | "), rc.Impact))
+	}
+	return lines
+}
+
+func (r *ExportRepositoryImpl) ExportTrendReportToCSV(trends []entity.CostTrend, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "csv")
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(outputFilename)
+	if err != nil {
+		return "", fmt.Errorf("error creating trend CSV file: %w", err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	defer w.Flush()
+
+	// Formato longo: uma linha com o total de cada ponto e uma linha por série, fácil de pivotar.
+	headers := []string{"Profile", "Account ID", "Cost Metric", "Granularity", "Period Start", "Period", "Series By", "Series", "Cost ($)"}
+	if err := w.Write(headers); err != nil {
+		return "", fmt.Errorf("error writing CSV header: %w", err)
+	}
+
+	for _, t := range trends {
+		seriesBy := ""
+		if t.SeriesBy != nil {
+			seriesBy = t.SeriesBy.String()
+		}
+		for _, p := range t.Points {
+			base := []string{t.Profile, t.AccountID, t.Metric.Label(), string(t.Granularity), p.Start.Format("2006-01-02"), p.Label, seriesBy}
+			if err := w.Write(append(base, "Total", fmt.Sprintf("%.2f", p.Cost))); err != nil {
+				return "", fmt.Errorf("error writing CSV record: %w", err)
+			}
+			for i, name := range t.Series {
+				record := append(append([]string{}, base...), name, fmt.Sprintf("%.2f", p.SeriesCosts[i]))
+				if err := w.Write(record); err != nil {
+					return "", fmt.Errorf("error writing CSV record: %w", err)
+				}
+			}
+		}
+		// Os valores excluídos por --exclude cobrem o período inteiro e não entram nos totais acima.
+		for _, e := range t.ExcludedCosts {
+			record := []string{t.Profile, t.AccountID, t.Metric.Label(), string(t.Granularity), t.PeriodStart.Format("2006-01-02"), "Excluded (not in totals)", seriesBy, e.ServiceName, fmt.Sprintf("%.2f", e.Cost)}
+			if err := w.Write(record); err != nil {
+				return "", fmt.Errorf("error writing CSV record: %w", err)
+			}
+		}
+	}
+	return filepath.Abs(outputFilename)
+}
+
+func (r *ExportRepositoryImpl) ExportTrendReportToJSON(trends []entity.CostTrend, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "json")
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(outputFilename)
+	if err != nil {
+		return "", fmt.Errorf("error creating trend JSON file: %w", err)
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(trends); err != nil {
+		return "", fmt.Errorf("error encoding trend JSON: %w", err)
+	}
+	return filepath.Abs(outputFilename)
+}
+
+func (r *ExportRepositoryImpl) ExportTrendReportToPDF(trends []entity.CostTrend, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "pdf")
+	if err != nil {
+		return "", err
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for i, t := range trends {
+		pdf.AddPage()
+		headerColor := [3]int{51, 51, 51}
+		headerTextColor := [3]int{255, 255, 255}
+		sectionTitleColor := [3]int{0, 0, 0}
+		bodyTextColor := [3]int{50, 50, 50}
+		lineColor := [3]int{200, 200, 200}
+
+		drawSectionTitle := func(title string) {
+			pdf.SetFont("Arial", "B", 12)
+			pdf.SetTextColor(sectionTitleColor[0], sectionTitleColor[1], sectionTitleColor[2])
+			pdf.Cell(0, 8, tr(title))
+			pdf.Ln(7)
+			pdf.SetDrawColor(lineColor[0], lineColor[1], lineColor[2])
+			pdf.Line(pdf.GetX(), pdf.GetY(), pdf.GetX()+190, pdf.GetY())
+			pdf.Ln(4)
+		}
+		drawSection := func(title string, content string) {
+			if strings.TrimSpace(content) == "" {
+				return
+			}
+			drawSectionTitle(title)
+			pdf.SetFont("Arial", "", 10)
+			pdf.SetTextColor(bodyTextColor[0], bodyTextColor[1], bodyTextColor[2])
+			pdf.MultiCell(190, 5, tr(content), "", "L", false)
+			pdf.Ln(8)
+		}
+
+		// Header
+		pdf.SetFillColor(headerColor[0], headerColor[1], headerColor[2])
+		pdf.SetTextColor(headerTextColor[0], headerTextColor[1], headerTextColor[2])
+		pdf.SetFont("Arial", "B", 14)
+		pdf.CellFormat(0, 12, tr("  Cost Trend"), "", 1, "L", true, 0, "")
+		pdf.SetFont("Arial", "", 10)
+		pdf.SetFillColor(240, 240, 240)
+		pdf.SetTextColor(bodyTextColor[0], bodyTextColor[1], bodyTextColor[2])
+		period := fmt.Sprintf("%s to %s", t.PeriodStart.Format("2006-01-02"), t.PeriodEnd.AddDate(0, 0, -1).Format("2006-01-02"))
+		pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Profile: %s  |  Account ID: %s  |  Period: %s", t.Profile, t.AccountID, period)), "", 1, "L", true, 0, "")
+		pdf.Ln(6)
+
+		// Summary
+		summary := fmt.Sprintf("Granularity: %s\nCost Metric: %s\nTotal: $%.2f", t.Granularity, t.Metric.Label(), t.Total())
+		if t.SeriesBy != nil {
+			summary += "\nSeries: " + t.SeriesBy.Label()
+		}
+		drawSection("Summary", summary)
+		if len(t.ExcludedCosts) > 0 {
+			drawSection("Excluded Charges (not in totals)", formatExcludedCosts(t.ExcludedCosts))
+		}
+
+		// Chart
+		drawSectionTitle(fmt.Sprintf("Cost by %s", t.Granularity.Unit()))
+		drawTrendChart(pdf, tr, t)
+		pdf.Ln(6)
+
+		// Series
+		if len(t.Series) > 0 {
+			var b strings.Builder
+			total := t.Total()
+			for j, name := range t.Series {
+				share := 0.0
+				if total != 0 {
+					share = t.SeriesTotal(j) / total * 100
+				}
+				b.WriteString(fmt.Sprintf("%s: $%.2f (%.1f%%)\n", name, t.SeriesTotal(j), share))
+			}
+			drawSection(t.SeriesBy.Label()+" Totals", b.String())
+		}
+
+		// Points
+		var b strings.Builder
+		for _, p := range t.Points {
+			b.WriteString(fmt.Sprintf("%s: $%.2f\n", p.Label, p.Cost))
+		}
+		drawSection(fmt.Sprintf("Cost by %s (values)", t.Granularity.Unit()), b.String())
+
+		// Footer
+		pdf.SetY(-15)
+		pdf.SetFont("Arial", "I", 8)
+		pdf.SetTextColor(128, 128, 128)
+		pdf.CellFormat(0, 10, tr(fmt.Sprintf("Cost Trend Report | %s", time.Now().Format("2006-01-02"))), "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 10, tr(fmt.Sprintf("Page %d", i+1)), "", 0, "R", false, 0, "")
+	}
+
+	if err := pdf.OutputFileAndClose(outputFilename); err != nil {
+		return "", fmt.Errorf("error writing trend PDF file: %w", err)
+	}
+	return filepath.Abs(outputFilename)
+}
+
+// trendChartColors são as cores das séries no gráfico da tendência; a última é usada para a série de outras (entity.TrendOtherSeries).
+var trendChartColors = [][3]int{
+	{31, 119, 180}, {255, 127, 14}, {44, 160, 44}, {214, 39, 40}, {148, 103, 189},
+	{140, 86, 75}, {227, 119, 194}, {23, 190, 207}, {127, 127, 127},
+}
+
+// drawTrendChart desenha um gráfico de barras empilhadas da tendência na posição atual,
+// com a escala no eixo Y, até 12 rótulos no eixo X e a legenda das séries.
+func drawTrendChart(pdf *gofpdf.Fpdf, tr func(string) string, t entity.CostTrend) {
+	const (
+		chartHeight = 70.0
+		axisWidth   = 18.0
+		chartWidth  = 190.0 - axisWidth
+	)
+	if len(t.Points) == 0 {
+		return
+	}
+	// Mantém o gráfico inteiro na mesma página (altura do gráfico + rótulos + legenda).
+	if pdf.GetY()+chartHeight+30 > 280 {
+		pdf.AddPage()
+	}
+
+	maxCost := 0.0
+	for _, p := range t.Points {
+		stacked := 0.0
+		for _, c := range p.SeriesCosts {
+			if c > 0 {
+				stacked += c
+			}
+		}
+		maxCost = math.Max(maxCost, math.Max(p.Cost, stacked))
+	}
+	if maxCost <= 0 {
+		pdf.SetFont("Arial", "", 10)
+		pdf.MultiCell(190, 5, tr("All costs are $0.00 for this period."), "", "L", false)
+		return
+	}
+
+	left, top := pdf.GetX()+axisWidth, pdf.GetY()
+	bottom := top + chartHeight
+
+	// Eixo Y com 4 linhas de grade.
+	pdf.SetFont("Arial", "", 7)
+	pdf.SetTextColor(100, 100, 100)
+	pdf.SetDrawColor(220, 220, 220)
+	for step := 0; step <= 4; step++ {
+		y := bottom - chartHeight*float64(step)/4
+		pdf.Line(left, y, left+chartWidth, y)
+		pdf.SetXY(left-axisWidth, y-2)
+		pdf.CellFormat(axisWidth-1, 4, fmt.Sprintf("$%.0f", maxCost*float64(step)/4), "", 0, "R", false, 0, "")
+	}
+
+	slot := chartWidth / float64(len(t.Points))
+	barWidth := slot * 0.8
+	labelEvery := int(math.Ceil(float64(len(t.Points)) / 12))
+	for i, p := range t.Points {
+		x := left + slot*float64(i) + (slot-barWidth)/2
+		y := bottom
+		if len(t.Series) == 0 {
+			if p.Cost > 0 {
+				h := p.Cost / maxCost * chartHeight
+				pdf.SetFillColor(trendChartColors[0][0], trendChartColors[0][1], trendChartColors[0][2])
+				pdf.Rect(x, y-h, barWidth, h, "F")
+			}
+		} else {
+			// Valores negativos (ex: créditos) não entram na pilha.
+			for j, c := range p.SeriesCosts {
+				if c <= 0 {
+					continue
+				}
+				h := c / maxCost * chartHeight
+				color := trendChartColors[j%len(trendChartColors)]
+				if t.IsOtherSeries(j) {
+					color = trendChartColors[len(trendChartColors)-1]
+				}
+				pdf.SetFillColor(color[0], color[1], color[2])
+				pdf.Rect(x, y-h, barWidth, h, "F")
+				y -= h
+			}
+		}
+		if i%labelEvery == 0 {
+			pdf.SetXY(left+slot*float64(i)-6, bottom+1)
+			pdf.CellFormat(slot+12, 4, tr(p.Label), "", 0, "C", false, 0, "")
+		}
+	}
+	pdf.SetDrawColor(120, 120, 120)
+	pdf.Line(left, bottom, left+chartWidth, bottom)
+	pdf.SetXY(left-axisWidth, bottom+7)
+
+	// Legenda
+	if len(t.Series) > 0 {
+		x := left
+		for j, name := range t.Series {
+			color := trendChartColors[j%len(trendChartColors)]
+			if t.IsOtherSeries(j) {
+				color = trendChartColors[len(trendChartColors)-1]
+			}
+			label := tr(name)
+			width := pdf.GetStringWidth(label) + 8
+			if x+width > left+chartWidth {
+				x = left
+				pdf.Ln(5)
+			}
+			pdf.SetFillColor(color[0], color[1], color[2])
+			pdf.Rect(x, pdf.GetY()+1, 3, 3, "F")
+			pdf.SetXY(x+4, pdf.GetY())
+			pdf.CellFormat(width-4, 5, label, "", 0, "L", false, 0, "")
+			x += width
+		}
+		pdf.Ln(5)
+	}
+	pdf.SetX(left - axisWidth)
+}
+
+func (r *ExportRepositoryImpl) ExportRightsizingReportToCSV(reports []entity.RightsizingReport, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "csv")
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(outputFilename)
+	if err != nil {
+		return "", fmt.Errorf("error creating rightsizing CSV file: %w", err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	defer w.Flush()
+
+	// Uma linha por recomendação; perfis sem recomendações não geram linhas.
+	headers := []string{
+		"Profile", "Account ID", "Instance Account", "Region", "Instance ID", "Instance Name", "Action",
+		"Current Type", "Target Type", "Current Monthly Cost ($)", "Est. Monthly Savings ($)",
+		"Max CPU (%)", "Max Memory (%)", "Max Storage (%)", "Finding Reasons",
+	}
+	if err := w.Write(headers); err != nil {
+		return "", fmt.Errorf("error writing CSV header: %w", err)
+	}
+
+	for _, rep := range reports {
+		for _, rec := range rep.Recommendations {
+			record := []string{
+				rep.Profile,
+				rep.AccountID,
+				rec.AccountID,
+				rec.Region,
+				rec.InstanceID,
+				rec.InstanceName,
+				rec.Action,
+				rec.CurrentType,
+				rec.TargetType,
+				fmt.Sprintf("%.2f", rec.CurrentMonthlyCost),
+				fmt.Sprintf("%.2f", rec.EstimatedMonthlySavings),
+				formatOptionalPercent(rec.MaxCPUUtilization),
+				formatOptionalPercent(rec.MaxMemoryUtilization),
+				formatOptionalPercent(rec.MaxStorageUtilization),
+				strings.Join(rec.FindingReasons, ", "),
+			}
+			if err := w.Write(record); err != nil {
+				return "", fmt.Errorf("error writing CSV record: %w", err)
+			}
+		}
+	}
+	return filepath.Abs(outputFilename)
+}
+
+func (r *ExportRepositoryImpl) ExportRightsizingReportToJSON(reports []entity.RightsizingReport, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "json")
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(outputFilename)
+	if err != nil {
+		return "", fmt.Errorf("error creating rightsizing JSON file: %w", err)
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(reports); err != nil {
+		return "", fmt.Errorf("error encoding rightsizing JSON: %w", err)
+	}
+	return filepath.Abs(outputFilename)
+}
+
+func (r *ExportRepositoryImpl) ExportRightsizingReportToPDF(reports []entity.RightsizingReport, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "pdf")
+	if err != nil {
+		return "", err
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for i, rep := range reports {
+		pdf.AddPage()
+		headerColor := [3]int{51, 51, 51}
+		headerTextColor := [3]int{255, 255, 255}
+		sectionTitleColor := [3]int{0, 0, 0}
+		bodyTextColor := [3]int{50, 50, 50}
+		lineColor := [3]int{200, 200, 200}
+
+		drawSection := func(title string, content string) {
+			content = cleanRichTags(content)
+			if strings.TrimSpace(content) == "" {
+				return
+			}
+			pdf.SetFont("Arial", "B", 12)
+			pdf.SetTextColor(sectionTitleColor[0], sectionTitleColor[1], sectionTitleColor[2])
+			pdf.Cell(0, 8, tr(title))
+			pdf.Ln(7)
+			pdf.SetDrawColor(lineColor[0], lineColor[1], lineColor[2])
+			pdf.Line(pdf.GetX(), pdf.GetY(), pdf.GetX()+190, pdf.GetY())
+			pdf.Ln(4)
+			pdf.SetFont("Arial", "", 10)
+			pdf.SetTextColor(bodyTextColor[0], bodyTextColor[1], bodyTextColor[2])
+			pdf.MultiCell(190, 5, tr(content), "", "L", false)
+			pdf.Ln(8)
+		}
+
+		// Header
+		pdf.SetFillColor(headerColor[0], headerColor[1], headerColor[2])
+		pdf.SetTextColor(headerTextColor[0], headerTextColor[1], headerTextColor[2])
+		pdf.SetFont("Arial", "B", 14)
+		pdf.CellFormat(0, 12, tr("  EC2 Rightsizing Recommendations"), "", 1, "L", true, 0, "")
+		pdf.SetFont("Arial", "", 10)
+		pdf.SetFillColor(240, 240, 240)
+		pdf.SetTextColor(bodyTextColor[0], bodyTextColor[1], bodyTextColor[2])
+		pdf.CellFormat(0, 8, tr(fmt.Sprintf("  Profile: %s  |  Account ID: %s", rep.Profile, rep.AccountID)), "", 1, "L", true, 0, "")
+		pdf.Ln(6)
+
+		// Summary
+		drawSection("Summary", fmt.Sprintf("Recommendations: %d (Terminate: %d, Modify: %d)\nEstimated Monthly Savings: $%.2f",
+			len(rep.Recommendations), rep.Count(entity.RightsizingTerminate), rep.Count(entity.RightsizingModify), rep.TotalSavings))
+
+		// Recommendations
+		if len(rep.Recommendations) > 0 {
+			var b strings.Builder
+			limit := len(rep.Recommendations)
+			if limit > 30 {
+				limit = 30
+			}
+			for _, rec := range rep.Recommendations[:limit] {
+				instance := rec.InstanceID
+				if rec.InstanceName != "" {
+					instance = fmt.Sprintf("%s (%s)", rec.InstanceName, rec.InstanceID)
+				}
+				target := rec.TargetType
+				if rec.Action == entity.RightsizingTerminate {
+					target = "terminate"
+				}
+				b.WriteString(fmt.Sprintf("%s | Account %s | %s\n", instance, rec.AccountID, rec.Region))
+				b.WriteString(fmt.Sprintf("  %s -> %s  Current: $%.2f/month  Savings: $%.2f/month\n",
+					rec.CurrentType, target, rec.CurrentMonthlyCost, rec.EstimatedMonthlySavings))
+				b.WriteString(fmt.Sprintf("  Max utilization (%%): CPU %s  Memory %s  Storage %s\n",
+					formatOptionalPercent(rec.MaxCPUUtilization), formatOptionalPercent(rec.MaxMemoryUtilization), formatOptionalPercent(rec.MaxStorageUtilization)))
+				if len(rec.FindingReasons) > 0 {
+					b.WriteString("  Reasons: " + strings.Join(rec.FindingReasons, ", ") + "\n")
+				}
+				b.WriteString("\n")
+			}
+			if len(rep.Recommendations) > limit {
+				b.WriteString(fmt.Sprintf("... (+%d more)\n", len(rep.Recommendations)-limit))
+			}
+			drawSection("Recommendations (by savings)", b.String())
+		} else {
+			drawSection("Recommendations (by savings)", "No rightsizing recommendations.")
+		}
+
+		// Footer
+		pdf.SetY(-15)
+		pdf.SetFont("Arial", "I", 8)
+		pdf.SetTextColor(128, 128, 128)
+		pdf.CellFormat(0, 10, tr(fmt.Sprintf("EC2 Rightsizing Report | %s", time.Now().Format("2006-01-02"))), "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 10, tr(fmt.Sprintf("Page %d", i+1)), "", 0, "R", false, 0, "")
+	}
+
+	if err := pdf.OutputFileAndClose(outputFilename); err != nil {
+		return "", fmt.Errorf("error writing rightsizing PDF file: %w", err)
+	}
+	return filepath.Abs(outputFilename)
+}
+
+// formatOptionalPercent formata uma utilização opcional; vazio quando a métrica não está disponível.
+func formatOptionalPercent(v *float64) string {
+	if v == nil {
+		return "N/A"
+	}
+	return fmt.Sprintf("%.1f", *v)
+}
+
+// ExportFullAuditReportToCSV gera um pacote de arquivos CSV, um para cada sub-relatório.
+func (r *ExportRepositoryImpl) ExportFullAuditReportToCSV(reports []entity.FullAuditReport, baseFilename, outputDir string) ([]string, error) {
+	var generatedFiles []string
+
+	// Extrai os sub-relatórios
+	mainAudits := make([]entity.AuditData, 0, len(reports))
+	transferAudits := make([]entity.DataTransferReport, 0, len(reports))
+	logsAudits := make([]entity.CloudWatchLogsAudit, 0, len(reports))
+	s3Audits := make([]entity.S3LifecycleAudit, 0, len(reports))
+	commitmentsAudits := make([]entity.CommitmentsReport, 0, len(reports))
+	expirationsAudits := make([]entity.CommitmentExpirationReport, 0, len(reports))
+
+	for _, rep := range reports {
+		if rep.MainAudit != nil {
+			mainAudits = append(mainAudits, *rep.MainAudit)
+		}
+		if rep.TransferAudit != nil {
+			transferAudits = append(transferAudits, *rep.TransferAudit)
+		}
+		if rep.LogsAudit != nil {
+			logsAudits = append(logsAudits, *rep.LogsAudit)
+		}
+		if rep.S3Audit != nil {
+			s3Audits = append(s3Audits, *rep.S3Audit)
+		}
+		if rep.CommitmentsAudit != nil {
+			commitmentsAudits = append(commitmentsAudits, *rep.CommitmentsAudit)
+		}
+		if rep.ExpirationsAudit != nil {
+			expirationsAudits = append(expirationsAudits, *rep.ExpirationsAudit)
+		}
+	}
+
+	// Chama os exportadores individuais com nomes de arquivo derivados
+	if len(mainAudits) > 0 {
+		if path, err := r.ExportAuditReportToCSV(mainAudits, baseFilename+"_main", outputDir); err == nil {
+			generatedFiles = append(generatedFiles, path)
+		}
+	}
+	if len(transferAudits) > 0 {
+		if path, err := r.ExportTransferReportToCSV(transferAudits, baseFilename+"_transfer", outputDir); err == nil {
+			generatedFiles = append(generatedFiles, path)
+		}
+	}
+	if len(logsAudits) > 0 {
+		if path, err := r.ExportLogsAuditToCSV(logsAudits, baseFilename+"_logs", outputDir); err == nil {
+			generatedFiles = append(generatedFiles, path)
+		}
+	}
+	if len(s3Audits) > 0 {
+		if path, err := r.ExportS3LifecycleAuditToCSV(s3Audits, baseFilename+"_s3", outputDir); err == nil {
+			generatedFiles = append(generatedFiles, path)
+		}
+	}
+	if len(commitmentsAudits) > 0 {
+		if path, err := r.ExportCommitmentsReportToCSV(commitmentsAudits, baseFilename+"_commitments", outputDir); err == nil {
+			generatedFiles = append(generatedFiles, path)
+		}
+	}
+	if len(expirationsAudits) > 0 {
+		if path, err := r.ExportExpirationReportToCSV(expirationsAudits, baseFilename+"_expirations", outputDir); err == nil {
+			generatedFiles = append(generatedFiles, path)
+		}
+	}
+
+	return generatedFiles, nil
+}
+
+// ExportFullAuditReportToJSON gera um único JSON com todos os dados.
+func (r *ExportRepositoryImpl) ExportFullAuditReportToJSON(reports []entity.FullAuditReport, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "json")
+	if err != nil {
+		return "", err
+	}
+	f, err := os.Create(outputFilename)
+	if err != nil {
+		return "", fmt.Errorf("error creating full audit JSON file: %w", err)
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(reports); err != nil {
+		return "", fmt.Errorf("error encoding full audit JSON: %w", err)
+	}
+	return filepath.Abs(outputFilename)
+}
+
+// ExportFullAuditReportToPDF gera um único PDF com "capítulos" para cada auditoria.
+func (r *ExportRepositoryImpl) ExportFullAuditReportToPDF(reports []entity.FullAuditReport, filename, outputDir string) (string, error) {
+	outputFilename, err := generateFilename(filename, outputDir, "pdf")
+	if err != nil {
+		return "", err
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for _, rep := range reports {
+		// --- Página de Rosto do Relatório para o Perfil ---
+		pdf.AddPage()
+		pdf.SetFont("Arial", "B", 24)
+		pdf.SetTextColor(0, 0, 0)
+		pdf.Cell(0, 20, "Full FinOps Audit Report")
+		pdf.Ln(15)
+		pdf.SetFont("Arial", "", 14)
+		pdf.Cell(0, 10, fmt.Sprintf("Profile: %s", rep.Profile))
+		pdf.Ln(8)
+		pdf.Cell(0, 10, fmt.Sprintf("Account ID: %s", rep.AccountID))
+		pdf.Ln(8)
+		if rep.MainAudit != nil {
+			pdf.Cell(0, 10, fmt.Sprintf("Cost metric: %s", rep.MainAudit.Metric.Label()))
+			pdf.Ln(8)
+		} else if rep.TransferAudit != nil {
+			pdf.Cell(0, 10, fmt.Sprintf("Cost metric: %s", rep.TransferAudit.Metric.Label()))
+			pdf.Ln(8)
+		}
+		pdf.Cell(0, 10, fmt.Sprintf("Generated on: %s", time.Now().Format("2006-01-02 15:04:05")))
+		pdf.Ln(20)
+
+		// Índice
+		pdf.SetFont("Arial", "B", 16)
+		pdf.Cell(0, 10, "Table of Contents")
+		pdf.Ln(10)
+		pdf.SetFont("Arial", "", 12)
+		if rep.MainAudit != nil {
+			pdf.Cell(0, 8, "1. Main Audit (Unused, Untagged, etc.)")
+			pdf.Ln(6)
+		}
+		if rep.TransferAudit != nil {
+			pdf.Cell(0, 8, "2. Data Transfer Deep Dive")
+			pdf.Ln(6)
+		}
+		if rep.LogsAudit != nil {
+			pdf.Cell(0, 8, "3. CloudWatch Logs Retention")
+			pdf.Ln(6)
+		}
+		if rep.S3Audit != nil {
+			pdf.Cell(0, 8, "4. S3 Lifecycle & Security")
+			pdf.Ln(6)
+		}
+		if rep.CommitmentsAudit != nil {
+			pdf.Cell(0, 8, "5. Commitments (SP/RI)")
+			pdf.Ln(6)
+		}
+		if rep.ExpirationsAudit != nil {
+			pdf.Cell(0, 8, "6. Commitment Expirations")
+			pdf.Ln(6)
+		}
+
+		// --- Seções/Capítulos ---
+		drawChapter := func(title string, drawContent func()) {
+			pdf.AddPage()
+			pdf.SetFont("Arial", "B", 18)
+			pdf.SetFillColor(230, 230, 230)
+			pdf.CellFormat(0, 12, fmt.Sprintf("  %s", title), "", 1, "L", true, 0, "")
+			pdf.Ln(8)
+			drawContent()
+		}
+
+		drawSection := func(title string, content string) {
+			content = cleanRichTags(content)
+			if strings.TrimSpace(content) == "" || content == "None" {
+				return
+			}
+			pdf.SetFont("Arial", "B", 12)
+			pdf.Cell(0, 8, tr(title))
+			pdf.Ln(7)
+			pdf.SetDrawColor(200, 200, 200)
+			pdf.Line(pdf.GetX(), pdf.GetY(), pdf.GetX()+190, pdf.GetY())
+			pdf.Ln(4)
+			pdf.SetFont("Arial", "", 10)
+			pdf.MultiCell(190, 5, tr(content), "", "L", false)
+			pdf.Ln(8)
+		}
+
+		// 1. Main Audit
+		if a := rep.MainAudit; a != nil {
+			drawChapter("1. Main Audit", func() {
+				for _, id := range a.Checks {
+					drawSection(auditSectionTitle(id), formatFindings(entity.FilterFindings(a.Findings, id)))
+				}
+				drawSection("Suppressed Findings", formatSuppressedFindings(a.Suppressed))
+				if a.Baseline != nil {
+					drawSection("Baseline", formatBaselineSummary(a.Baseline))
+				}
+				drawSection("Resolved Since Baseline", formatResolvedFindings(a.Resolved))
+				drawSection("Potential Monthly Savings", formatSavingsEstimate(a.PotentialSavings, a.SavingsError))
+			})
+		}
+
+		// 2. Data Transfer
+		if t := rep.TransferAudit; t != nil {
+			drawChapter("2. Data Transfer Deep Dive", func() {
+				var b strings.Builder
+				b.WriteString(fmt.Sprintf("Total: $%.2f\n\n", t.Total))
+				for _, c := range t.Categories {
+					b.WriteString(fmt.Sprintf("%s: $%.2f\n", c.Category, c.Cost))
+				}
+				drawSection("Category Summary", b.String())
+
+				if len(t.TopLines) > 0 {
 					var tl strings.Builder
 					for _, l := range t.TopLines {
 						tl.WriteString(fmt.Sprintf("%s | %s: $%.2f\n", l.Service, l.UsageType, l.Cost))
@@ -1421,6 +2606,17 @@ func (r *ExportRepositoryImpl) ExportFullAuditReportToPDF(reports []entity.FullA
 				}
 			})
 		}
+
+		// 6. Commitment Expirations
+		if e := rep.ExpirationsAudit; e != nil {
+			drawChapter("6. Commitment Expirations", func() {
+				for _, section := range expirationSections(*e) {
+					pdf.SetTextColor(section.Color[0], section.Color[1], section.Color[2])
+					drawSection(section.Title, section.Content)
+				}
+				pdf.SetTextColor(0, 0, 0)
+			})
+		}
 	}
 
 	if err := pdf.OutputFileAndClose(outputFilename); err != nil {
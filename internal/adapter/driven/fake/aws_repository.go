@@ -0,0 +1,423 @@
+// Package fake provides in-memory implementations of the ports used by the use cases, so they can be
+// exercised without AWS credentials or a terminal.
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/repository"
+)
+
+// ErrUnknownProfile is returned by GetAccountID for a profile without an account ID in the fake.
+var ErrUnknownProfile = errors.New("unknown profile")
+
+// AWSRepository is an in-memory repository.AWSRepository. The data of each method is kept in a map keyed
+// by profile; a profile without data gets the zero value. Errors holds the error returned by a method,
+// keyed by "Method:profile" or, for every profile, by "Method" (ex: "GetCostData:prod").
+//
+// Os campos devem ser preenchidos antes do uso: o caso de uso chama o repositório em várias goroutines. As
+// listas são devolvidas como cópias, para que o caso de uso não altere os dados do teste.
+type AWSRepository struct {
+	Profiles   []string
+	AccountIDs map[string]string
+	Regions    map[string][]string
+
+	CostData           map[string]entity.CostData
+	Trends             map[string]entity.CostTrend
+	Forecasts          map[string]entity.CostForecast
+	LinkedAccountCosts map[string][]entity.LinkedAccountCost
+
+	AnomalyMonitors   map[string][]entity.AnomalyMonitor
+	Anomalies         map[string][]entity.CostAnomaly
+	DailyServiceCosts map[string][]entity.ServiceDailyCosts
+
+	Rightsizing map[string][]entity.RightsizingRecommendation
+	OrgAccounts map[string][]entity.OrgAccount
+	Budgets     map[string][]entity.BudgetInfo
+
+	EC2Summaries       map[string]entity.EC2Summary
+	StoppedInstances   map[string]entity.StoppedEC2Instances
+	UnusedVolumes      map[string]entity.UnusedVolumes
+	UnusedEIPs         map[string]entity.UnusedEIPs
+	UntaggedResources  map[string]entity.UntaggedResources
+	IdleLoadBalancers  map[string]entity.IdleLoadBalancers
+	NatGatewayCosts    map[string][]entity.NatGatewayCost
+	UnusedVpcEndpoints map[string]entity.UnusedVpcEndpoints
+	Prices             map[string]entity.PriceList
+
+	DataTransfer map[string]entity.DataTransferReport
+	LogGroups    map[string][]entity.CloudWatchLogGroupInfo
+	S3Buckets    map[string][]entity.S3BucketLifecycleStatus
+
+	SavingsPlans            map[string]entity.SPSummary
+	Reservations            map[string]entity.RISummary
+	PurchaseRecommendations map[string]entity.PurchaseRecommendations
+	Commitments             map[string][]entity.CommitmentExpiration
+
+	Errors map[string]error
+	// RecordingError é o erro retornado por RecordingErr, como se respostas tivessem ficado fora da gravação.
+	RecordingError error
+
+	mu sync.Mutex
+	// calls conta as chamadas por "Method" e por "Method:profile".
+	calls map[string]int
+	// assumed guarda a conta de cada perfil criado por AssumeRoleProfile.
+	assumed    map[string]string
+	cache      *entity.ResponseCacheOptions
+	cacheStats entity.ResponseCacheStats
+	recordDir  string
+	replayDir  string
+}
+
+var _ repository.AWSRepository = (*AWSRepository)(nil)
+
+// Calls returns how many times a method was called, for every profile ("Method") or for one profile
+// ("Method:profile").
+func (r *AWSRepository) Calls(key string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls[key]
+}
+
+// ResponseCache returns the options of the last EnableResponseCache call, or nil.
+func (r *AWSRepository) ResponseCache() *entity.ResponseCacheOptions {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cache
+}
+
+// SetResponseCacheStats sets the statistics returned by ResponseCacheStats.
+func (r *AWSRepository) SetResponseCacheStats(stats entity.ResponseCacheStats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cacheStats = stats
+}
+
+// RecordDir and ReplayDir return the directories of the last RecordAPIResponses and ReplayAPIResponses calls.
+func (r *AWSRepository) RecordDir() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.recordDir
+}
+
+func (r *AWSRepository) ReplayDir() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.replayDir
+}
+
+// call registra a chamada e retorna o erro configurado para o método e o perfil.
+func (r *AWSRepository) call(method, profile string) error {
+	key := method + ":" + profile
+	r.mu.Lock()
+	if r.calls == nil {
+		r.calls = make(map[string]int)
+	}
+	r.calls[method]++
+	r.calls[key]++
+	r.mu.Unlock()
+
+	if err, ok := r.Errors[key]; ok {
+		return err
+	}
+	return r.Errors[method]
+}
+
+func (r *AWSRepository) GetAWSProfiles() []string {
+	_ = r.call("GetAWSProfiles", "")
+	return append([]string(nil), r.Profiles...)
+}
+
+// GetAccountID returns the account of the profile in AccountIDs or, for a profile created by
+// AssumeRoleProfile, the assumed account.
+func (r *AWSRepository) GetAccountID(ctx context.Context, profile string) (string, error) {
+	if err := r.call("GetAccountID", profile); err != nil {
+		return "", err
+	}
+	if id, ok := r.AccountIDs[profile]; ok {
+		return id, nil
+	}
+	r.mu.Lock()
+	id, ok := r.assumed[profile]
+	r.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnknownProfile, profile)
+}
+
+func (r *AWSRepository) GetSession(ctx context.Context, profile string) (string, error) {
+	if err := r.call("GetSession", profile); err != nil {
+		return "", err
+	}
+	return profile, nil
+}
+
+// AssumeRoleProfile returns a profile named like the real repository's, "base@account/role".
+func (r *AWSRepository) AssumeRoleProfile(baseProfile, accountID string, role entity.AssumeRoleOptions) string {
+	profile := role.ProfileName(baseProfile, accountID)
+	_ = r.call("AssumeRoleProfile", profile)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.assumed == nil {
+		r.assumed = make(map[string]string)
+	}
+	r.assumed[profile] = accountID
+	return profile
+}
+
+func (r *AWSRepository) GetAllRegions(ctx context.Context, profile string) ([]string, error) {
+	return r.GetAccessibleRegions(ctx, profile)
+}
+
+func (r *AWSRepository) GetAccessibleRegions(ctx context.Context, profile string) ([]string, error) {
+	if err := r.call("GetAccessibleRegions", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.Regions[profile]), nil
+}
+
+func (r *AWSRepository) GetCostData(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, groupBy entity.Grouping, tags []string, exclude []entity.RecordType, breakdown bool) (entity.CostData, error) {
+	if err := r.call("GetCostData", profile); err != nil {
+		return entity.CostData{}, err
+	}
+	return r.CostData[profile], nil
+}
+
+func (r *AWSRepository) GetTrendData(ctx context.Context, profile string, period entity.Period, granularity entity.TrendGranularity, metric entity.CostMetric, seriesBy *entity.GroupDimension, tags []string, exclude []entity.RecordType) (entity.CostTrend, error) {
+	if err := r.call("GetTrendData", profile); err != nil {
+		return entity.CostTrend{}, err
+	}
+	return r.Trends[profile], nil
+}
+
+func (r *AWSRepository) GetCostForecast(ctx context.Context, profile string, metric entity.CostMetric, tags []string, exclude []entity.RecordType) (entity.CostForecast, error) {
+	if err := r.call("GetCostForecast", profile); err != nil {
+		return entity.CostForecast{}, err
+	}
+	return r.Forecasts[profile], nil
+}
+
+func (r *AWSRepository) GetLinkedAccountCosts(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, groupBy entity.Grouping, tags []string, exclude []entity.RecordType) ([]entity.LinkedAccountCost, error) {
+	if err := r.call("GetLinkedAccountCosts", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.LinkedAccountCosts[profile]), nil
+}
+
+func (r *AWSRepository) GetAnomalyMonitors(ctx context.Context, profile string) ([]entity.AnomalyMonitor, error) {
+	if err := r.call("GetAnomalyMonitors", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.AnomalyMonitors[profile]), nil
+}
+
+func (r *AWSRepository) GetCostAnomalies(ctx context.Context, profile string, period entity.Period) ([]entity.CostAnomaly, error) {
+	if err := r.call("GetCostAnomalies", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.Anomalies[profile]), nil
+}
+
+func (r *AWSRepository) GetDailyServiceCosts(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, tags []string, exclude []entity.RecordType) ([]entity.ServiceDailyCosts, error) {
+	if err := r.call("GetDailyServiceCosts", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.DailyServiceCosts[profile]), nil
+}
+
+func (r *AWSRepository) GetRightsizingRecommendations(ctx context.Context, profile string, regions []string, tags []string) ([]entity.RightsizingRecommendation, error) {
+	if err := r.call("GetRightsizingRecommendations", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.Rightsizing[profile]), nil
+}
+
+func (r *AWSRepository) GetOrganizationAccounts(ctx context.Context, profile string) ([]entity.OrgAccount, error) {
+	if err := r.call("GetOrganizationAccounts", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.OrgAccounts[profile]), nil
+}
+
+func (r *AWSRepository) GetBudgets(ctx context.Context, profile string) ([]entity.BudgetInfo, error) {
+	if err := r.call("GetBudgets", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.Budgets[profile]), nil
+}
+
+func (r *AWSRepository) GetEC2Summary(ctx context.Context, profile string, regions []string) (entity.EC2Summary, error) {
+	if err := r.call("GetEC2Summary", profile); err != nil {
+		return nil, err
+	}
+	return r.EC2Summaries[profile], nil
+}
+
+func (r *AWSRepository) GetStoppedInstances(ctx context.Context, profile string, regions []string) (entity.StoppedEC2Instances, error) {
+	if err := r.call("GetStoppedInstances", profile); err != nil {
+		return nil, err
+	}
+	return r.StoppedInstances[profile], nil
+}
+
+func (r *AWSRepository) GetUnusedVolumes(ctx context.Context, profile string, regions []string) (entity.UnusedVolumes, error) {
+	if err := r.call("GetUnusedVolumes", profile); err != nil {
+		return nil, err
+	}
+	return r.UnusedVolumes[profile], nil
+}
+
+func (r *AWSRepository) GetUnusedEIPs(ctx context.Context, profile string, regions []string) (entity.UnusedEIPs, error) {
+	if err := r.call("GetUnusedEIPs", profile); err != nil {
+		return nil, err
+	}
+	return r.UnusedEIPs[profile], nil
+}
+
+func (r *AWSRepository) GetUntaggedResources(ctx context.Context, profile string, regions []string) (entity.UntaggedResources, error) {
+	if err := r.call("GetUntaggedResources", profile); err != nil {
+		return nil, err
+	}
+	return r.UntaggedResources[profile], nil
+}
+
+func (r *AWSRepository) GetIdleLoadBalancers(ctx context.Context, profile string, regions []string) (entity.IdleLoadBalancers, error) {
+	if err := r.call("GetIdleLoadBalancers", profile); err != nil {
+		return nil, err
+	}
+	return r.IdleLoadBalancers[profile], nil
+}
+
+func (r *AWSRepository) GetNatGatewayCost(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, tags []string) ([]entity.NatGatewayCost, error) {
+	if err := r.call("GetNatGatewayCost", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.NatGatewayCosts[profile]), nil
+}
+
+func (r *AWSRepository) GetUnusedVpcEndpoints(ctx context.Context, profile string, regions []string) (entity.UnusedVpcEndpoints, error) {
+	if err := r.call("GetUnusedVpcEndpoints", profile); err != nil {
+		return nil, err
+	}
+	return r.UnusedVpcEndpoints[profile], nil
+}
+
+// GetResourcePrices returns the prices of the profile restricted to the requested regions.
+func (r *AWSRepository) GetResourcePrices(ctx context.Context, profile string, regions []string) (entity.PriceList, error) {
+	if err := r.call("GetResourcePrices", profile); err != nil {
+		return nil, err
+	}
+	prices := entity.PriceList{}
+	for _, region := range regions {
+		if rp, ok := r.Prices[profile][region]; ok {
+			prices[region] = rp
+		}
+	}
+	return prices, nil
+}
+
+func (r *AWSRepository) GetDataTransferBreakdown(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, tags []string) (entity.DataTransferReport, error) {
+	if err := r.call("GetDataTransferBreakdown", profile); err != nil {
+		return entity.DataTransferReport{}, err
+	}
+	return r.DataTransfer[profile], nil
+}
+
+func (r *AWSRepository) GetCloudWatchLogGroups(ctx context.Context, profile string, regions []string) ([]entity.CloudWatchLogGroupInfo, error) {
+	if err := r.call("GetCloudWatchLogGroups", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.LogGroups[profile]), nil
+}
+
+func (r *AWSRepository) GetS3LifecycleStatus(ctx context.Context, profile string) ([]entity.S3BucketLifecycleStatus, error) {
+	if err := r.call("GetS3LifecycleStatus", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.S3Buckets[profile]), nil
+}
+
+func (r *AWSRepository) GetSavingsPlansSummary(ctx context.Context, profile string, period entity.Period, tags []string) (entity.SPSummary, error) {
+	if err := r.call("GetSavingsPlansSummary", profile); err != nil {
+		return entity.SPSummary{}, err
+	}
+	return r.SavingsPlans[profile], nil
+}
+
+func (r *AWSRepository) GetReservationSummary(ctx context.Context, profile string, period entity.Period, tags []string) (entity.RISummary, error) {
+	if err := r.call("GetReservationSummary", profile); err != nil {
+		return entity.RISummary{}, err
+	}
+	return r.Reservations[profile], nil
+}
+
+func (r *AWSRepository) GetPurchaseRecommendations(ctx context.Context, profile string, opts entity.PurchaseOptions) (entity.PurchaseRecommendations, error) {
+	if err := r.call("GetPurchaseRecommendations", profile); err != nil {
+		return entity.PurchaseRecommendations{}, err
+	}
+	recs := r.PurchaseRecommendations[profile]
+	recs.Options = opts
+	return recs, nil
+}
+
+func (r *AWSRepository) GetActiveCommitments(ctx context.Context, profile string, regions []string) ([]entity.CommitmentExpiration, error) {
+	if err := r.call("GetActiveCommitments", profile); err != nil {
+		return nil, err
+	}
+	return slices.Clone(r.Commitments[profile]), nil
+}
+
+// EnableResponseCache only records the options; the fake never calls AWS, so there is nothing to cache.
+func (r *AWSRepository) EnableResponseCache(opts entity.ResponseCacheOptions) {
+	_ = r.call("EnableResponseCache", "")
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache = &opts
+}
+
+func (r *AWSRepository) ResponseCacheStats() entity.ResponseCacheStats {
+	_ = r.call("ResponseCacheStats", "")
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cacheStats
+}
+
+func (r *AWSRepository) ClearResponseCache(dir string) (int, error) {
+	if err := r.call("ClearResponseCache", ""); err != nil {
+		return 0, err
+	}
+	return 0, nil
+}
+
+func (r *AWSRepository) RecordAPIResponses(dir string) error {
+	if err := r.call("RecordAPIResponses", ""); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recordDir = dir
+	return nil
+}
+
+func (r *AWSRepository) RecordingErr() error {
+	if err := r.call("RecordingErr", ""); err != nil {
+		return err
+	}
+	return r.RecordingError
+}
+
+func (r *AWSRepository) ReplayAPIResponses(dir string) error {
+	if err := r.call("ReplayAPIResponses", ""); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.replayDir = dir
+	return nil
+}
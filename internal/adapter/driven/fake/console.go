@@ -0,0 +1,187 @@
+package fake
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+// Log levels of the messages captured by Console.
+const (
+	LevelInfo    = "info"
+	LevelWarning = "warning"
+	LevelError   = "error"
+	LevelSuccess = "success"
+)
+
+// LogEntry is a message logged through the console.
+type LogEntry struct {
+	Level   string
+	Message string
+}
+
+// TrendChart is a call to DisplayTrendBars.
+type TrendChart struct {
+	Unit string
+	Bars []types.TrendBar
+}
+
+// Console is a types.ConsoleInterface that captures the output instead of writing it to the terminal:
+// printed text, log messages, tables and trend charts. Spinners and progress bars write to io.Discard.
+type Console struct {
+	mu     sync.Mutex
+	output strings.Builder
+	logs   []LogEntry
+	tables []*Table
+	charts []TrendChart
+}
+
+var _ types.ConsoleInterface = (*Console)(nil)
+
+// NewConsole creates an empty Console.
+func NewConsole() *Console {
+	return &Console{}
+}
+
+func (c *Console) Print(a ...interface{}) {
+	c.write(fmt.Sprint(a...))
+}
+
+func (c *Console) Printf(format string, a ...interface{}) {
+	c.write(fmt.Sprintf(format, a...))
+}
+
+func (c *Console) Println(a ...interface{}) {
+	c.write(fmt.Sprintln(a...))
+}
+
+func (c *Console) write(s string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.output.WriteString(s)
+}
+
+func (c *Console) LogInfo(format string, a ...interface{})    { c.log(LevelInfo, format, a...) }
+func (c *Console) LogWarning(format string, a ...interface{}) { c.log(LevelWarning, format, a...) }
+func (c *Console) LogError(format string, a ...interface{})   { c.log(LevelError, format, a...) }
+func (c *Console) LogSuccess(format string, a ...interface{}) { c.log(LevelSuccess, format, a...) }
+
+func (c *Console) log(level, format string, a ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.logs = append(c.logs, LogEntry{Level: level, Message: fmt.Sprintf(format, a...)})
+}
+
+type statusHandle struct{}
+
+func (c *Console) Status(message string) types.StatusHandle { return statusHandle{} }
+func (statusHandle) Update(message string)                  {}
+func (statusHandle) Stop()                                  {}
+
+// GetMultiPrinter retorna um MultiPrinter próprio que descarta a saída.
+func (c *Console) GetMultiPrinter() *pterm.MultiPrinter {
+	return pterm.DefaultMultiPrinter.WithWriter(io.Discard)
+}
+
+// NewProgressbar cria uma barra de progresso que descarta a saída.
+func (c *Console) NewProgressbar(total int, title string) *pterm.ProgressbarPrinter {
+	return pterm.DefaultProgressbar.WithTotal(total).WithTitle(title).WithRemoveWhenDone(true).WithWriter(io.Discard)
+}
+
+// CreateTable creates a table that is kept by the console, in creation order.
+func (c *Console) CreateTable() types.TableInterface {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &Table{}
+	c.tables = append(c.tables, t)
+	return t
+}
+
+func (c *Console) DisplayTrendBars(unit string, bars []types.TrendBar) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.charts = append(c.charts, TrendChart{Unit: unit, Bars: append([]types.TrendBar(nil), bars...)})
+}
+
+// Output returns everything printed with Print, Printf and Println.
+func (c *Console) Output() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.output.String()
+}
+
+// Logs returns the logged messages, in order.
+func (c *Console) Logs() []LogEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]LogEntry(nil), c.logs...)
+}
+
+// Messages returns the logged messages of a level, in order.
+func (c *Console) Messages(level string) []string {
+	var messages []string
+	for _, l := range c.Logs() {
+		if l.Level == level {
+			messages = append(messages, l.Message)
+		}
+	}
+	return messages
+}
+
+// Tables returns the tables created through the console, in creation order.
+func (c *Console) Tables() []*Table {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*Table(nil), c.tables...)
+}
+
+// TrendCharts returns the trend charts displayed, in order.
+func (c *Console) TrendCharts() []TrendChart {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]TrendChart(nil), c.charts...)
+}
+
+// Table is a captured table. Cells are stored as printed, including the pterm styles.
+type Table struct {
+	Columns []string
+	Rows    [][]string
+}
+
+func (t *Table) AddColumn(name string, options ...interface{}) {
+	t.Columns = append(t.Columns, name)
+}
+
+func (t *Table) AddRow(cells ...interface{}) {
+	row := make([]string, len(cells))
+	for i, cell := range cells {
+		row[i] = fmt.Sprint(cell)
+	}
+	t.Rows = append(t.Rows, row)
+}
+
+// Render returns the columns and rows separated by " | ", one line per row.
+func (t *Table) Render() string {
+	lines := []string{strings.Join(t.Columns, " | ")}
+	for _, row := range t.Rows {
+		lines = append(lines, strings.Join(row, " | "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Cell returns the cell of a row in the named column, or "" if there is no such column or row.
+func (t *Table) Cell(row int, column string) string {
+	if row < 0 || row >= len(t.Rows) {
+		return ""
+	}
+	for i, c := range t.Columns {
+		if c == column && i < len(t.Rows[row]) {
+			return t.Rows[row][i]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,150 @@
+package history
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/repository"
+)
+
+// Layout do diretório do histórico: cada snapshot é um JSON comprimido em snapshots/ e o índice é um
+// arquivo JSON Lines, com uma linha por snapshot, que só recebe acréscimos.
+const (
+	snapshotsDir  = "snapshots"
+	indexFile     = "index.jsonl"
+	snapshotExt   = ".json.gz"
+	maxIDAttempts = 100
+)
+
+// HistoryRepositoryImpl implementa o HistoryRepository em arquivos locais, sem dependências externas.
+type HistoryRepositoryImpl struct{}
+
+// NewHistoryRepository cria uma nova implementação do HistoryRepository.
+func NewHistoryRepository() repository.HistoryRepository {
+	return &HistoryRepositoryImpl{}
+}
+
+// SaveSnapshot grava o snapshot e acrescenta o resumo dele ao índice.
+func (r *HistoryRepositoryImpl) SaveSnapshot(dir string, snapshot entity.Snapshot) (string, error) {
+	if err := os.MkdirAll(filepath.Join(dir, snapshotsDir), 0o755); err != nil {
+		return "", fmt.Errorf("error creating history directory '%s': %w", dir, err)
+	}
+
+	file, id, err := createSnapshotFile(dir, snapshot.ID)
+	if err != nil {
+		return "", err
+	}
+	snapshot.ID = id
+
+	gz := gzip.NewWriter(file)
+	encodeErr := json.NewEncoder(gz).Encode(snapshot)
+	if err := errors.Join(encodeErr, gz.Close(), file.Close()); err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("error writing snapshot %s: %w", id, err)
+	}
+
+	line, err := json.Marshal(snapshot.Summary())
+	if err != nil {
+		return "", fmt.Errorf("error encoding snapshot index: %w", err)
+	}
+	index, err := os.OpenFile(filepath.Join(dir, indexFile), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return "", fmt.Errorf("error opening history index: %w", err)
+	}
+	// Uma única escrita por linha, para que execuções simultâneas não intercalem as linhas.
+	_, writeErr := index.Write(append(line, '\n'))
+	if err := errors.Join(writeErr, index.Close()); err != nil {
+		return "", fmt.Errorf("error writing history index: %w", err)
+	}
+	return id, nil
+}
+
+// createSnapshotFile cria o arquivo do snapshot sem sobrescrever outro; se o ID já existir (duas execuções
+// no mesmo segundo), acrescenta um sufixo numérico.
+func createSnapshotFile(dir, id string) (*os.File, string, error) {
+	candidate := id
+	for i := 2; i <= maxIDAttempts; i++ {
+		file, err := os.OpenFile(snapshotPath(dir, candidate), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			return file, candidate, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, "", fmt.Errorf("error creating snapshot file: %w", err)
+		}
+		candidate = fmt.Sprintf("%s-%d", id, i)
+	}
+	return nil, "", fmt.Errorf("error creating snapshot file: too many snapshots with ID %s", id)
+}
+
+// ListSnapshots lê o índice do histórico. Linhas ilegíveis (ex: uma escrita interrompida) são ignoradas.
+func (r *HistoryRepositoryImpl) ListSnapshots(dir string) ([]entity.SnapshotSummary, error) {
+	file, err := os.Open(filepath.Join(dir, indexFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening history index: %w", err)
+	}
+	defer file.Close()
+
+	var summaries []entity.SnapshotSummary
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var s entity.SnapshotSummary
+		if err := json.Unmarshal([]byte(line), &s); err != nil {
+			continue
+		}
+		summaries = append(summaries, s)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading history index: %w", err)
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool { return summaries[i].CreatedAt.Before(summaries[j].CreatedAt) })
+	return summaries, nil
+}
+
+// GetSnapshot lê um snapshot pelo ID.
+func (r *HistoryRepositoryImpl) GetSnapshot(dir, id string) (*entity.Snapshot, error) {
+	// O ID vira nome de arquivo; separadores de caminho não são IDs válidos.
+	if id == "" || strings.ContainsAny(id, `/\`) || id == "." || id == ".." {
+		return nil, fmt.Errorf("%w: %q", entity.ErrSnapshotNotFound, id)
+	}
+	file, err := os.Open(snapshotPath(dir, id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %q", entity.ErrSnapshotNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening snapshot %s: %w", id, err)
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot %s: %w", id, err)
+	}
+	defer gz.Close()
+
+	var snapshot entity.Snapshot
+	if err := json.NewDecoder(gz).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("error parsing snapshot %s: %w", id, err)
+	}
+	return &snapshot, nil
+}
+
+func snapshotPath(dir, id string) string {
+	return filepath.Join(dir, snapshotsDir, id+snapshotExt)
+}
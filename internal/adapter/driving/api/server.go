@@ -0,0 +1,246 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/application/usecase"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+)
+
+// apiReports são os relatórios expostos pela API, um endpoint por relatório.
+var apiReports = []types.ReportKind{
+	types.ReportCost,
+	types.ReportAudit,
+	types.ReportTrend,
+	types.ReportTransfer,
+	types.ReportLogs,
+	types.ReportS3,
+	types.ReportCommitments,
+	types.ReportExpirations,
+	types.ReportAnomalies,
+	types.ReportRightsizing,
+}
+
+// errBadRequest marca erros de validação dos parâmetros da requisição.
+var errBadRequest = errors.New("bad request")
+
+// Server exposes the dashboard reports as a JSON HTTP API.
+type Server struct {
+	useCase *usecase.DashboardUseCase
+	addr    string
+
+	// allowedRoles são as roles que os clientes podem assumir com assume_role. As roles são
+	// assumidas com as credenciais do servidor, então sem allowlist o parâmetro é recusado.
+	allowedRoles []string
+}
+
+// NewServer creates a new API server. Requests may only use assume_role with one of allowedRoles;
+// with no allowed roles, assume_role, accounts and external_id are rejected.
+func NewServer(useCase *usecase.DashboardUseCase, addr string, allowedRoles []string) *Server {
+	return &Server{useCase: useCase, addr: addr, allowedRoles: allowedRoles}
+}
+
+// Handler returns the HTTP handler with all API routes.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	for _, report := range apiReports {
+		mux.HandleFunc("/api/v1/"+string(report), s.reportHandler(report))
+	}
+	return mux
+}
+
+// ListenAndServe starts the server and shuts it down gracefully when ctx is cancelled.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	srv := &http.Server{
+		Addr:              s.addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- srv.ListenAndServe() }()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("failed to shut down API server: %w", err)
+		}
+		return nil
+	}
+}
+
+func (s *Server) reportHandler(report types.ReportKind) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		args, err := parseQuery(r, report)
+		if err == nil {
+			err = s.checkAssumeRole(args)
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		result, err := s.useCase.GenerateReport(r.Context(), args)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, types.ErrNoValidProfilesFound) || errors.Is(err, types.ErrNoProfilesFound) ||
+				errors.Is(err, entity.ErrInvalidPeriod) || errors.Is(err, types.ErrConflictingPeriods) ||
+				errors.Is(err, entity.ErrInvalidCostMetric) || errors.Is(err, entity.ErrInvalidGrouping) ||
+				errors.Is(err, entity.ErrInvalidFilter) || errors.Is(err, entity.ErrInvalidRecordType) ||
+				errors.Is(err, entity.ErrInvalidTrend) || errors.Is(err, entity.ErrInvalidPurchaseOption) ||
+				errors.Is(err, entity.ErrInvalidExpirationWindow) ||
+				errors.Is(err, types.ErrInvalidAccountID) || errors.Is(err, types.ErrMultipleBaseProfiles) ||
+				errors.Is(err, types.ErrOrgWithAssumeRole) || errors.Is(err, types.ErrInvalidChecks) {
+				status = http.StatusBadRequest
+			}
+			writeError(w, status, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, result)
+	}
+}
+
+// checkAssumeRole recusa roles fora da allowlist do servidor e os parâmetros de assume role
+// quando nenhuma role é permitida.
+func (s *Server) checkAssumeRole(args *types.CLIArgs) error {
+	if args.AssumeRole == "" {
+		if len(args.Accounts) > 0 || args.ExternalID != "" {
+			return fmt.Errorf("%w: accounts and external_id require assume_role", errBadRequest)
+		}
+		return nil
+	}
+	if len(s.allowedRoles) == 0 {
+		return fmt.Errorf("%w: assume_role is disabled; start the server with --allow-assume-role", errBadRequest)
+	}
+	if !slices.Contains(s.allowedRoles, args.AssumeRole) {
+		return fmt.Errorf("%w: assume_role %q is not allowed by the server", errBadRequest, args.AssumeRole)
+	}
+	return nil
+}
+
+// parseQuery converte os parâmetros da query string em CLIArgs.
+// Listas aceitam tanto parâmetros repetidos quanto valores separados por vírgula, exceto
+// tag, em que a vírgula faz parte da sintaxe do filtro e cada parâmetro é uma expressão.
+func parseQuery(r *http.Request, report types.ReportKind) (*types.CLIArgs, error) {
+	q := r.URL.Query()
+	args := &types.CLIArgs{
+		Profiles:    splitList(q["profiles"]),
+		Regions:     splitList(q["regions"]),
+		Tag:         q["tag"],
+		From:        q.Get("from"),
+		To:          q.Get("to"),
+		Month:       q.Get("month"),
+		Metric:      q.Get("metric"),
+		GroupBy:     splitList(q["group_by"]),
+		Exclude:     splitList(q["exclude"]),
+		Checks:      splitList(q["checks"]),
+		Granularity: q.Get("granularity"),
+		TrendSeries: q.Get("series"),
+		Term:        q.Get("term"),
+		Payment:     q.Get("payment_option"),
+		AssumeRole:  q.Get("assume_role"),
+		Accounts:    splitList(q["accounts"]),
+		ExternalID:  q.Get("external_id"),
+		Report:      report,
+	}
+
+	var err error
+	if args.All, err = parseBool(q.Get("all"), "all"); err != nil {
+		return nil, err
+	}
+	if args.Combine, err = parseBool(q.Get("combine"), "combine"); err != nil {
+		return nil, err
+	}
+	if args.Org, err = parseBool(q.Get("org"), "org"); err != nil {
+		return nil, err
+	}
+	if args.BreakdownCosts, err = parseBool(q.Get("breakdown"), "breakdown"); err != nil {
+		return nil, err
+	}
+
+	if v := q.Get("time_range"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days <= 0 {
+			return nil, fmt.Errorf("%w: time_range must be a positive number of days, got %q", errBadRequest, v)
+		}
+		args.TimeRange = &days
+	}
+	if v := q.Get("trend_months"); v != "" {
+		months, err := strconv.Atoi(v)
+		if err != nil || months <= 0 {
+			return nil, fmt.Errorf("%w: trend_months must be a positive number of months, got %q", errBadRequest, v)
+		}
+		args.TrendMonths = months
+	}
+	if v := q.Get("expiring_within"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days <= 0 {
+			return nil, fmt.Errorf("%w: expiring_within must be a positive number of days, got %q", errBadRequest, v)
+		}
+		args.ExpiringWithin = days
+	}
+	if v := q.Get("lookback_days"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days <= 0 {
+			return nil, fmt.Errorf("%w: lookback_days must be a positive number of days, got %q", errBadRequest, v)
+		}
+		args.LookbackDays = days
+	}
+
+	return args, nil
+}
+
+func parseBool(v, name string) (bool, error) {
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s must be a boolean, got %q", errBadRequest, name, v)
+	}
+	return b, nil
+}
+
+func splitList(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				out = append(out, item)
+			}
+		}
+	}
+	return out
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -18,19 +19,40 @@ type CLIApp struct {
 	version          string
 }
 
+// legacyReportFlags mapeia as flags antigas do comando raiz para os subcomandos equivalentes.
+// Continuam aceitas por compatibilidade, mas ficam ocultas na ajuda.
+var legacyReportFlags = []struct {
+	Flag   string
+	Report types.ReportKind
+}{
+	{Flag: "audit", Report: types.ReportAudit},
+	{Flag: "trend", Report: types.ReportTrend},
+	{Flag: "transfer", Report: types.ReportTransfer},
+	{Flag: "logs-audit", Report: types.ReportLogs},
+	{Flag: "s3-audit", Report: types.ReportS3},
+	{Flag: "commitments", Report: types.ReportCommitments},
+	{Flag: "full-audit", Report: types.ReportFullAudit},
+}
+
 // NewCLIApp cria uma nova aplicação CLI.
 func NewCLIApp(versionStr string) *CLIApp {
 	app := &CLIApp{version: versionStr}
 	formattedVersion := version.FormatVersion()
 
 	rootCmd := &cobra.Command{
-		Use:     "aws-finops",
-		Short:   "AWS FinOps Dashboard CLI",
+		Use:   "aws-finops",
+		Short: "AWS FinOps Dashboard CLI",
+		Long: `AWS FinOps Dashboard CLI
+
+Without a subcommand, displays the cost dashboard (same as 'aws-finops cost').
+Use one of the subcommands below to run a specific report.`,
 		Version: formattedVersion,
-		RunE:    app.runCommand,
+		Args:    cobra.NoArgs,
+		RunE:    app.runRootCommand,
 	}
 	rootCmd.SetVersionTemplate(`{{printf "AWS FinOps Dashboard version: %s\n" .Version}}`)
 
+	// Flags comuns a todos os relatórios
 	rootCmd.PersistentFlags().StringP("config-file", "C", "", "Path to a TOML, YAML, or JSON configuration file")
 	rootCmd.PersistentFlags().StringSliceP("profiles", "p", nil, "Specific AWS profiles to use (comma-separated)")
 	rootCmd.PersistentFlags().StringSliceP("regions", "r", nil, "AWS regions to check for EC2 instances (comma-separated)")
@@ -39,16 +61,26 @@ func NewCLIApp(versionStr string) *CLIApp {
 	rootCmd.PersistentFlags().StringP("report-name", "n", "", "Specify the base name for the report file (without extension)")
 	rootCmd.PersistentFlags().StringSliceP("report-type", "y", []string{"csv"}, "Specify report types: csv, json, pdf")
 	rootCmd.PersistentFlags().StringP("dir", "d", "", "Directory to save the report files (default: current directory)")
-	rootCmd.PersistentFlags().IntP("time-range", "t", 0, "Time range for cost data in days (default: current month)")
-	rootCmd.PersistentFlags().StringSliceP("tag", "g", nil, "Cost allocation tag to filter resources, e.g., --tag Team=DevOps")
-	rootCmd.PersistentFlags().Bool("trend", false, "Display a trend report for the past 6 months")
-	rootCmd.PersistentFlags().Bool("audit", false, "Display an audit report with potential cost savings")
-	rootCmd.PersistentFlags().Bool("breakdown-costs", false, "Show a detailed cost breakdown for services like Data Transfer.")
-	rootCmd.PersistentFlags().Bool("transfer", false, "Display a Data Transfer Deep Dive report")
-	rootCmd.PersistentFlags().Bool("logs-audit", false, "Display a CloudWatch Logs Retention Audit report")
-	rootCmd.PersistentFlags().Bool("s3-audit", false, "Display an S3 Lifecycle Audit report")
-	rootCmd.PersistentFlags().Bool("commitments", false, "Display Savings Plans/RI Coverage & Utilization report")
-	rootCmd.PersistentFlags().Bool("full-audit", false, "Run all audit reports sequentially (audit, transfer, logs, s3, commitments)")
+	rootCmd.PersistentFlags().StringArrayP("tag", "g", nil, "Cost filter expression, repeatable and ANDed, e.g. --tag Team=DevOps, --tag 'Team=a,b', --tag 'Env!=prod | Owner=<absent>', --tag REGION=us-east-1")
+	rootCmd.PersistentFlags().String("assume-role", "", "Role name to assume in each account from the base profile, e.g. OrganizationAccountAccessRole")
+	rootCmd.PersistentFlags().StringSlice("accounts", nil, "Account IDs to access with --assume-role (default: all active accounts of the organization)")
+	rootCmd.PersistentFlags().String("external-id", "", "External ID to use with --assume-role")
+	rootCmd.PersistentFlags().Duration("role-duration", 0, "Session duration of the assumed role (default: 1h)")
+	rootCmd.PersistentFlags().String("history-dir", "", "Directory of the local snapshot history (default: ~/.aws-finops/history)")
+	rootCmd.PersistentFlags().String("cache-dir", "", "Directory of the Cost Explorer response cache (default: ~/.aws-finops/cache)")
+	rootCmd.PersistentFlags().Bool("verbose", false, "Show run details, such as Cost Explorer response cache hits")
+
+	// Flags do dashboard de custos (comando raiz)
+	addCostFlags(rootCmd)
+	addRecordFlags(rootCmd)
+
+	// Flags legadas: mantidas para não quebrar scripts existentes
+	for _, legacy := range legacyReportFlags {
+		rootCmd.Flags().Bool(legacy.Flag, false, fmt.Sprintf("Deprecated: use 'aws-finops %s'", legacy.Report))
+		_ = rootCmd.Flags().MarkDeprecated(legacy.Flag, fmt.Sprintf("use 'aws-finops %s' instead", legacy.Report))
+	}
+
+	rootCmd.AddCommand(app.newReportCommands()...)
 
 	app.rootCmd = rootCmd
 	return app
@@ -60,25 +92,51 @@ func (app *CLIApp) Execute() error {
 }
 
 // parseArgs parses command-line arguments into a CLIArgs struct.
-func (app *CLIApp) parseArgs() (*types.CLIArgs, error) {
-	configFile, _ := app.rootCmd.Flags().GetString("config-file")
-	profiles, _ := app.rootCmd.Flags().GetStringSlice("profiles")
-	regions, _ := app.rootCmd.Flags().GetStringSlice("regions")
-	all, _ := app.rootCmd.Flags().GetBool("all")
-	combine, _ := app.rootCmd.Flags().GetBool("combine")
-	reportName, _ := app.rootCmd.Flags().GetString("report-name")
-	reportType, _ := app.rootCmd.Flags().GetStringSlice("report-type")
-	dir, _ := app.rootCmd.Flags().GetString("dir")
-	timeRange, _ := app.rootCmd.Flags().GetInt("time-range")
-	tag, _ := app.rootCmd.Flags().GetStringSlice("tag")
-	trend, _ := app.rootCmd.Flags().GetBool("trend")
-	audit, _ := app.rootCmd.Flags().GetBool("audit")
-	breakdownCosts, _ := app.rootCmd.Flags().GetBool("breakdown-costs")
-	transfer, _ := app.rootCmd.Flags().GetBool("transfer")
-	logsAudit, _ := app.rootCmd.Flags().GetBool("logs-audit")
-	s3Audit, _ := app.rootCmd.Flags().GetBool("s3-audit")
-	commitments, _ := app.rootCmd.Flags().GetBool("commitments")
-	fullAudit, _ := app.rootCmd.Flags().GetBool("full-audit")
+// Flags que não pertencem ao comando em execução retornam o valor zero.
+func (app *CLIApp) parseArgs(cmd *cobra.Command, report types.ReportKind) (*types.CLIArgs, error) {
+	flags := cmd.Flags()
+	configFile, _ := flags.GetString("config-file")
+	profiles, _ := flags.GetStringSlice("profiles")
+	regions, _ := flags.GetStringSlice("regions")
+	all, _ := flags.GetBool("all")
+	combine, _ := flags.GetBool("combine")
+	org, _ := flags.GetBool("org")
+	reportName, _ := flags.GetString("report-name")
+	reportType, _ := flags.GetStringSlice("report-type")
+	dir, _ := flags.GetString("dir")
+	timeRange, _ := flags.GetInt("time-range")
+	from, _ := flags.GetString("from")
+	to, _ := flags.GetString("to")
+	month, _ := flags.GetString("month")
+	metric, _ := flags.GetString("metric")
+	groupBy, _ := flags.GetStringSlice("group-by")
+	tag, _ := flags.GetStringArray("tag")
+	exclude, _ := flags.GetStringSlice("exclude")
+	trendMonths, _ := flags.GetInt("trend-months")
+	granularity, _ := flags.GetString("granularity")
+	trendSeries, _ := flags.GetString("series")
+	term, _ := flags.GetString("term")
+	payment, _ := flags.GetString("payment-option")
+	lookbackDays, _ := flags.GetInt("lookback-days")
+	expiringWithin, _ := flags.GetInt("expiring-within")
+	priceList, _ := flags.GetString("price-list")
+	checks, _ := flags.GetStringSlice("checks")
+	suppressions, _ := flags.GetString("suppressions")
+	baseline, _ := flags.GetString("baseline")
+	onlyNew, _ := flags.GetBool("only-new")
+	historyDir, _ := flags.GetString("history-dir")
+	noHistory, _ := flags.GetBool("no-history")
+	cacheDir, _ := flags.GetString("cache-dir")
+	cacheTTL, _ := flags.GetDuration("cache-ttl")
+	noCache, _ := flags.GetBool("no-cache")
+	verbose, _ := flags.GetBool("verbose")
+	record, _ := flags.GetString("record")
+	replay, _ := flags.GetString("replay")
+	breakdownCosts, _ := flags.GetBool("breakdown-costs")
+	assumeRole, _ := flags.GetString("assume-role")
+	accounts, _ := flags.GetStringSlice("accounts")
+	externalID, _ := flags.GetString("external-id")
+	roleDuration, _ := flags.GetDuration("role-duration")
 
 	if dir == "" {
 		cwd, err := os.Getwd()
@@ -105,29 +163,69 @@ func (app *CLIApp) parseArgs() (*types.CLIArgs, error) {
 		Regions:        regions,
 		All:            all,
 		Combine:        combine,
+		Org:            org,
 		ReportName:     reportName,
 		ReportType:     reportType,
 		Dir:            dir,
 		TimeRange:      timeRangePtr,
+		From:           from,
+		To:             to,
+		Month:          month,
+		Metric:         metric,
+		GroupBy:        groupBy,
 		Tag:            tag,
-		Trend:          trend,
-		Audit:          audit,
+		Exclude:        exclude,
 		BreakdownCosts: breakdownCosts,
-		Transfer:       transfer,
-		LogsAudit:      logsAudit,
-		S3Audit:        s3Audit,
-		Commitments:    commitments,
-		FullAudit:      fullAudit,
+		TrendMonths:    trendMonths,
+		Granularity:    granularity,
+		TrendSeries:    trendSeries,
+		Term:           term,
+		Payment:        payment,
+		LookbackDays:   lookbackDays,
+		ExpiringWithin: expiringWithin,
+		PriceList:      priceList,
+		Checks:         checks,
+		Suppressions:   suppressions,
+		Baseline:       baseline,
+		OnlyNew:        onlyNew,
+		HistoryDir:     historyDir,
+		NoHistory:      noHistory,
+		CacheDir:       cacheDir,
+		CacheTTL:       cacheTTL,
+		NoCache:        noCache,
+		Verbose:        verbose,
+		Record:         record,
+		Replay:         replay,
+		AssumeRole:     assumeRole,
+		Accounts:       accounts,
+		ExternalID:     externalID,
+		RoleDuration:   roleDuration,
+		Report:         report,
 	}
 	return args, nil
 }
 
-// runCommand é o ponto de entrada principal para o comando CLI.
-func (app *CLIApp) runCommand(cmd *cobra.Command, args []string) error {
+// runRootCommand trata o comando raiz: dashboard de custos ou uma flag legada de relatório.
+func (app *CLIApp) runRootCommand(cmd *cobra.Command, args []string) error {
+	var report types.ReportKind
+	for _, legacy := range legacyReportFlags {
+		if set, _ := cmd.Flags().GetBool(legacy.Flag); !set {
+			continue
+		}
+		if report != "" {
+			return types.ErrConflictingReports
+		}
+		report = legacy.Report
+	}
+	return app.runReport(cmd, report)
+}
+
+// runReport é o ponto de entrada comum a todos os comandos de relatório.
+func (app *CLIApp) runReport(cmd *cobra.Command, report types.ReportKind) error {
 	displayWelcomeBanner(app.version)
 	go version.CheckLatestVersion(app.version)
 
-	cliArgs, err := app.parseArgs()
+	cliArgs, err := app.parseArgs(cmd, report)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,426 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/adapter/driving/api"
+	"github.com/diillson/aws-finops-dashboard-go/internal/adapter/driving/metrics"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/diillson/aws-finops-dashboard-go/pkg/console"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// newReportCommands cria um subcomando para cada relatório, cada um com suas próprias flags.
+func (app *CLIApp) newReportCommands() []*cobra.Command {
+	cost := app.newReportCommand(types.ReportCost, &cobra.Command{
+		Use:   "cost",
+		Short: "Display the cost dashboard (default when no subcommand is given)",
+		Long: `Display the cost dashboard: previous vs. current period cost, cost by service,
+budget status and EC2 instance summary for each profile.`,
+	})
+	addCostFlags(cost)
+
+	audit := app.newReportCommand(types.ReportAudit, &cobra.Command{
+		Use:   "audit",
+		Short: "Display an audit report with potential cost savings",
+		Long: `Audit each profile for potential savings: budget alerts, high-cost NAT Gateways,
+unused VPC endpoints, idle load balancers, stopped EC2 instances, unused EBS volumes,
+unused Elastic IPs and untagged resources.
+
+Stopped instances (attached EBS), unused volumes, unused Elastic IPs and idle load balancers
+are priced at on-demand list prices, from the AWS Pricing API or from an offline --price-list
+file, and summed into a potential monthly savings total per account.
+
+Use --checks to run a subset of the checks; 'aws-finops checks list' describes them.
+Findings matched by a --suppressions file (e.g. DR standbys) are left out of the counts and
+savings and listed as suppressed in the exports until the suppression expires.
+With --baseline (a previous audit or full-audit JSON export), each finding is marked new,
+unchanged or resolved since that run; add --only-new to focus on regressions.`,
+	})
+	addPeriodFlags(audit)
+	addMetricFlag(audit)
+	addPriceListFlag(audit)
+	addChecksFlag(audit)
+	addSuppressionsFlag(audit)
+	addBaselineFlags(audit)
+	addNoHistoryFlag(audit)
+	addCacheFlags(audit)
+
+	trend := app.newReportCommand(types.ReportTrend, &cobra.Command{
+		Use:   "trend",
+		Short: "Display a cost trend report (past 6 months by default)",
+		Long: `Display the cost history of each profile over the past --trend-months months plus the
+current month-to-date, by month, week or day. With --series, each point is split by service,
+tag or another dimension into stacked series; the PDF export includes a stacked bar chart.`,
+	})
+	addMetricFlag(trend)
+	addExcludeFlag(trend)
+	trend.Flags().Int("trend-months", 0, fmt.Sprintf("Number of past months in the trend (default: %d, max: %d monthly or %d daily/weekly)", entity.DefaultTrendMonths, entity.MaxTrendMonths, entity.MaxDailyTrendMonths))
+	trend.Flags().String("granularity", "", "Trend granularity: monthly, weekly or daily (default: monthly)")
+	trend.Flags().String("series", "", "Split the trend into stacked series by SERVICE, REGION, LINKED_ACCOUNT, TAG:<key>, COST_CATEGORY:<name>...")
+	addNoHistoryFlag(trend)
+	addCacheFlags(trend)
+
+	transfer := app.newReportCommand(types.ReportTransfer, &cobra.Command{
+		Use:   "transfer",
+		Short: "Display a Data Transfer Deep Dive report",
+		Long: `Break down data transfer costs by category (Internet, Inter-Region,
+Cross-AZ/Regional, NAT Gateway, Other) and list the top service/usage type lines.`,
+	})
+	addPeriodFlags(transfer)
+	addMetricFlag(transfer)
+	addCacheFlags(transfer)
+
+	logs := app.newReportCommand(types.ReportLogs, &cobra.Command{
+		Use:     "logs",
+		Aliases: []string{"logs-audit"},
+		Short:   "Display a CloudWatch Logs Retention Audit report",
+		Long:    `List CloudWatch Log Groups without a retention policy ("Never expire"), ordered by stored size.`,
+	})
+
+	s3 := app.newReportCommand(types.ReportS3, &cobra.Command{
+		Use:     "s3",
+		Aliases: []string{"s3-audit"},
+		Short:   "Display an S3 Lifecycle Audit report",
+		Long: `Audit S3 buckets for missing lifecycle rules, versioning without noncurrent rules,
+missing Intelligent-Tiering, missing default encryption and public access risk.`,
+	})
+
+	commitments := app.newReportCommand(types.ReportCommitments, &cobra.Command{
+		Use:   "commitments",
+		Short: "Display Savings Plans/RI Coverage & Utilization report",
+		Long: `Display Savings Plans and Reserved Instances coverage and utilization for the period,
+followed by Cost Explorer purchase recommendations for Compute Savings Plans and EC2, RDS,
+ElastiCache, OpenSearch and Redshift reservations: hourly commitment, upfront cost, estimated
+monthly savings, ROI and break-even. The two lists are alternatives for the same usage.`,
+	})
+	addPeriodFlags(commitments)
+	commitments.Flags().String("term", "", "Commitment term of the purchase recommendations: 1y or 3y (default: 1y)")
+	commitments.Flags().String("payment-option", "", "Payment option of the purchase recommendations: no-upfront, partial-upfront or all-upfront (default: no-upfront)")
+	commitments.Flags().Int("lookback-days", 0, "Usage lookback period of the purchase recommendations: 7, 30 or 60 days (default: 30)")
+	addCacheFlags(commitments)
+
+	expirations := app.newReportCommand(types.ReportExpirations, &cobra.Command{
+		Use:     "expirations",
+		Aliases: []string{"commitment-expirations"},
+		Short:   "Display active Savings Plans and reservations by end date",
+		Long: `List the active Savings Plans and the EC2, RDS, ElastiCache, OpenSearch and Redshift
+reservations with their start and end dates, ordered by end date. Commitments ending within
+--expiring-within days are highlighted, so renewals can be planned before the workloads fall
+back to on-demand pricing.`,
+	})
+	addExpiringWithinFlag(expirations)
+	addCacheFlags(expirations)
+
+	anomalies := app.newReportCommand(types.ReportAnomalies, &cobra.Command{
+		Use:   "anomalies",
+		Short: "Display a cost anomaly report",
+		Long: `List cost anomalies in the period with their root causes and impact. Accounts with
+AWS Cost Anomaly Detection monitors use the anomalies found by the monitors; accounts without
+monitors run a local z-score detector over the daily cost of each service.`,
+	})
+	addPeriodFlags(anomalies)
+	addMetricFlag(anomalies)
+	addExcludeFlag(anomalies)
+	addCacheFlags(anomalies)
+
+	rightsizing := app.newReportCommand(types.ReportRightsizing, &cobra.Command{
+		Use:   "rightsizing",
+		Short: "Display EC2 rightsizing recommendations",
+		Long: `List the EC2 instances that Cost Explorer recommends terminating or downsizing (same
+instance family), with current and target type, maximum CPU/memory utilization and estimated
+monthly savings. Honors --tag and --regions.`,
+	})
+	addCacheFlags(rightsizing)
+
+	fullAudit := app.newReportCommand(types.ReportFullAudit, &cobra.Command{
+		Use:   "full-audit",
+		Short: "Run all audit reports (audit, transfer, logs, s3, commitments, expirations)",
+	})
+	addPeriodFlags(fullAudit)
+	addMetricFlag(fullAudit)
+	addExpiringWithinFlag(fullAudit)
+	addPriceListFlag(fullAudit)
+	addChecksFlag(fullAudit)
+	addSuppressionsFlag(fullAudit)
+	addBaselineFlags(fullAudit)
+	addNoHistoryFlag(fullAudit)
+	addCacheFlags(fullAudit)
+
+	return []*cobra.Command{cost, audit, trend, transfer, logs, s3, commitments, expirations, anomalies, rightsizing, fullAudit, app.newChecksCommand(), app.newHistoryCommand(), app.newCacheCommand(), app.newServeCommand(), app.newExporterCommand()}
+}
+
+// newChecksCommand cria o grupo de subcomandos das verificações da auditoria.
+func (app *CLIApp) newChecksCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checks",
+		Short: "Inspect the checks run by the audit and full-audit reports",
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List every audit check with its description and required IAM actions",
+		Args:  cobra.NoArgs,
+		Run: func(*cobra.Command, []string) {
+			app.dashboardUseCase.ListChecks()
+		},
+	})
+	return cmd
+}
+
+// newHistoryCommand cria o grupo de subcomandos do histórico local de snapshots.
+func (app *CLIApp) newHistoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Browse the local history of cost, trend and audit snapshots",
+		Long: `Each cost, trend, audit and full-audit run saves a snapshot of its results to a local
+history under --history-dir (default: ~/.aws-finops/history), unless --no-history is given.
+These commands read the snapshots without calling AWS; --profiles limits them to some profiles.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List the saved snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			cliArgs, err := app.parseArgs(c, "")
+			if err != nil {
+				return err
+			}
+			return app.dashboardUseCase.ListHistory(cliArgs)
+		},
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "show [id]",
+		Short: "Display a saved snapshot (default: the latest)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			cliArgs, err := app.parseArgs(c, "")
+			if err != nil {
+				return err
+			}
+			id := ""
+			if len(args) > 0 {
+				id = args[0]
+			}
+			return app.dashboardUseCase.ShowHistory(cliArgs, id)
+		},
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "trend",
+		Short: "Chart monthly costs and audit finding counts across snapshots",
+		Long: `Merge the monthly costs of the cost and trend snapshots into one chart per profile, cost
+metric and filter, going back further than the 13 months kept by Cost Explorer, and chart the
+number of audit findings in each audit and full-audit snapshot. Cost snapshots only count when
+their period is within one calendar month; the latest snapshot of a month wins.`,
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			cliArgs, err := app.parseArgs(c, "")
+			if err != nil {
+				return err
+			}
+			return app.dashboardUseCase.HistoryTrend(cliArgs)
+		},
+	})
+	return cmd
+}
+
+// newCacheCommand cria o grupo de subcomandos do cache das respostas do Cost Explorer.
+func (app *CLIApp) newCacheCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the on-disk cache of Cost Explorer responses",
+		Long: `Cost Explorer responses are cached under --cache-dir (default: ~/.aws-finops/cache), keyed by
+profile, account, request parameters and day, and reused for --cache-ttl (default: 1h), since each
+Cost Explorer request is billed. Use --no-cache on a report to bypass the cache and --verbose to see
+the cache hits.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "clear",
+		Short: "Remove every cached Cost Explorer response",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			cliArgs, err := app.parseArgs(c, "")
+			if err != nil {
+				return err
+			}
+			return app.dashboardUseCase.ClearCache(cliArgs)
+		},
+	})
+	return cmd
+}
+
+// newServeCommand cria o subcomando que expõe os relatórios como uma API HTTP JSON.
+func (app *CLIApp) newServeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the reports as a local JSON HTTP API",
+		Long: `Start a local HTTP server exposing the reports as JSON:
+
+  GET /api/v1/{cost,audit,trend,transfer,logs,s3,commitments,expirations,anomalies,rightsizing}
+  GET /healthz
+
+Query parameters: profiles, regions, tag (repeatable or comma-separated),
+time_range (days), from, to, month, metric, group_by, exclude, all, combine, org,
+breakdown, assume_role, accounts, external_id, trend_months, granularity and
+series for the trend report, term, payment_option and lookback_days for the
+commitments report, expiring_within for the expirations report and checks for
+the audit report. AWS clients are reused across requests.
+
+Roles are assumed with the server's own credentials, so assume_role (and with it
+accounts and external_id) is rejected unless the role is listed in --allow-assume-role.`,
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			addr, _ := c.Flags().GetString("addr")
+			allowedRoles, _ := c.Flags().GetStringSlice("allow-assume-role")
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			server := api.NewServer(app.dashboardUseCase.WithConsole(console.NewQuietConsole()), addr, allowedRoles)
+			pterm.Info.Printfln("Serving AWS FinOps API on http://%s (Ctrl+C to stop)", addr)
+			return server.ListenAndServe(ctx)
+		},
+	}
+	cmd.Flags().String("addr", "127.0.0.1:8080", "Address for the HTTP server to listen on")
+	cmd.Flags().StringSlice("allow-assume-role", nil, "IAM role names that API requests may assume with assume_role (disabled by default)")
+	return cmd
+}
+
+// newReportCommand liga um subcomando ao relatório correspondente do caso de uso.
+func (app *CLIApp) newReportCommand(report types.ReportKind, cmd *cobra.Command) *cobra.Command {
+	cmd.Args = cobra.NoArgs
+	cmd.RunE = func(c *cobra.Command, _ []string) error {
+		return app.runReport(c, report)
+	}
+	addRecordFlags(cmd)
+	return cmd
+}
+
+// addCostFlags registra as flags do dashboard de custos.
+func addCostFlags(cmd *cobra.Command) {
+	addPeriodFlags(cmd)
+	addMetricFlag(cmd)
+	addExcludeFlag(cmd)
+	cmd.Flags().StringSlice("group-by", nil, "Group costs by up to two keys: SERVICE, LINKED_ACCOUNT, REGION, USAGE_TYPE, INSTANCE_TYPE, TAG:<key> or COST_CATEGORY:<name> (default: SERVICE)")
+	cmd.Flags().Bool("breakdown-costs", false, "Show a detailed cost breakdown for services like Data Transfer.")
+	cmd.Flags().Bool("org", false, "Organization mode: show one row per member account of the management account profile, with account name and OU path")
+	addNoHistoryFlag(cmd)
+	addCacheFlags(cmd)
+}
+
+// addPeriodFlags registra as flags de período para relatórios baseados no Cost Explorer.
+func addPeriodFlags(cmd *cobra.Command) {
+	cmd.Flags().IntP("time-range", "t", 0, "Time range for cost data in days (default: current month)")
+	cmd.Flags().String("from", "", "Start date of a custom period, inclusive (YYYY-MM-DD, requires --to)")
+	cmd.Flags().String("to", "", "End date of a custom period, inclusive (YYYY-MM-DD, requires --from)")
+	cmd.Flags().String("month", "", "Calendar month to report on (YYYY-MM)")
+	cmd.MarkFlagsRequiredTogether("from", "to")
+	cmd.MarkFlagsMutuallyExclusive("time-range", "month", "from")
+	cmd.MarkFlagsMutuallyExclusive("time-range", "month", "to")
+}
+
+// addMetricFlag registra a flag de escolha da métrica de custo do Cost Explorer.
+func addMetricFlag(cmd *cobra.Command) {
+	cmd.Flags().String("metric", "", "Cost metric: unblended, blended, amortized, net-amortized or net-unblended (default: unblended)")
+}
+
+// addExcludeFlag registra a flag que remove tipos de registro (RECORD_TYPE) dos totais.
+func addExcludeFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("exclude", nil, "Record types to exclude from cost totals, shown as separate lines: credits, refunds, tax, support")
+}
+
+// addExpiringWithinFlag registra a janela de destaque dos compromissos perto do fim.
+func addExpiringWithinFlag(cmd *cobra.Command) {
+	cmd.Flags().Int("expiring-within", 0, fmt.Sprintf("Highlight Savings Plans and reservations ending within this many days (default: %d)", entity.DefaultExpirationWarningDays))
+}
+
+// addPriceListFlag registra o arquivo de preços offline usado na estimativa de economia da auditoria.
+func addPriceListFlag(cmd *cobra.Command) {
+	cmd.Flags().String("price-list", "", "Offline price list JSON used instead of the AWS Pricing API to estimate potential savings")
+}
+
+// addChecksFlag registra a seleção das verificações da auditoria.
+func addChecksFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("checks", nil, "Audit checks to run, by ID; prefix an ID with '-' to exclude it, e.g. --checks=-untagged-resource (default: all, see 'aws-finops checks list')")
+}
+
+// addSuppressionsFlag registra o arquivo de supressões dos achados da auditoria.
+func addSuppressionsFlag(cmd *cobra.Command) {
+	cmd.Flags().String("suppressions", "", "TOML, YAML or JSON file of audit findings to suppress, each with a reason and an optional expiry date")
+}
+
+// addBaselineFlags registra a comparação dos achados com uma auditoria anterior.
+func addBaselineFlags(cmd *cobra.Command) {
+	cmd.Flags().String("baseline", "", "JSON export of a previous audit or full-audit; findings are marked new, unchanged or resolved since it")
+	cmd.Flags().Bool("only-new", false, "With --baseline, show and export only the findings that are new since the baseline")
+}
+
+// addNoHistoryFlag registra a flag que desliga a gravação do snapshot no histórico local.
+func addNoHistoryFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("no-history", false, "Do not save a snapshot of this run to the local history (see 'aws-finops history')")
+}
+
+// addCacheFlags registra as flags do cache em disco das respostas do Cost Explorer.
+func addCacheFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("no-cache", false, "Always call Cost Explorer instead of reusing cached responses (see 'aws-finops cache clear')")
+	cmd.Flags().Duration("cache-ttl", 0, "How long a cached Cost Explorer response is reused, within the same day (default: 1h)")
+}
+
+// addRecordFlags registra a gravação e a reprodução das respostas da AWS.
+func addRecordFlags(cmd *cobra.Command) {
+	cmd.Flags().String("record", "", "Record every AWS API response of this run to a directory, with credentials redacted")
+	cmd.Flags().String("replay", "", "Replay the AWS API responses recorded with --record from a directory, without calling AWS")
+	cmd.MarkFlagsMutuallyExclusive("record", "replay")
+}
+
+// newExporterCommand cria o subcomando que publica métricas no formato Prometheus.
+func (app *CLIApp) newExporterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exporter",
+		Short: "Publish cost, commitment and audit metrics for Prometheus on /metrics",
+		Long: `Start a Prometheus/OpenMetrics exporter on /metrics with per-profile cost totals,
+cost by service, budget actual vs. limit, Savings Plans/RI coverage and utilization,
+and audit resource counts.
+
+Data is collected at startup and then once per refresh interval. Scrapes are served
+from the cached data, so scraping never triggers (billed) Cost Explorer calls.`,
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			addr, _ := c.Flags().GetString("addr")
+			interval, _ := c.Flags().GetDuration("refresh-interval")
+			if interval < time.Minute {
+				return fmt.Errorf("refresh interval must be at least 1m, got %s", interval)
+			}
+
+			cliArgs, err := app.parseArgs(c, types.ReportCost)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			exporter := metrics.NewExporter(app.dashboardUseCase.WithConsole(console.NewQuietConsole()), cliArgs, addr, interval)
+			pterm.Info.Printfln("Serving metrics on http://%s/metrics (refresh every %s, Ctrl+C to stop)", addr, interval)
+			return exporter.ListenAndServe(ctx, func(err error) {
+				pterm.Error.Printfln("%v", err)
+			})
+		},
+	}
+	addPeriodFlags(cmd)
+	addMetricFlag(cmd)
+	addExcludeFlag(cmd)
+	addSuppressionsFlag(cmd)
+	cmd.Flags().String("addr", "127.0.0.1:9725", "Address for the metrics server to listen on")
+	cmd.Flags().Duration("refresh-interval", time.Hour, "How often to refresh the data from AWS (minimum 1m)")
+	return cmd
+}
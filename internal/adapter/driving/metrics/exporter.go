@@ -0,0 +1,229 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/application/usecase"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "aws_finops"
+
+var (
+	profileLabels = []string{"profile", "account_id"}
+
+	upDesc = prometheus.NewDesc(namespace+"_profile_up",
+		"Whether all data for the profile was collected successfully in the last refresh (1) or not (0).",
+		[]string{"profile"}, nil)
+	lastRefreshDesc = prometheus.NewDesc(namespace+"_last_refresh_timestamp_seconds",
+		"Unix timestamp of the last successful refresh.", nil, nil)
+	refreshDurationDesc = prometheus.NewDesc(namespace+"_last_refresh_duration_seconds",
+		"Duration of the last successful refresh.", nil, nil)
+	refreshErrorsDesc = prometheus.NewDesc(namespace+"_refresh_errors_total",
+		"Number of refreshes that failed.", nil, nil)
+
+	currentCostDesc = prometheus.NewDesc(namespace+"_cost_current_period_usd",
+		"Total cost of the current period.", profileLabels, nil)
+	previousCostDesc = prometheus.NewDesc(namespace+"_cost_previous_period_usd",
+		"Total cost of the previous period.", profileLabels, nil)
+	serviceCostDesc = prometheus.NewDesc(namespace+"_service_cost_usd",
+		"Cost of the current period by service.", append(profileLabels, "service"), nil)
+	ec2InstancesDesc = prometheus.NewDesc(namespace+"_ec2_instances",
+		"Number of EC2 instances by state.", append(profileLabels, "state"), nil)
+
+	budgetActualDesc = prometheus.NewDesc(namespace+"_budget_actual_usd",
+		"Actual spend of the budget.", append(profileLabels, "budget"), nil)
+	budgetLimitDesc = prometheus.NewDesc(namespace+"_budget_limit_usd",
+		"Limit of the budget.", append(profileLabels, "budget"), nil)
+	budgetForecastDesc = prometheus.NewDesc(namespace+"_budget_forecast_usd",
+		"Forecasted spend of the budget.", append(profileLabels, "budget"), nil)
+
+	spCoverageDesc = prometheus.NewDesc(namespace+"_savings_plans_coverage_percent",
+		"Savings Plans coverage.", profileLabels, nil)
+	spUtilizationDesc = prometheus.NewDesc(namespace+"_savings_plans_utilization_percent",
+		"Savings Plans utilization.", profileLabels, nil)
+	riCoverageDesc = prometheus.NewDesc(namespace+"_reserved_instances_coverage_percent",
+		"Reserved Instances coverage.", profileLabels, nil)
+	riUtilizationDesc = prometheus.NewDesc(namespace+"_reserved_instances_utilization_percent",
+		"Reserved Instances utilization.", profileLabels, nil)
+
+	auditResourcesDesc = prometheus.NewDesc(namespace+"_audit_resources",
+		"Number of resources flagged by each audit check.", append(profileLabels, "check"), nil)
+)
+
+// Exporter periodically collects the dashboard data and publishes it as Prometheus metrics.
+// Os scrapes leem apenas o último snapshot em cache; nenhuma chamada AWS é feita por scrape,
+// já que cada chamada ao Cost Explorer é cobrada.
+type Exporter struct {
+	useCase  *usecase.DashboardUseCase
+	args     *types.CLIArgs
+	addr     string
+	interval time.Duration
+
+	mu            sync.RWMutex
+	snapshot      *usecase.MetricsSnapshot
+	refreshErrors int
+}
+
+// NewExporter creates a new metrics exporter.
+func NewExporter(useCase *usecase.DashboardUseCase, args *types.CLIArgs, addr string, interval time.Duration) *Exporter {
+	return &Exporter{useCase: useCase, args: args, addr: addr, interval: interval}
+}
+
+// Describe implements prometheus.Collector.
+func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		upDesc, lastRefreshDesc, refreshDurationDesc, refreshErrorsDesc,
+		currentCostDesc, previousCostDesc, serviceCostDesc, ec2InstancesDesc,
+		budgetActualDesc, budgetLimitDesc, budgetForecastDesc,
+		spCoverageDesc, spUtilizationDesc, riCoverageDesc, riUtilizationDesc,
+		auditResourcesDesc,
+	} {
+		ch <- d
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+	e.mu.RLock()
+	snapshot := e.snapshot
+	refreshErrors := e.refreshErrors
+	e.mu.RUnlock()
+
+	ch <- prometheus.MustNewConstMetric(refreshErrorsDesc, prometheus.CounterValue, float64(refreshErrors))
+	if snapshot == nil {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(lastRefreshDesc, prometheus.GaugeValue, float64(snapshot.CollectedAt.Unix()))
+	ch <- prometheus.MustNewConstMetric(refreshDurationDesc, prometheus.GaugeValue, snapshot.Duration.Seconds())
+
+	for _, p := range snapshot.Profiles {
+		up := 1.0
+		if len(p.Errors) > 0 {
+			up = 0
+		}
+		ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, up, p.Profile)
+
+		gauge := func(desc *prometheus.Desc, value float64, extra ...string) {
+			labels := append([]string{p.Profile, p.AccountID}, extra...)
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, labels...)
+		}
+
+		if c := p.Cost; c != nil {
+			gauge(currentCostDesc, c.CurrentMonth)
+			gauge(previousCostDesc, c.LastMonth)
+			for _, sc := range c.ServiceCosts {
+				gauge(serviceCostDesc, sc.Cost, sc.ServiceName)
+			}
+			for state, count := range c.EC2Summary {
+				gauge(ec2InstancesDesc, float64(count), state)
+			}
+			for _, b := range c.Budgets {
+				gauge(budgetActualDesc, b.Actual, b.Name)
+				gauge(budgetLimitDesc, b.Limit, b.Name)
+				gauge(budgetForecastDesc, b.Forecast, b.Name)
+			}
+		}
+
+		if cm := p.Commitments; cm != nil {
+			if !cm.SPSummary.DataUnavailable {
+				gauge(spCoverageDesc, cm.SPSummary.CoveragePercent)
+				gauge(spUtilizationDesc, cm.SPSummary.UtilizationPercent)
+			}
+			if !cm.RISummary.DataUnavailable {
+				gauge(riCoverageDesc, cm.RISummary.CoveragePercent)
+				gauge(riUtilizationDesc, cm.RISummary.UtilizationPercent)
+			}
+		}
+
+		if a := p.Audit; a != nil {
+			gauge(auditResourcesDesc, float64(a.StoppedInstances), "stopped_instances")
+			gauge(auditResourcesDesc, float64(a.UnusedVolumes), "unused_volumes")
+			gauge(auditResourcesDesc, float64(a.UnusedEIPs), "unused_eips")
+			gauge(auditResourcesDesc, float64(a.IdleLoadBalancers), "idle_load_balancers")
+			gauge(auditResourcesDesc, float64(a.UnusedVpcEndpoints), "unused_vpc_endpoints")
+			gauge(auditResourcesDesc, float64(a.UntaggedResources), "untagged_resources")
+			gauge(auditResourcesDesc, float64(a.NatGateways), "nat_gateways")
+		}
+	}
+}
+
+// Refresh collects a new snapshot. Em caso de erro, o snapshot anterior continua sendo publicado.
+func (e *Exporter) Refresh(ctx context.Context) error {
+	snapshot, err := e.useCase.CollectMetrics(ctx, e.args)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		e.refreshErrors++
+		return fmt.Errorf("failed to refresh metrics: %w", err)
+	}
+	e.snapshot = snapshot
+	return nil
+}
+
+// Handler returns the HTTP handler serving /metrics.
+func (e *Exporter) Handler() (http.Handler, error) {
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(e); err != nil {
+		return nil, fmt.Errorf("failed to register collector: %w", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	return mux, nil
+}
+
+// ListenAndServe serves /metrics and refreshes the snapshot at every interval until ctx is cancelled.
+// O onError é chamado quando uma atualização falha.
+func (e *Exporter) ListenAndServe(ctx context.Context, onError func(error)) error {
+	handler, err := e.Handler()
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{
+		Addr:              e.addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- srv.ListenAndServe() }()
+	go e.refreshLoop(ctx, onError)
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("failed to shut down metrics server: %w", err)
+		}
+		return nil
+	}
+}
+
+func (e *Exporter) refreshLoop(ctx context.Context, onError func(error)) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		if err := e.Refresh(ctx); err != nil && ctx.Err() == nil && onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
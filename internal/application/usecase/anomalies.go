@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+// anomalyRow é o relatório de anomalias de custo de um grupo de perfis.
+type anomalyRow struct {
+	Profile   string
+	AccountID string
+	Report    entity.AnomalyReport
+	Err       error
+}
+
+func (uc *DashboardUseCase) runAnomalyReport(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) error {
+	uc.console.LogInfo("Looking for cost anomalies (%s)...", opts.Period.Label())
+	if len(opts.Exclude) > 0 {
+		uc.console.LogInfo("Excluding record types from the local detector: %s", entity.RecordTypesLabel(opts.Exclude))
+	}
+
+	results := uc.collectAnomalyReports(ctx, profileGroups, args, opts)
+
+	table := uc.console.CreateTable()
+	table.AddColumn("Profile")
+	table.AddColumn("Account ID")
+	table.AddColumn("Detection")
+	table.AddColumn("Total Impact")
+	table.AddColumn("Top Anomalies (Dates | Service | Account | Region | Impact)")
+
+	for _, r := range results {
+		if r.Err != nil {
+			table.AddRow(
+				pterm.FgMagenta.Sprint(r.Profile),
+				"N/A",
+				"N/A",
+				"N/A",
+				pterm.FgRed.Sprintf("Error: %v", r.Err),
+			)
+			continue
+		}
+
+		detection := fmt.Sprintf("AWS monitors (%d)", len(r.Report.Monitors))
+		if r.Report.LocalDetection {
+			detection = fmt.Sprintf("Local z-score ≥ %.1f", entity.AnomalyZScoreThreshold)
+		}
+
+		lines := []string{pterm.FgGreen.Sprint("No anomalies found")}
+		if len(r.Report.Anomalies) > 0 {
+			lines = lines[:0]
+			limit := len(r.Report.Anomalies)
+			if limit > 5 {
+				limit = 5
+			}
+			for _, a := range r.Report.Anomalies[:limit] {
+				lines = append(lines, formatAnomalyLine(a))
+				if len(a.RootCauses) > 1 {
+					lines = append(lines, pterm.FgGray.Sprintf("  +%d more root cause(s)", len(a.RootCauses)-1))
+				}
+			}
+			if len(r.Report.Anomalies) > limit {
+				lines = append(lines, fmt.Sprintf("... (+%d more)", len(r.Report.Anomalies)-limit))
+			}
+		}
+
+		impact := pterm.FgGreen.Sprint("$0.00")
+		if r.Report.TotalImpact > 0 {
+			impact = pterm.FgRed.Sprintf("$%.2f", r.Report.TotalImpact)
+		}
+
+		table.AddRow(
+			pterm.FgMagenta.Sprint(r.Profile),
+			r.AccountID,
+			detection,
+			impact,
+			strings.Join(lines, "\n"),
+		)
+	}
+	uc.console.Println("\n" + table.Render())
+
+	if args.ReportName != "" {
+		uc.console.LogInfo("Exporting anomaly reports...")
+		reports := make([]entity.AnomalyReport, 0, len(results))
+		for _, r := range results {
+			if r.Err == nil {
+				reports = append(reports, r.Report)
+			}
+		}
+		for _, reportType := range args.ReportType {
+			switch strings.ToLower(reportType) {
+			case "csv":
+				path, err := uc.exportRepo.ExportAnomalyReportToCSV(reports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export anomaly CSV: %v", err)
+				} else {
+					uc.console.LogSuccess("Anomaly CSV saved to: %s", path)
+				}
+			case "json":
+				path, err := uc.exportRepo.ExportAnomalyReportToJSON(reports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export anomaly JSON: %v", err)
+				} else {
+					uc.console.LogSuccess("Anomaly JSON saved to: %s", path)
+				}
+			case "pdf":
+				path, err := uc.exportRepo.ExportAnomalyReportToPDF(reports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export anomaly PDF: %v", err)
+				} else {
+					uc.console.LogSuccess("Anomaly PDF saved to: %s", path)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatAnomalyLine formata uma anomalia como "datas | serviço | conta | região | impacto".
+func formatAnomalyLine(a entity.CostAnomaly) string {
+	dates := a.StartDate.Format("2006-01-02")
+	if !a.EndDate.IsZero() && !a.EndDate.Equal(a.StartDate) {
+		dates += " → " + a.EndDate.Format("2006-01-02")
+	}
+	orDash := func(s string) string {
+		if s == "" {
+			return "-"
+		}
+		return s
+	}
+	return fmt.Sprintf("%s | %s | %s | %s | %s", dates, orDash(a.Service), orDash(a.AccountID), orDash(a.Region),
+		pterm.FgRed.Sprintf("+$%.2f", a.Impact))
+}
+
+// collectAnomalyReports busca as anomalias de custo de cada grupo de perfis, ordenadas por perfil.
+// Contas com monitores de anomalia usam o GetAnomalies do Cost Explorer; as demais passam pelo
+// detector local de z-score sobre os custos diários por serviço.
+func (uc *DashboardUseCase) collectAnomalyReports(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) []anomalyRow {
+	livePrinter, _ := uc.console.GetMultiPrinter().Start()
+	defer livePrinter.Stop()
+
+	results := make([]anomalyRow, 0, len(profileGroups))
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	for _, group := range profileGroups {
+		wg.Add(1)
+		go func(g entity.ProfileGroup) {
+			defer wg.Done()
+
+			// 2 passos: monitores + anomalias (AWS ou detector local)
+			bar := uc.console.NewProgressbar(2, fmt.Sprintf("Anomalies: %s", g.Identifier))
+			bar.Start()
+
+			profile := g.Profiles[0]
+			accountID, _ := uc.awsRepo.GetAccountID(ctx, profile)
+			report := entity.AnomalyReport{
+				Profile:     g.Identifier,
+				AccountID:   accountID,
+				PeriodStart: opts.Period.Start,
+				PeriodEnd:   opts.Period.End,
+			}
+
+			// Sem permissão para listar monitores, a conta é tratada como sem monitores.
+			monitors, _ := uc.awsRepo.GetAnomalyMonitors(ctx, profile)
+			bar.Increment()
+
+			var err error
+			if len(monitors) > 0 {
+				report.Anomalies, err = uc.awsAnomalies(ctx, profile, opts, monitors)
+				for _, m := range monitors {
+					report.Monitors = append(report.Monitors, m.Name)
+				}
+			} else {
+				report.LocalDetection = true
+				report.Anomalies, err = uc.localAnomalies(ctx, profile, accountID, opts, args.Tag)
+			}
+			bar.Increment()
+
+			row := anomalyRow{Profile: g.Identifier, AccountID: accountID, Err: err}
+			if err == nil {
+				for _, a := range report.Anomalies {
+					report.TotalImpact += a.Impact
+				}
+				row.Report = report
+			}
+
+			mu.Lock()
+			results = append(results, row)
+			mu.Unlock()
+		}(group)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Profile < results[j].Profile })
+	return results
+}
+
+// awsAnomalies busca as anomalias dos monitores do Cost Explorer, trocando o ARN do monitor pelo nome.
+func (uc *DashboardUseCase) awsAnomalies(ctx context.Context, profile string, opts reportOptions, monitors []entity.AnomalyMonitor) ([]entity.CostAnomaly, error) {
+	anomalies, err := uc.awsRepo.GetCostAnomalies(ctx, profile, opts.Period)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(monitors))
+	for _, m := range monitors {
+		names[m.ARN] = m.Name
+	}
+	for i := range anomalies {
+		if name, ok := names[anomalies[i].Monitor]; ok {
+			anomalies[i].Monitor = name
+		}
+	}
+	return anomalies, nil
+}
+
+// localAnomalies roda o detector de z-score sobre os custos diários por serviço do período.
+func (uc *DashboardUseCase) localAnomalies(ctx context.Context, profile, accountID string, opts reportOptions, tags []string) ([]entity.CostAnomaly, error) {
+	series, err := uc.awsRepo.GetDailyServiceCosts(ctx, profile, opts.Period, opts.Metric, tags, opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+	anomalies := entity.DetectCostAnomalies(series, opts.Period.Start)
+	for i := range anomalies {
+		anomalies[i].AccountID = accountID
+	}
+	return anomalies, nil
+}
@@ -0,0 +1,296 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/repository"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+)
+
+// Check is an audit check: it looks for one kind of resource in an account and reports each one as a finding.
+type Check interface {
+	// ID identifica a verificação em --checks e no check_id dos achados.
+	ID() string
+	// Title é o nome curto usado como coluna e seção dos relatórios.
+	Title() string
+	Description() string
+	// IAMActions são as permissões necessárias para executar a verificação.
+	IAMActions() []string
+	Run(ctx context.Context, env *CheckEnv) ([]entity.Finding, error)
+}
+
+// CheckEnv is the account a check runs against, with the report options that apply to it.
+type CheckEnv struct {
+	Repo repository.AWSRepository
+	// Profile é o perfil AWS usado nas chamadas; AccountID, a conta auditada.
+	Profile   string
+	AccountID string
+	Regions   []string
+	Period    entity.Period
+	Metric    entity.CostMetric
+	Tag       []string
+
+	// Estado da estimativa de custo, compartilhado pelas verificações do perfil (ver Prices).
+	pricing     bool
+	mu          sync.Mutex
+	prices      entity.PriceList
+	priceSource string
+	offline     bool
+	pricingErr  error
+}
+
+// auditCheck implementa Check para as verificações nativas.
+type auditCheck struct {
+	id, title, description string
+	actions                []string
+	run                    func(ctx context.Context, env *CheckEnv) ([]entity.Finding, error)
+}
+
+func (c auditCheck) ID() string           { return c.id }
+func (c auditCheck) Title() string        { return c.title }
+func (c auditCheck) Description() string  { return c.description }
+func (c auditCheck) IAMActions() []string { return c.actions }
+
+func (c auditCheck) Run(ctx context.Context, env *CheckEnv) ([]entity.Finding, error) {
+	return c.run(ctx, env)
+}
+
+// checkRegistry são as verificações da auditoria, na ordem das colunas e seções dos relatórios.
+var checkRegistry = []Check{
+	auditCheck{
+		id:          entity.CheckBudgetExceeded,
+		title:       "Budget Alerts",
+		description: "AWS Budgets whose actual spend is over the budget limit.",
+		actions:     []string{"budgets:ViewBudget", "sts:GetCallerIdentity"},
+		run: func(ctx context.Context, env *CheckEnv) ([]entity.Finding, error) {
+			budgets, err := env.Repo.GetBudgets(ctx, env.Profile)
+			return budgetFindings(budgets, env.AccountID), err
+		},
+	},
+	auditCheck{
+		id:          entity.CheckHighCostNatGateway,
+		title:       "High-Cost NAT GWs",
+		description: "NAT Gateways ranked by data processing cost in the report period (honors --tag and --metric).",
+		actions:     []string{"ce:GetCostAndUsage"},
+		run: func(ctx context.Context, env *CheckEnv) ([]entity.Finding, error) {
+			costs, err := env.Repo.GetNatGatewayCost(ctx, env.Profile, env.Period, env.Metric, env.Tag)
+			return natGatewayFindings(costs, env.Metric), err
+		},
+	},
+	auditCheck{
+		id:          entity.CheckUnusedVpcEndpoint,
+		title:       "Unused VPC Endpoints",
+		description: "Interface VPC endpoints that are not in use.",
+		actions:     []string{"ec2:DescribeVpcEndpoints"},
+		run: func(ctx context.Context, env *CheckEnv) ([]entity.Finding, error) {
+			endpoints, err := env.Repo.GetUnusedVpcEndpoints(ctx, env.Profile, env.Regions)
+			return vpcEndpointFindings(endpoints, env.AccountID), err
+		},
+	},
+	auditCheck{
+		id:          entity.CheckIdleLoadBalancer,
+		title:       "Idle Load Balancers",
+		description: "Load balancers without healthy targets, priced at the hourly rate of their type.",
+		actions: []string{
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:DescribeTargetGroups",
+			"elasticloadbalancing:DescribeTargetHealth",
+			"elasticloadbalancing:DescribeTags",
+			"pricing:GetProducts",
+		},
+		run: func(ctx context.Context, env *CheckEnv) ([]entity.Finding, error) {
+			lbs, err := env.Repo.GetIdleLoadBalancers(ctx, env.Profile, env.Regions)
+			return loadBalancerFindings(lbs, env.Prices(ctx, mapRegions(lbs))), err
+		},
+	},
+	auditCheck{
+		id:          entity.CheckStoppedInstance,
+		title:       "Stopped EC2",
+		description: "Stopped EC2 instances, priced at the cost of their attached EBS volumes.",
+		actions:     []string{"ec2:DescribeInstances", "ec2:DescribeVolumes", "pricing:GetProducts"},
+		run: func(ctx context.Context, env *CheckEnv) ([]entity.Finding, error) {
+			instances, err := env.Repo.GetStoppedInstances(ctx, env.Profile, env.Regions)
+			return stoppedInstanceFindings(instances, env.AccountID, env.Prices(ctx, mapRegions(instances))), err
+		},
+	},
+	auditCheck{
+		id:          entity.CheckUnusedVolume,
+		title:       "Unused Volumes",
+		description: "EBS volumes not attached to any instance, priced by storage, IOPS and throughput.",
+		actions:     []string{"ec2:DescribeVolumes", "pricing:GetProducts"},
+		run: func(ctx context.Context, env *CheckEnv) ([]entity.Finding, error) {
+			volumes, err := env.Repo.GetUnusedVolumes(ctx, env.Profile, env.Regions)
+			return volumeFindings(volumes, env.AccountID, env.Prices(ctx, mapRegions(volumes))), err
+		},
+	},
+	auditCheck{
+		id:          entity.CheckUnusedElasticIP,
+		title:       "Unused Elastic IPs",
+		description: "Elastic IPs not associated with any resource, priced at the idle public IPv4 rate.",
+		actions:     []string{"ec2:DescribeAddresses", "pricing:GetProducts"},
+		run: func(ctx context.Context, env *CheckEnv) ([]entity.Finding, error) {
+			ips, err := env.Repo.GetUnusedEIPs(ctx, env.Profile, env.Regions)
+			return elasticIPFindings(ips, env.AccountID, env.Prices(ctx, mapRegions(ips))), err
+		},
+	},
+	auditCheck{
+		id:          entity.CheckUntaggedResource,
+		title:       "Untagged Resources",
+		description: "EC2 instances, RDS DB instances and Lambda functions without tags.",
+		actions:     []string{"ec2:DescribeInstances", "rds:DescribeDBInstances", "lambda:ListFunctions", "lambda:ListTags"},
+		run: func(ctx context.Context, env *CheckEnv) ([]entity.Finding, error) {
+			resources, err := env.Repo.GetUntaggedResources(ctx, env.Profile, env.Regions)
+			return untaggedFindings(resources, env.AccountID), err
+		},
+	},
+}
+
+// RegisterCheck adds a check to the audit and full-audit reports. It must be called during program
+// initialization; it panics when the ID is empty or already registered.
+func RegisterCheck(c Check) {
+	if c.ID() == "" {
+		panic("usecase: RegisterCheck with an empty check ID")
+	}
+	for _, existing := range checkRegistry {
+		if existing.ID() == c.ID() {
+			panic(fmt.Sprintf("usecase: check %q registered twice", c.ID()))
+		}
+	}
+	checkRegistry = append(checkRegistry, c)
+}
+
+// RegisteredChecks returns every registered check, in report order.
+func RegisteredChecks() []Check {
+	out := make([]Check, len(checkRegistry))
+	copy(out, checkRegistry)
+	return out
+}
+
+// selectChecks aplica --checks: IDs incluem verificações e IDs prefixados com "-" as excluem. Sem nenhuma
+// inclusão, parte de todas as verificações registradas.
+func selectChecks(specs []string) ([]Check, error) {
+	byID := make(map[string]bool, len(checkRegistry))
+	for _, c := range checkRegistry {
+		byID[c.ID()] = true
+	}
+
+	include := make(map[string]bool)
+	exclude := make(map[string]bool)
+	for _, spec := range specs {
+		id := strings.ToLower(strings.TrimSpace(spec))
+		if id == "" {
+			continue
+		}
+		target := include
+		if strings.HasPrefix(id, "-") {
+			id, target = strings.TrimPrefix(id, "-"), exclude
+		}
+		if !byID[id] {
+			return nil, fmt.Errorf("%w: unknown check %q (see 'aws-finops checks list')", types.ErrInvalidChecks, id)
+		}
+		target[id] = true
+	}
+
+	var selected []Check
+	for _, c := range checkRegistry {
+		if (len(include) == 0 || include[c.ID()]) && !exclude[c.ID()] {
+			selected = append(selected, c)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("%w: every check was excluded", types.ErrInvalidChecks)
+	}
+	return selected, nil
+}
+
+// auditRun é o resultado das verificações de um perfil.
+type auditRun struct {
+	Profile   string
+	AccountID string
+	Checks    []string
+	Findings  []entity.Finding
+	// CheckErrs são os erros das verificações que falharam, por ID; os achados obtidos são mantidos.
+	CheckErrs map[string]error
+
+	// Prices fica nil quando os preços não puderam ser obtidos (PricingErr) ou não foram pedidos.
+	Prices      entity.PriceList
+	PriceSource string
+	PricingErr  error
+}
+
+// runAuditChecks executa as verificações em paralelo contra a conta do ambiente. step é chamado ao fim de
+// cada verificação, para a barra de progresso.
+func runAuditChecks(ctx context.Context, env *CheckEnv, checks []Check, step func()) auditRun {
+	run := auditRun{AccountID: env.AccountID, Checks: make([]string, len(checks))}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		run.Checks[i] = c.ID()
+		wg.Add(1)
+		go func(c Check) {
+			defer wg.Done()
+			findings, err := c.Run(ctx, env)
+
+			mu.Lock()
+			run.Findings = append(run.Findings, findings...)
+			if err != nil {
+				if run.CheckErrs == nil {
+					run.CheckErrs = make(map[string]error)
+				}
+				run.CheckErrs[c.ID()] = err
+			}
+			mu.Unlock()
+			if step != nil {
+				step()
+			}
+		}(c)
+	}
+	wg.Wait()
+
+	for i := range run.Findings {
+		run.Findings[i].AccountID = env.AccountID
+	}
+	entity.SortFindings(run.Findings)
+
+	if env.pricing {
+		env.mu.Lock()
+		if env.pricingErr != nil {
+			run.PricingErr = env.pricingErr
+		} else {
+			run.Prices, run.PriceSource = env.prices, env.priceSource
+		}
+		env.mu.Unlock()
+	}
+	return run
+}
+
+// mapRegions retorna as regiões com recursos, em ordem.
+func mapRegions[T any](m map[string][]T) []string {
+	regions := make([]string, 0, len(m))
+	for region, items := range m {
+		if len(items) > 0 {
+			regions = append(regions, region)
+		}
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// ListChecks displays every registered check with its description and required IAM actions.
+func (uc *DashboardUseCase) ListChecks() {
+	table := uc.console.CreateTable()
+	table.AddColumn("ID")
+	table.AddColumn("Title")
+	table.AddColumn("Description")
+	table.AddColumn("IAM Actions")
+	for _, c := range checkRegistry {
+		table.AddRow(c.ID(), c.Title(), c.Description(), strings.Join(c.IAMActions(), "\n"))
+	}
+	uc.console.Println(table.Render())
+}
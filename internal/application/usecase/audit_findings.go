@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// Recomendações de cada verificação da auditoria principal.
+const (
+	recommendBudgetExceeded     = "Review the spend behind the overrun or adjust the budget limit."
+	recommendHighCostNatGateway = "Use gateway endpoints for S3/DynamoDB and review cross-AZ traffic through the NAT Gateway."
+	recommendUnusedVpcEndpoint  = "Delete the endpoint if no workload depends on it."
+	recommendIdleLoadBalancer   = "Delete the load balancer or register healthy targets."
+	recommendStoppedInstance    = "Terminate the instance, or snapshot and delete its EBS volumes, if it is no longer needed."
+	recommendUnusedVolume       = "Snapshot the volume if the data is needed and delete it."
+	recommendUnusedElasticIP    = "Release the address or associate it with a running resource."
+	recommendUntaggedResource   = "Add the cost allocation tags required by your tagging policy."
+)
+
+// newAuditData monta o resultado da auditoria de um perfil. Os achados cobertos pelas supressões ficam
+// em Suppressed; com preços, o custo estimado dos recursos ociosos restantes é somado em PotentialSavings.
+// Com --baseline, esses achados são comparados com a base e, com --only-new, só os novos são mantidos.
+func newAuditData(run auditRun, opts reportOptions) entity.AuditData {
+	findings, suppressed := entity.SuppressFindings(run.Findings, opts.Suppressions)
+	data := entity.AuditData{
+		Profile:    run.Profile,
+		AccountID:  run.AccountID,
+		Checks:     run.Checks,
+		Findings:   findings,
+		Suppressed: suppressed,
+		Metric:     opts.Metric,
+	}
+	// A economia soma todos os achados não suprimidos, inclusive os que --only-new esconde.
+	if run.Prices != nil {
+		data.PotentialSavings = estimateSavings(data.Findings, run.PriceSource)
+	} else if run.PricingErr != nil {
+		data.SavingsError = run.PricingErr.Error()
+	}
+	if opts.Baseline != nil {
+		opts.Baseline.Compare(&data)
+		if opts.OnlyNew {
+			data.OnlyNew()
+		}
+	}
+	return data
+}
+
+// estimateSavings soma o custo estimado dos recursos ociosos por verificação.
+func estimateSavings(findings []entity.Finding, source string) *entity.SavingsEstimate {
+	s := &entity.SavingsEstimate{Source: source}
+	for _, f := range findings {
+		var total *float64
+		switch f.CheckID {
+		case entity.CheckStoppedInstance:
+			total = &s.StoppedInstances
+		case entity.CheckUnusedVolume:
+			total = &s.UnusedVolumes
+		case entity.CheckUnusedElasticIP:
+			total = &s.UnusedEIPs
+		case entity.CheckIdleLoadBalancer:
+			total = &s.IdleLoadBalancers
+		default:
+			continue
+		}
+		if f.EstimatedCost == nil {
+			s.Unpriced++
+			continue
+		}
+		*total += *f.EstimatedCost
+	}
+	return s
+}
+
+// monthlyCost é o custo estimado de um achado; nil sem lista de preços ou sem preço para o recurso.
+func monthlyCost(prices entity.PriceList, cost float64, ok bool) *float64 {
+	if prices == nil || !ok {
+		return nil
+	}
+	return &cost
+}
+
+func budgetFindings(budgets []entity.BudgetInfo, accountID string) []entity.Finding {
+	var findings []entity.Finding
+	for _, b := range budgets {
+		if b.Actual <= b.Limit {
+			continue
+		}
+		findings = append(findings, entity.Finding{
+			CheckID:        entity.CheckBudgetExceeded,
+			ResourceID:     b.Name,
+			ARN:            entity.BuildARN("budgets", "", accountID, "budget/"+b.Name),
+			Service:        "AWS Budgets",
+			Severity:       entity.SeverityHigh,
+			Details:        fmt.Sprintf("$%.2f > $%.2f", b.Actual, b.Limit),
+			Recommendation: recommendBudgetExceeded,
+		})
+	}
+	return findings
+}
+
+func natGatewayFindings(costs []entity.NatGatewayCost, metric entity.CostMetric) []entity.Finding {
+	findings := make([]entity.Finding, 0, len(costs))
+	for _, c := range costs {
+		f := entity.Finding{
+			CheckID:        entity.CheckHighCostNatGateway,
+			ResourceID:     c.ResourceID,
+			Region:         c.Region,
+			Service:        "Amazon VPC",
+			Severity:       entity.SeverityMedium,
+			Details:        fmt.Sprintf("data processing (%s)", metric.OrDefault().Label()),
+			EstimatedCost:  &c.Cost,
+			Recommendation: recommendHighCostNatGateway,
+		}
+		// O Cost Explorer identifica o NAT Gateway pelo ARN.
+		if strings.HasPrefix(c.ResourceID, "arn:") {
+			f.ARN = c.ResourceID
+			f.ResourceID = c.ResourceID[strings.LastIndex(c.ResourceID, "/")+1:]
+		}
+		findings = append(findings, f)
+	}
+	return findings
+}
+
+func vpcEndpointFindings(data entity.UnusedVpcEndpoints, accountID string) []entity.Finding {
+	var findings []entity.Finding
+	for region, endpoints := range data {
+		for _, ep := range endpoints {
+			findings = append(findings, entity.Finding{
+				CheckID:        entity.CheckUnusedVpcEndpoint,
+				ResourceID:     ep.ID,
+				ARN:            entity.BuildARN("ec2", region, accountID, "vpc-endpoint/"+ep.ID),
+				Region:         region,
+				Service:        "Amazon VPC",
+				Severity:       entity.SeverityLow,
+				Details:        ep.ServiceName,
+				Tags:           ep.Tags,
+				Recommendation: recommendUnusedVpcEndpoint,
+			})
+		}
+	}
+	return findings
+}
+
+func loadBalancerFindings(data entity.IdleLoadBalancers, prices entity.PriceList) []entity.Finding {
+	var findings []entity.Finding
+	for region, lbs := range data {
+		for _, lb := range lbs {
+			cost, ok := prices.LoadBalancerMonthlyCost(region, lb.Type)
+			findings = append(findings, entity.Finding{
+				CheckID:        entity.CheckIdleLoadBalancer,
+				ResourceID:     lb.Name,
+				ARN:            lb.ARN,
+				Region:         region,
+				Service:        "Elastic Load Balancing",
+				Severity:       entity.SeverityMedium,
+				Details:        lb.Type,
+				EstimatedCost:  monthlyCost(prices, cost, ok),
+				Tags:           lb.Tags,
+				Recommendation: recommendIdleLoadBalancer,
+			})
+		}
+	}
+	return findings
+}
+
+func stoppedInstanceFindings(data entity.StoppedEC2Instances, accountID string, prices entity.PriceList) []entity.Finding {
+	var findings []entity.Finding
+	for region, instances := range data {
+		for _, inst := range instances {
+			var size int32
+			cost, priced := 0.0, true
+			for _, v := range inst.Volumes {
+				size += v.SizeGiB
+				c, ok := prices.VolumeMonthlyCost(region, v)
+				cost += c
+				priced = priced && ok
+			}
+			details := inst.InstanceType
+			if len(inst.Volumes) > 0 {
+				details = fmt.Sprintf("%s, %d volumes, %d GiB", inst.InstanceType, len(inst.Volumes), size)
+			}
+			findings = append(findings, entity.Finding{
+				CheckID:        entity.CheckStoppedInstance,
+				ResourceID:     inst.ID,
+				ARN:            entity.BuildARN("ec2", region, accountID, "instance/"+inst.ID),
+				Region:         region,
+				Service:        "Amazon EC2",
+				Severity:       entity.SeverityLow,
+				Details:        details,
+				EstimatedCost:  monthlyCost(prices, cost, priced),
+				Tags:           inst.Tags,
+				Recommendation: recommendStoppedInstance,
+			})
+		}
+	}
+	return findings
+}
+
+func volumeFindings(data entity.UnusedVolumes, accountID string, prices entity.PriceList) []entity.Finding {
+	var findings []entity.Finding
+	for region, volumes := range data {
+		for _, v := range volumes {
+			cost, ok := prices.VolumeMonthlyCost(region, v)
+			findings = append(findings, entity.Finding{
+				CheckID:        entity.CheckUnusedVolume,
+				ResourceID:     v.ID,
+				ARN:            entity.BuildARN("ec2", region, accountID, "volume/"+v.ID),
+				Region:         region,
+				Service:        "Amazon EBS",
+				Severity:       entity.SeverityMedium,
+				Details:        fmt.Sprintf("%s, %d GiB", v.Type, v.SizeGiB),
+				EstimatedCost:  monthlyCost(prices, cost, ok),
+				Tags:           v.Tags,
+				Recommendation: recommendUnusedVolume,
+			})
+		}
+	}
+	return findings
+}
+
+func elasticIPFindings(data entity.UnusedEIPs, accountID string, prices entity.PriceList) []entity.Finding {
+	var findings []entity.Finding
+	for region, ips := range data {
+		cost, ok := prices.ElasticIPMonthlyCost(region)
+		for _, ip := range ips {
+			findings = append(findings, entity.Finding{
+				CheckID:        entity.CheckUnusedElasticIP,
+				ResourceID:     ip.PublicIP,
+				ARN:            entity.BuildARN("ec2", region, accountID, "elastic-ip/"+ip.AllocationID),
+				Region:         region,
+				Service:        "Amazon EC2",
+				Severity:       entity.SeverityLow,
+				Details:        ip.AllocationID,
+				EstimatedCost:  monthlyCost(prices, cost, ok),
+				Tags:           ip.Tags,
+				Recommendation: recommendUnusedElasticIP,
+			})
+		}
+	}
+	return findings
+}
+
+// untaggedServices traduz o serviço de GetUntaggedResources no nome do serviço, no prefixo do ARN e no
+// tipo de recurso.
+var untaggedServices = map[string]struct{ Service, ARNService, ARNPrefix, Kind string }{
+	"EC2":    {"Amazon EC2", "ec2", "instance/", "EC2 instance"},
+	"RDS":    {"Amazon RDS", "rds", "db:", "RDS DB instance"},
+	"Lambda": {"AWS Lambda", "lambda", "function:", "Lambda function"},
+}
+
+func untaggedFindings(data entity.UntaggedResources, accountID string) []entity.Finding {
+	var findings []entity.Finding
+	for service, regions := range data {
+		meta, ok := untaggedServices[service]
+		if !ok {
+			meta.Service, meta.Kind = service, service+" resource"
+		}
+		for region, ids := range regions {
+			for _, id := range ids {
+				f := entity.Finding{
+					CheckID:        entity.CheckUntaggedResource,
+					ResourceID:     id,
+					Region:         region,
+					Service:        meta.Service,
+					Severity:       entity.SeverityLow,
+					Details:        meta.Kind,
+					Recommendation: recommendUntaggedResource,
+				}
+				if meta.ARNService != "" {
+					f.ARN = entity.BuildARN(meta.ARNService, region, accountID, meta.ARNPrefix+id)
+				}
+				findings = append(findings, f)
+			}
+		}
+	}
+	return findings
+}
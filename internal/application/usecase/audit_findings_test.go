@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+func TestNewAuditData(t *testing.T) {
+	cost := func(v float64) *float64 { return &v }
+	volume := func(id string, c float64) entity.Finding {
+		return entity.Finding{CheckID: entity.CheckUnusedVolume, AccountID: "111111111111", Region: "us-east-1", ResourceID: id, EstimatedCost: cost(c)}
+	}
+	baseline := entity.NewAuditBaseline("audit-base.json", []entity.AuditData{{
+		AccountID: "111111111111",
+		Findings:  []entity.Finding{volume("vol-suppressed", 100), volume("vol-kept", 5), volume("vol-deleted", 7)},
+	}})
+	run := auditRun{
+		Profile:     "default",
+		AccountID:   "111111111111",
+		Checks:      []string{entity.CheckUnusedVolume},
+		Findings:    []entity.Finding{volume("vol-suppressed", 100), volume("vol-kept", 5), volume("vol-new", 3)},
+		Prices:      entity.PriceList{},
+		PriceSource: "test",
+	}
+	suppressions := []entity.Suppression{{ResourceID: "vol-suppressed", Reason: "DR standby"}}
+
+	tests := []struct {
+		name         string
+		onlyNew      bool
+		wantFindings []string
+		wantResolved []string
+	}{
+		{name: "all findings", wantFindings: []string{"vol-kept", "vol-new"}, wantResolved: []string{"vol-deleted"}},
+		{name: "only new", onlyNew: true, wantFindings: []string{"vol-new"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := run
+			r.Findings = append([]entity.Finding(nil), run.Findings...)
+			data := newAuditData(r, reportOptions{Suppressions: suppressions, Baseline: baseline, OnlyNew: tt.onlyNew})
+
+			if got := resourceIDs(data.Findings); !slices.Equal(got, tt.wantFindings) {
+				t.Errorf("expected findings %q, got %q", tt.wantFindings, got)
+			}
+			if got := resourceIDs(data.Resolved); !slices.Equal(got, tt.wantResolved) {
+				t.Errorf("expected resolved %q, got %q", tt.wantResolved, got)
+			}
+			if len(data.Suppressed) != 1 {
+				t.Errorf("expected the suppressed volume, got %d suppressed", len(data.Suppressed))
+			}
+			// O volume suprimido continua existindo: não é resolvido nem entra na economia.
+			if s := data.Baseline; s == nil || s.New != 1 || s.Unchanged != 1 || s.Resolved != 1 {
+				t.Errorf("expected 1 new, 1 unchanged and 1 resolved, got %+v", s)
+			}
+			if s := data.PotentialSavings; s == nil || s.UnusedVolumes != 8 {
+				t.Errorf("expected $8 of savings from the unsuppressed volumes, got %+v", s)
+			}
+		})
+	}
+
+	// Sem preços, o motivo da falha explica por que a economia fica N/A.
+	r := run
+	r.Prices, r.PricingErr = nil, errors.New("access denied")
+	data := newAuditData(r, reportOptions{})
+	if data.PotentialSavings != nil || data.SavingsError != "access denied" {
+		t.Errorf("expected no savings with the pricing error, got %+v and %q", data.PotentialSavings, data.SavingsError)
+	}
+}
+
+func resourceIDs(findings []entity.Finding) []string {
+	var ids []string
+	for _, f := range findings {
+		ids = append(ids, f.ResourceID)
+	}
+	return ids
+}
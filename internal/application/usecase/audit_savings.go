@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"maps"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+// pricingAPISource identifica os preços obtidos na AWS Pricing API.
+const pricingAPISource = "AWS Pricing API"
+
+// resolvePriceList carrega a lista de preços offline de --price-list nas opções do relatório. Sem o
+// arquivo, os preços vêm da AWS Pricing API.
+func (uc *DashboardUseCase) resolvePriceList(args *types.CLIArgs, opts *reportOptions) error {
+	if args.PriceList == "" {
+		return nil
+	}
+	prices, err := uc.configRepo.LoadPriceList(args.PriceList)
+	if err != nil {
+		return err
+	}
+	opts.Prices, opts.PriceSource = prices, args.PriceList
+	return nil
+}
+
+// newCheckEnv prepara o ambiente das verificações de auditoria de um perfil. Com withPrices, os recursos
+// ociosos são precificados com a lista offline, quando carregada, ou com a AWS Pricing API.
+func (uc *DashboardUseCase) newCheckEnv(profile, accountID string, regions []string, args *types.CLIArgs, opts reportOptions, withPrices bool) *CheckEnv {
+	env := &CheckEnv{
+		Repo:      uc.awsRepo,
+		Profile:   profile,
+		AccountID: accountID,
+		Regions:   regions,
+		Period:    opts.Period,
+		Metric:    opts.Metric,
+		Tag:       args.Tag,
+		pricing:   withPrices,
+	}
+	if !withPrices {
+		return env
+	}
+	if opts.Prices != nil {
+		env.prices, env.priceSource, env.offline = opts.Prices, opts.PriceSource, true
+	} else {
+		env.prices, env.priceSource = entity.PriceList{}, pricingAPISource
+	}
+	return env
+}
+
+// Prices returns the on-demand prices of the given regions, for checks that estimate the monthly cost of
+// the resources they find. It returns nil when the report does not estimate costs or the prices could not
+// be fetched; os preços da AWS Pricing API são buscados uma vez por região e compartilhados entre as
+// verificações do perfil.
+func (e *CheckEnv) Prices(ctx context.Context, regions []string) entity.PriceList {
+	if !e.pricing {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.offline {
+		return e.prices
+	}
+	if e.pricingErr != nil {
+		return nil
+	}
+
+	var missing []string
+	for _, region := range regions {
+		if _, ok := e.prices[region]; !ok {
+			missing = append(missing, region)
+		}
+	}
+	if len(missing) > 0 {
+		prices, err := e.Repo.GetResourcePrices(ctx, e.Profile, missing)
+		if err != nil {
+			e.pricingErr = err
+			return nil
+		}
+		for region, rp := range prices {
+			e.prices[region] = rp
+		}
+	}
+	// Cópia: outras verificações podem acrescentar regiões enquanto esta lê os preços.
+	return maps.Clone(e.prices)
+}
+
+// formatPotentialSavings resume a economia mensal estimada para a tabela de auditoria; savingsErr é o
+// motivo da falta de estimativa, exibido abaixo da tabela.
+func formatPotentialSavings(s *entity.SavingsEstimate, savingsErr string) string {
+	if s == nil {
+		if savingsErr != "" {
+			return "N/A" + pterm.FgGray.Sprint("\n(prices unavailable)")
+		}
+		return "N/A"
+	}
+	out := pterm.FgGreen.Sprintf("$%.2f/mo", s.Total())
+	if s.Unpriced > 0 {
+		out += pterm.FgGray.Sprintf("\n(+%d unpriced)", s.Unpriced)
+	}
+	return out
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+)
+
+// resolveBaseline carrega a exportação JSON de --baseline nas opções do relatório, para marcar os achados
+// como novos, inalterados ou resolvidos desde aquela execução.
+func (uc *DashboardUseCase) resolveBaseline(args *types.CLIArgs, opts *reportOptions) error {
+	if args.Baseline == "" {
+		if args.OnlyNew {
+			return types.ErrOnlyNewWithoutBase
+		}
+		return nil
+	}
+	audits, err := uc.configRepo.LoadAuditBaseline(args.Baseline)
+	if err != nil {
+		return err
+	}
+	for _, a := range audits {
+		// Uma base exportada com --only-new não tem os achados inalterados, que voltariam como novos.
+		if a.Baseline != nil && len(a.Findings) < a.Baseline.New+a.Baseline.Unchanged {
+			uc.console.LogWarning("Baseline %s was exported with --only-new; findings left out of it are reported as new", args.Baseline)
+			break
+		}
+	}
+	opts.Baseline = entity.NewAuditBaseline(args.Baseline, audits)
+	opts.OnlyNew = args.OnlyNew
+	return nil
+}
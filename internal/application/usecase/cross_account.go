@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+)
+
+// defaultRoleDuration é a duração das sessões assumidas quando --role-duration não é informado.
+const defaultRoleDuration = time.Hour
+
+var accountIDRegex = regexp.MustCompile(`^\d{12}$`)
+
+// initializeAssumeRoleGroups cria um grupo por conta alvo, acessada assumindo a role a partir do perfil base.
+// Sem --accounts, as contas ativas são descobertas via AWS Organizations. Contas em que a role não
+// pode ser assumida são ignoradas com um aviso.
+func (uc *DashboardUseCase) initializeAssumeRoleGroups(ctx context.Context, profiles []string, args *types.CLIArgs) ([]entity.ProfileGroup, error) {
+	if len(profiles) > 1 {
+		return nil, types.ErrMultipleBaseProfiles
+	}
+	if args.Org {
+		return nil, types.ErrOrgWithAssumeRole
+	}
+	base := profiles[0]
+
+	role := entity.AssumeRoleOptions{RoleName: args.AssumeRole, ExternalID: args.ExternalID, Duration: args.RoleDuration}
+	if role.Duration == 0 {
+		role.Duration = defaultRoleDuration
+	}
+
+	names := make(map[string]string)
+	accountIDs := args.Accounts
+	if len(accountIDs) == 0 {
+		uc.console.LogInfo("Discovering organization accounts using profile '%s'...", base)
+		accounts, err := uc.awsRepo.GetOrganizationAccounts(ctx, base)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover organization accounts (use --accounts to list them): %w", err)
+		}
+		for _, a := range accounts {
+			if a.Status != "" && a.Status != "ACTIVE" {
+				continue
+			}
+			accountIDs = append(accountIDs, a.ID)
+			names[a.ID] = a.Name
+		}
+	}
+	for _, id := range accountIDs {
+		if !accountIDRegex.MatchString(id) {
+			return nil, fmt.Errorf("%w: %q (expected 12 digits)", types.ErrInvalidAccountID, id)
+		}
+	}
+
+	// A conta do perfil base é acessada diretamente; a role costuma não existir na conta de gerenciamento.
+	baseAccountID, _ := uc.awsRepo.GetAccountID(ctx, base)
+
+	uc.console.LogInfo("Assuming role '%s' in %d account(s)...", role.RoleName, len(accountIDs))
+	var groups []entity.ProfileGroup
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	seen := make(map[string]bool, len(accountIDs))
+	for _, id := range accountIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		profile := base
+		if id != baseAccountID {
+			profile = uc.awsRepo.AssumeRoleProfile(base, id, role)
+		}
+
+		wg.Add(1)
+		go func(accountID, profile string) {
+			defer wg.Done()
+			// Valida a role agora: as auditorias ignoram erros e mostrariam a conta como sem achados.
+			if _, err := uc.awsRepo.GetAccountID(ctx, profile); err != nil {
+				uc.console.LogWarning("Could not assume role '%s' in account %s, skipping. Error: %v", role.RoleName, accountID, err)
+				return
+			}
+			identifier := accountID
+			if name := names[accountID]; name != "" {
+				identifier = fmt.Sprintf("%s (%s)", name, accountID)
+			}
+			mu.Lock()
+			groups = append(groups, entity.ProfileGroup{Identifier: identifier, AccountID: accountID, Profiles: []string{profile}})
+			mu.Unlock()
+		}(id, profile)
+	}
+	wg.Wait()
+
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("could not assume role '%s' in any of the %d account(s)", role.RoleName, len(seen))
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Identifier < groups[j].Identifier })
+	return groups, nil
+}
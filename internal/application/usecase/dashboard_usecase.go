@@ -3,10 +3,13 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"maps"
 	"math"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
 	"github.com/diillson/aws-finops-dashboard-go/internal/domain/repository"
@@ -16,10 +19,11 @@ import (
 
 // DashboardUseCase handles the main dashboard functionality.
 type DashboardUseCase struct {
-	awsRepo    repository.AWSRepository
-	exportRepo repository.ExportRepository
-	configRepo repository.ConfigRepository
-	console    types.ConsoleInterface
+	awsRepo     repository.AWSRepository
+	exportRepo  repository.ExportRepository
+	configRepo  repository.ConfigRepository
+	historyRepo repository.HistoryRepository
+	console     types.ConsoleInterface
 }
 
 // NewDashboardUseCase creates a new dashboard use case.
@@ -27,13 +31,15 @@ func NewDashboardUseCase(
 	awsRepo repository.AWSRepository,
 	exportRepo repository.ExportRepository,
 	configRepo repository.ConfigRepository,
+	historyRepo repository.HistoryRepository,
 	console types.ConsoleInterface,
 ) *DashboardUseCase {
 	return &DashboardUseCase{
-		awsRepo:    awsRepo,
-		exportRepo: exportRepo,
-		configRepo: configRepo,
-		console:    console,
+		awsRepo:     awsRepo,
+		exportRepo:  exportRepo,
+		configRepo:  configRepo,
+		historyRepo: historyRepo,
+		console:     console,
 	}
 }
 
@@ -43,59 +49,79 @@ func (uc *DashboardUseCase) RunDashboard(ctx context.Context, args *types.CLIArg
 		return fmt.Errorf("failed to process configuration: %w", err)
 	}
 
-	profileGroups, err := uc.initializeProfiles(ctx, args)
+	opts, err := resolveReportOptions(args, time.Now())
 	if err != nil {
 		return err
 	}
-	if len(profileGroups) == 0 {
-		uc.console.LogWarning("No profiles to process.")
-		return nil
+	if err := uc.resolvePriceList(args, &opts); err != nil {
+		return err
 	}
-
-	// Ordem de prioridade de relatórios específicos
-	if args.S3Audit {
-		return uc.runS3LifecycleAudit(ctx, profileGroups, args)
+	if err := uc.resolveSuppressions(args, &opts, time.Now()); err != nil {
+		return err
 	}
-	if args.LogsAudit {
-		return uc.runCloudWatchLogsAudit(ctx, profileGroups, args)
+	if err := uc.resolveBaseline(args, &opts); err != nil {
+		return err
+	}
+	if err := uc.resolveRecording(args); err != nil {
+		return err
 	}
-	if args.Commitments {
-		return uc.runCommitmentsReport(ctx, profileGroups, args)
+	if args.Record != "" {
+		defer uc.reportRecording(args)
 	}
-	if args.Audit {
-		return uc.runAuditReport(ctx, profileGroups, args)
+	if err := uc.enableResponseCache(args); err != nil {
+		return err
 	}
-	if args.FullAudit {
-		return uc.runFullAuditReport(ctx, profileGroups, args)
+	if args.Verbose {
+		defer uc.reportResponseCache(args)
 	}
-	if args.Trend {
-		return uc.runTrendAnalysis(ctx, profileGroups, args)
+
+	profileGroups, err := uc.initializeProfiles(ctx, args)
+	if err != nil {
+		return err
 	}
-	if args.Transfer {
-		return uc.runDataTransferDeepDive(ctx, profileGroups, args)
+	if len(profileGroups) == 0 {
+		uc.console.LogWarning("No profiles to process.")
+		return nil
 	}
 
-	return uc.runCostDashboard(ctx, profileGroups, args)
+	switch args.Report {
+	case types.ReportS3:
+		return uc.runS3LifecycleAudit(ctx, profileGroups, args)
+	case types.ReportLogs:
+		return uc.runCloudWatchLogsAudit(ctx, profileGroups, args)
+	case types.ReportCommitments:
+		return uc.runCommitmentsReport(ctx, profileGroups, args, opts)
+	case types.ReportExpirations:
+		return uc.runExpirationReport(ctx, profileGroups, args, opts)
+	case types.ReportAnomalies:
+		return uc.runAnomalyReport(ctx, profileGroups, args, opts)
+	case types.ReportRightsizing:
+		return uc.runRightsizingReport(ctx, profileGroups, args)
+	case types.ReportAudit:
+		return uc.runAuditReport(ctx, profileGroups, args, opts)
+	case types.ReportFullAudit:
+		return uc.runFullAuditReport(ctx, profileGroups, args, opts)
+	case types.ReportTrend:
+		return uc.runTrendAnalysis(ctx, profileGroups, args, opts)
+	case types.ReportTransfer:
+		return uc.runDataTransferDeepDive(ctx, profileGroups, args, opts)
+	default:
+		return uc.runCostDashboard(ctx, profileGroups, args, opts)
+	}
 }
 
 // runCostDashboard executa o dashboard de custos principal.
-func (uc *DashboardUseCase) runCostDashboard(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) error {
+func (uc *DashboardUseCase) runCostDashboard(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) error {
 	status := uc.console.Status("Initializing dashboard...")
 	defer status.Stop() // Defer é seguro aqui, pois a impressão da tabela ocorre depois.
 
-	var timeRange *int
-	if args.TimeRange != nil && *args.TimeRange > 0 {
-		timeRange = args.TimeRange
-	}
-
-	sampleProfile := profileGroups[0].Profiles[0]
-	prevName, currName, prevDates, currDates := uc.getDisplayTablePeriodInfo(ctx, sampleProfile, timeRange)
+	prevName, currName, prevDates, currDates := getDisplayTablePeriodInfo(opts.Period)
 
-	table := uc.createDisplayTable(prevDates, currDates, prevName, currName)
+	table := uc.createDisplayTable(prevDates, currDates, prevName, currName, opts.GroupBy.Title())
 
 	status.Update("Fetching AWS data concurrently...")
 
-	results := uc.generateDashboardData(ctx, profileGroups, args)
+	results := uc.collectCostDashboard(ctx, profileGroups, args, opts)
 
 	// Parar o spinner explicitamente aqui garante que ele desapareça
 	// antes de qualquer outra impressão.
@@ -103,110 +129,59 @@ func (uc *DashboardUseCase) runCostDashboard(ctx context.Context, profileGroups
 
 	// O MultiPrinter dentro de generateDashboardData já parou e limpou suas linhas.
 
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].Profile < results[j].Profile
-	})
-
 	for _, data := range results {
 		uc.addProfileToTable(table, data)
 	}
 
 	uc.console.Print("\n" + table.Render())
+	uc.console.Println(pterm.FgGray.Sprintf("Cost metric: %s", opts.Metric.Label()))
+	if len(opts.Exclude) > 0 {
+		uc.console.Println(pterm.FgGray.Sprintf("Excluded from totals: %s (shown separately under the current cost)", entity.RecordTypesLabel(opts.Exclude)))
+	}
 
 	if args.ReportName != "" {
 		uc.exportCostDashboardReports(results, args, prevDates, currDates)
 	}
 
+	snapshot := newSnapshot(args, opts, opts.Period)
+	for _, data := range results {
+		if data.Success {
+			snapshot.Profiles = append(snapshot.Profiles, data)
+		}
+	}
+	uc.saveSnapshot(args, snapshot)
+
 	return nil
 }
 
-func (uc *DashboardUseCase) runCloudWatchLogsAudit(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) error {
-	uc.console.LogInfo("Auditing CloudWatch Logs retention...")
-
-	livePrinter, _ := uc.console.GetMultiPrinter().Start()
-	defer livePrinter.Stop()
-
-	type row struct {
-		Profile   string
-		AccountID string
-		Audit     entity.CloudWatchLogsAudit
-		Err       error
+// collectCostDashboard obtém os dados do dashboard de custos de cada grupo de perfis, ordenados por perfil.
+// Com --org, cada conta-membro da organização vira uma linha.
+func (uc *DashboardUseCase) collectCostDashboard(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) []entity.ProfileData {
+	var results []entity.ProfileData
+	if args.Org {
+		results = uc.generateOrganizationData(ctx, profileGroups, args, opts)
+	} else {
+		results = uc.generateDashboardData(ctx, profileGroups, args, opts)
 	}
+	// No modo organização as contas-membro de um mesmo perfil mantêm a ordem por custo.
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Profile < results[j].Profile
+	})
+	return results
+}
 
-	results := make([]row, 0, len(profileGroups))
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-
-	for _, group := range profileGroups {
-		wg.Add(1)
-		go func(g entity.ProfileGroup) {
-			defer wg.Done()
-
-			// Uma barra por perfil: 2 passos (List regions + Fetch logs)
-			bar := uc.console.NewProgressbar(2, fmt.Sprintf("Logs Audit: %s", g.Identifier))
-			bar.Start()
-
-			profile := g.Profiles[0]
-			regions := args.Regions
-			if len(regions) == 0 {
-				rs, _ := uc.awsRepo.GetAccessibleRegions(ctx, profile)
-				regions = rs
-			}
-			bar.Increment()
-
-			logGroups, err := uc.awsRepo.GetCloudWatchLogGroups(ctx, profile, regions)
-			if err != nil {
-				mu.Lock()
-				results = append(results, row{Profile: g.Identifier, Err: err})
-				mu.Unlock()
-				bar.Increment()
-				return
-			}
-			bar.Increment()
-
-			// Filtra grupos sem retenção e ordena por tamanho desc
-			noRetention := make([]entity.CloudWatchLogGroupInfo, 0, len(logGroups))
-			var totalBytes int64
-			for _, lg := range logGroups {
-				totalBytes += lg.StoredBytes
-				if lg.RetentionDays == 0 {
-					noRetention = append(noRetention, lg)
-				}
-			}
-			sort.Slice(noRetention, func(i, j int) bool {
-				return noRetention[i].StoredBytes > noRetention[j].StoredBytes
-			})
-
-			// Top N mais pesados
-			const topN = 10
-			top := noRetention
-			if len(noRetention) > topN {
-				top = noRetention[:topN]
-			}
-
-			accountID, _ := uc.awsRepo.GetAccountID(ctx, profile)
-			audit := entity.CloudWatchLogsAudit{
-				Profile:            g.Identifier,
-				AccountID:          accountID,
-				NoRetentionCount:   len(noRetention),
-				NoRetentionTopN:    top,
-				TotalStoredGB:      float64(totalBytes) / (1024.0 * 1024.0 * 1024.0),
-				RecommendedMessage: "Set retention days per environment (e.g., 7/14/30) to avoid unlimited storage growth.",
-			}
+// logsAuditRow é o resultado da auditoria de CloudWatch Logs para um grupo de perfis.
+type logsAuditRow struct {
+	Profile   string
+	AccountID string
+	Audit     entity.CloudWatchLogsAudit
+	Err       error
+}
 
-			mu.Lock()
-			results = append(results, row{
-				Profile:   g.Identifier,
-				AccountID: accountID,
-				Audit:     audit,
-			})
-			mu.Unlock()
-		}(group)
-	}
-	wg.Wait()
+func (uc *DashboardUseCase) runCloudWatchLogsAudit(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) error {
+	uc.console.LogInfo("Auditing CloudWatch Logs retention...")
 
-	// Ordena por perfil
-	sort.Slice(results, func(i, j int) bool { return results[i].Profile < results[j].Profile })
+	results := uc.collectLogsAudits(ctx, profileGroups, args)
 
 	// Monta a tabela
 	table := uc.console.CreateTable()
@@ -290,27 +265,12 @@ func (uc *DashboardUseCase) runCloudWatchLogsAudit(ctx context.Context, profileG
 	return nil
 }
 
-type profileJob struct {
-	Group       entity.ProfileGroup
-	Args        *types.CLIArgs
-	ProgressBar *pterm.ProgressbarPrinter
-}
-
-func (uc *DashboardUseCase) runDataTransferDeepDive(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) error {
-	uc.console.LogInfo("Analysing data transfer costs...")
-
-	// MultiPrinter para progress bars por perfil
+// collectLogsAudits executa a auditoria de CloudWatch Logs em paralelo, um grupo de perfis por goroutine.
+func (uc *DashboardUseCase) collectLogsAudits(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) []logsAuditRow {
 	livePrinter, _ := uc.console.GetMultiPrinter().Start()
 	defer livePrinter.Stop()
 
-	type row struct {
-		Profile   string
-		AccountID string
-		Report    entity.DataTransferReport
-		Err       error
-	}
-	results := make([]row, 0, len(profileGroups))
-
+	results := make([]logsAuditRow, 0, len(profileGroups))
 	var wg sync.WaitGroup
 	var mu sync.Mutex
 
@@ -319,37 +279,93 @@ func (uc *DashboardUseCase) runDataTransferDeepDive(ctx context.Context, profile
 		go func(g entity.ProfileGroup) {
 			defer wg.Done()
 
-			bar := uc.console.NewProgressbar(1, fmt.Sprintf("Data Transfer: %s", g.Identifier))
+			// Uma barra por perfil: 2 passos (List regions + Fetch logs)
+			bar := uc.console.NewProgressbar(2, fmt.Sprintf("Logs Audit: %s", g.Identifier))
 			bar.Start()
 
-			// Usa o primeiro perfil real do grupo (tal qual trend)
 			profile := g.Profiles[0]
-
-			var timeRange *int
-			if args.TimeRange != nil && *args.TimeRange > 0 {
-				timeRange = args.TimeRange
+			regions := args.Regions
+			if len(regions) == 0 {
+				rs, _ := uc.awsRepo.GetAccessibleRegions(ctx, profile)
+				regions = rs
 			}
+			bar.Increment()
 
-			report, err := uc.awsRepo.GetDataTransferBreakdown(ctx, profile, timeRange, args.Tag)
+			logGroups, err := uc.awsRepo.GetCloudWatchLogGroups(ctx, profile, regions)
 			if err != nil {
 				mu.Lock()
-				results = append(results, row{Profile: g.Identifier, AccountID: "", Err: err})
+				results = append(results, logsAuditRow{Profile: g.Identifier, Err: err})
 				mu.Unlock()
 				bar.Increment()
 				return
 			}
+			bar.Increment()
+
+			// Filtra grupos sem retenção e ordena por tamanho desc
+			noRetention := make([]entity.CloudWatchLogGroupInfo, 0, len(logGroups))
+			var totalBytes int64
+			for _, lg := range logGroups {
+				totalBytes += lg.StoredBytes
+				if lg.RetentionDays == 0 {
+					noRetention = append(noRetention, lg)
+				}
+			}
+			sort.Slice(noRetention, func(i, j int) bool {
+				return noRetention[i].StoredBytes > noRetention[j].StoredBytes
+			})
+
+			// Top N mais pesados
+			const topN = 10
+			top := noRetention
+			if len(noRetention) > topN {
+				top = noRetention[:topN]
+			}
+
+			accountID, _ := uc.awsRepo.GetAccountID(ctx, profile)
+			audit := entity.CloudWatchLogsAudit{
+				Profile:            g.Identifier,
+				AccountID:          accountID,
+				NoRetentionCount:   len(noRetention),
+				NoRetentionTopN:    top,
+				TotalStoredGB:      float64(totalBytes) / (1024.0 * 1024.0 * 1024.0),
+				RecommendedMessage: "Set retention days per environment (e.g., 7/14/30) to avoid unlimited storage growth.",
+			}
 
-			accountID := report.AccountID
 			mu.Lock()
-			results = append(results, row{Profile: g.Identifier, AccountID: accountID, Report: report})
+			results = append(results, logsAuditRow{
+				Profile:   g.Identifier,
+				AccountID: accountID,
+				Audit:     audit,
+			})
 			mu.Unlock()
-			bar.Increment()
 		}(group)
 	}
 	wg.Wait()
 
 	// Ordena por perfil
 	sort.Slice(results, func(i, j int) bool { return results[i].Profile < results[j].Profile })
+	return results
+}
+
+type profileJob struct {
+	Group       entity.ProfileGroup
+	Args        *types.CLIArgs
+	Options     reportOptions
+	ProgressBar *pterm.ProgressbarPrinter
+}
+
+// transferRow é o resultado do deep dive de transferência de dados para um grupo de perfis.
+type transferRow struct {
+	Profile   string
+	AccountID string
+	Report    entity.DataTransferReport
+	Err       error
+}
+
+func (uc *DashboardUseCase) runDataTransferDeepDive(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) error {
+	uc.console.LogInfo("Analysing data transfer costs (%s cost)...", strings.ToLower(opts.Metric.Label()))
+
+	results := uc.collectTransferReports(ctx, profileGroups, args, opts)
 
 	// Monta tabela agregada por categoria
 	table := uc.console.CreateTable()
@@ -463,7 +479,52 @@ func (uc *DashboardUseCase) runDataTransferDeepDive(ctx context.Context, profile
 	return nil
 }
 
-func (uc *DashboardUseCase) generateDashboardData(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) []entity.ProfileData {
+// collectTransferReports obtém o breakdown de transferência de dados de cada grupo de perfis em paralelo.
+func (uc *DashboardUseCase) collectTransferReports(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) []transferRow {
+	// MultiPrinter para progress bars por perfil
+	livePrinter, _ := uc.console.GetMultiPrinter().Start()
+	defer livePrinter.Stop()
+
+	results := make([]transferRow, 0, len(profileGroups))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	for _, group := range profileGroups {
+		wg.Add(1)
+		go func(g entity.ProfileGroup) {
+			defer wg.Done()
+
+			bar := uc.console.NewProgressbar(1, fmt.Sprintf("Data Transfer: %s", g.Identifier))
+			bar.Start()
+
+			// Usa o primeiro perfil real do grupo (tal qual trend)
+			profile := g.Profiles[0]
+
+			report, err := uc.awsRepo.GetDataTransferBreakdown(ctx, profile, opts.Period, opts.Metric, args.Tag)
+			if err != nil {
+				mu.Lock()
+				results = append(results, transferRow{Profile: g.Identifier, AccountID: "", Err: err})
+				mu.Unlock()
+				bar.Increment()
+				return
+			}
+
+			accountID := report.AccountID
+			mu.Lock()
+			results = append(results, transferRow{Profile: g.Identifier, AccountID: accountID, Report: report})
+			mu.Unlock()
+			bar.Increment()
+		}(group)
+	}
+	wg.Wait()
+
+	// Ordena por perfil
+	sort.Slice(results, func(i, j int) bool { return results[i].Profile < results[j].Profile })
+	return results
+}
+
+func (uc *DashboardUseCase) generateDashboardData(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) []entity.ProfileData {
 	numJobs := len(profileGroups)
 	jobs := make(chan profileJob, numJobs)
 	results := make(chan entity.ProfileData, numJobs)
@@ -492,7 +553,7 @@ func (uc *DashboardUseCase) generateDashboardData(ctx context.Context, profileGr
 		bar := uc.console.NewProgressbar(5, group.Identifier)
 		// 4. Inicia a barra de progresso. A pterm irá automaticamente associá-la ao MultiPrinter ativo.
 		bar.Start()
-		jobs <- profileJob{Group: group, Args: args, ProgressBar: bar}
+		jobs <- profileJob{Group: group, Args: args, Options: opts, ProgressBar: bar}
 	}
 	close(jobs)
 
@@ -506,23 +567,19 @@ func (uc *DashboardUseCase) generateDashboardData(ctx context.Context, profileGr
 }
 
 func (uc *DashboardUseCase) processProfileJob(ctx context.Context, job profileJob) entity.ProfileData {
-	var timeRange *int
-	if job.Args.TimeRange != nil && *job.Args.TimeRange > 0 {
-		timeRange = job.Args.TimeRange
-	}
-
 	if job.Group.IsCombined {
-		return uc.processCombinedProfile(ctx, job.Group, job.Args.Regions, timeRange, job.Args.Tag, job.Args.BreakdownCosts, job.ProgressBar)
+		return uc.processCombinedProfile(ctx, job.Group, job.Args.Regions, job.Options, job.Args.Tag, job.Args.BreakdownCosts, job.ProgressBar)
 	}
-	return uc.processSingleProfile(ctx, job.Group.Profiles[0], job.Args.Regions, timeRange, job.Args.Tag, job.Args.BreakdownCosts, job.ProgressBar)
+	return uc.processSingleProfile(ctx, job.Group, job.Args.Regions, job.Options, job.Args.Tag, job.Args.BreakdownCosts, job.ProgressBar)
 }
 
-func (uc *DashboardUseCase) processSingleProfile(ctx context.Context, profile string, userRegions []string, timeRange *int, tags []string, breakdown bool, progress *pterm.ProgressbarPrinter) entity.ProfileData {
-	data := entity.ProfileData{Profile: profile, Success: false}
+func (uc *DashboardUseCase) processSingleProfile(ctx context.Context, group entity.ProfileGroup, userRegions []string, opts reportOptions, tags []string, breakdown bool, progress *pterm.ProgressbarPrinter) entity.ProfileData {
+	profile := group.Profiles[0]
+	data := entity.ProfileData{Profile: group.Identifier, Success: false}
 	progress.Increment()
 
 	// Passa a flag 'breakdown' para o repositório
-	costData, err := uc.awsRepo.GetCostData(ctx, profile, timeRange, tags, breakdown)
+	costData, err := uc.awsRepo.GetCostData(ctx, profile, opts.Period, opts.Metric, opts.GroupBy, tags, opts.Exclude, breakdown)
 	if err != nil {
 		data.Err = fmt.Errorf("failed to get cost data: %w", err)
 		return data
@@ -543,18 +600,19 @@ func (uc *DashboardUseCase) processSingleProfile(ctx context.Context, profile st
 	progress.Increment()
 
 	uc.populateProfileData(&data, &costData, ec2Summary)
+	data.Forecast = uc.getCostForecast(ctx, profile, opts, tags, costData.Budgets)
 	progress.Increment()
 
 	return data
 }
 
-func (uc *DashboardUseCase) processCombinedProfile(ctx context.Context, group entity.ProfileGroup, userRegions []string, timeRange *int, tags []string, breakdown bool, progress *pterm.ProgressbarPrinter) entity.ProfileData {
+func (uc *DashboardUseCase) processCombinedProfile(ctx context.Context, group entity.ProfileGroup, userRegions []string, opts reportOptions, tags []string, breakdown bool, progress *pterm.ProgressbarPrinter) entity.ProfileData {
 	data := entity.ProfileData{Profile: group.Identifier, AccountID: group.AccountID, Success: false}
 	primaryProfile := group.Profiles[0]
 	progress.Increment()
 
 	// Passa a flag 'breakdown' para o repositório
-	costData, err := uc.awsRepo.GetCostData(ctx, primaryProfile, timeRange, tags, breakdown)
+	costData, err := uc.awsRepo.GetCostData(ctx, primaryProfile, opts.Period, opts.Metric, opts.GroupBy, tags, opts.Exclude, breakdown)
 	if err != nil {
 		data.Err = fmt.Errorf("failed to get cost data for account: %w", err)
 		return data
@@ -590,6 +648,7 @@ func (uc *DashboardUseCase) processCombinedProfile(ctx context.Context, group en
 	progress.Increment()
 
 	uc.populateProfileData(&data, &costData, combinedEC2Summary)
+	data.Forecast = uc.getCostForecast(ctx, primaryProfile, opts, tags, costData.Budgets)
 	progress.Increment()
 
 	return data
@@ -603,6 +662,10 @@ func (uc *DashboardUseCase) populateProfileData(data *entity.ProfileData, costDa
 	data.PreviousPeriodName = costData.PreviousPeriodName
 	data.ServiceCosts = costData.CurrentMonthCostByService
 	data.ServiceCostsFormatted = uc.formatServiceCosts(costData.CurrentMonthCostByService)
+	data.ExcludedCosts = costData.ExcludedCosts
+	data.Budgets = costData.Budgets
+	data.Metric = costData.Metric
+	data.GroupBy = costData.GroupBy
 	data.BudgetInfo = uc.formatBudgetInfo(costData.Budgets)
 	data.EC2Summary = ec2Summary
 	data.EC2SummaryFormatted = uc.formatEC2Summary(ec2Summary)
@@ -610,6 +673,20 @@ func (uc *DashboardUseCase) populateProfileData(data *entity.ProfileData, costDa
 	data.Success = true
 }
 
+// getCostForecast obtém a previsão de custo do fim do mês e marca os orçamentos que ela ultrapassa.
+// A previsão é opcional: períodos encerrados ou falhas na consulta resultam em nil.
+func (uc *DashboardUseCase) getCostForecast(ctx context.Context, profile string, opts reportOptions, tags []string, budgets []entity.BudgetInfo) *entity.CostForecast {
+	if !opts.Forecast {
+		return nil
+	}
+	forecast, err := uc.awsRepo.GetCostForecast(ctx, profile, opts.Metric, tags, opts.Exclude)
+	if err != nil {
+		return nil
+	}
+	forecast.CheckBudgets(budgets)
+	return &forecast
+}
+
 func (uc *DashboardUseCase) mergeConfig(args *types.CLIArgs) error {
 	if args.ConfigFile == "" {
 		return nil
@@ -632,6 +709,9 @@ func (uc *DashboardUseCase) mergeConfig(args *types.CLIArgs) error {
 	if !args.Combine {
 		args.Combine = cfg.Combine
 	}
+	if !args.Org {
+		args.Org = cfg.Org
+	}
 	if args.ReportName == "" {
 		args.ReportName = cfg.ReportName
 	}
@@ -641,40 +721,132 @@ func (uc *DashboardUseCase) mergeConfig(args *types.CLIArgs) error {
 	if args.Dir == "" {
 		args.Dir = cfg.Dir
 	}
-	if args.TimeRange == nil && cfg.TimeRange > 0 {
-		val := cfg.TimeRange
-		args.TimeRange = &val
+	// O período do arquivo só vale quando nenhum período foi informado na linha de comando.
+	if args.TimeRange == nil && args.From == "" && args.To == "" && args.Month == "" {
+		if cfg.TimeRange > 0 {
+			val := cfg.TimeRange
+			args.TimeRange = &val
+		}
+		args.From, args.To, args.Month = cfg.From, cfg.To, cfg.Month
+	}
+	if args.Metric == "" {
+		args.Metric = cfg.Metric
+	}
+	if len(args.GroupBy) == 0 {
+		args.GroupBy = cfg.GroupBy
 	}
 	if len(args.Tag) == 0 {
 		args.Tag = cfg.Tag
 	}
-	if !args.Trend {
-		args.Trend = cfg.Trend
+	if len(args.Exclude) == 0 {
+		args.Exclude = cfg.Exclude
 	}
-	if !args.Audit {
-		args.Audit = cfg.Audit
+	if args.TrendMonths == 0 {
+		args.TrendMonths = cfg.TrendMonths
 	}
-
-	return nil
-}
-
-func (uc *DashboardUseCase) initializeProfiles(ctx context.Context, args *types.CLIArgs) ([]entity.ProfileGroup, error) {
-	availableProfiles := uc.awsRepo.GetAWSProfiles()
-	var profilesToScan []string
-
-	if args.All {
-		profilesToScan = availableProfiles
-	} else if len(args.Profiles) > 0 {
-		// Validar perfis
-		for _, p := range args.Profiles {
-			found := false
-			for _, ap := range availableProfiles {
-				if p == ap {
-					profilesToScan = append(profilesToScan, p)
-					found = true
-					break
-				}
-			}
+	if args.Granularity == "" {
+		args.Granularity = cfg.Granularity
+	}
+	if args.TrendSeries == "" {
+		args.TrendSeries = cfg.TrendSeries
+	}
+	if args.Term == "" {
+		args.Term = cfg.Term
+	}
+	if args.Payment == "" {
+		args.Payment = cfg.Payment
+	}
+	if args.LookbackDays == 0 {
+		args.LookbackDays = cfg.LookbackDays
+	}
+	if args.ExpiringWithin == 0 {
+		args.ExpiringWithin = cfg.ExpiringWithin
+	}
+	if args.PriceList == "" {
+		args.PriceList = cfg.PriceList
+	}
+	if len(args.Checks) == 0 {
+		args.Checks = cfg.Checks
+	}
+	if args.Suppressions == "" {
+		args.Suppressions = cfg.Suppressions
+	}
+	if args.Baseline == "" {
+		args.Baseline = cfg.Baseline
+	}
+	if args.HistoryDir == "" {
+		args.HistoryDir = cfg.HistoryDir
+	}
+	if !args.NoHistory {
+		args.NoHistory = cfg.NoHistory
+	}
+	if !args.OnlyNew {
+		args.OnlyNew = cfg.OnlyNew
+	}
+	if args.CacheDir == "" {
+		args.CacheDir = cfg.CacheDir
+	}
+	if args.CacheTTL == 0 && cfg.CacheTTL != "" {
+		d, err := time.ParseDuration(cfg.CacheTTL)
+		if err != nil {
+			return fmt.Errorf("invalid cache_ttl %q: %w", cfg.CacheTTL, err)
+		}
+		args.CacheTTL = d
+	}
+	if !args.NoCache {
+		args.NoCache = cfg.NoCache
+	}
+	if !args.Verbose {
+		args.Verbose = cfg.Verbose
+	}
+	if args.AssumeRole == "" {
+		args.AssumeRole = cfg.AssumeRole
+	}
+	if len(args.Accounts) == 0 {
+		args.Accounts = cfg.Accounts
+	}
+	if args.ExternalID == "" {
+		args.ExternalID = cfg.ExternalID
+	}
+	if args.RoleDuration == 0 && cfg.RoleDuration != "" {
+		d, err := time.ParseDuration(cfg.RoleDuration)
+		if err != nil {
+			return fmt.Errorf("invalid role_duration %q: %w", cfg.RoleDuration, err)
+		}
+		args.RoleDuration = d
+	}
+	// O relatório do arquivo só vale quando nenhum subcomando/flag o escolheu.
+	if args.Report == "" {
+		switch {
+		case cfg.Audit && cfg.Trend:
+			return types.ErrConflictingReports
+		case cfg.Audit:
+			args.Report = types.ReportAudit
+		case cfg.Trend:
+			args.Report = types.ReportTrend
+		}
+	}
+
+	return nil
+}
+
+func (uc *DashboardUseCase) initializeProfiles(ctx context.Context, args *types.CLIArgs) ([]entity.ProfileGroup, error) {
+	availableProfiles := uc.awsRepo.GetAWSProfiles()
+	var profilesToScan []string
+
+	if args.All {
+		profilesToScan = availableProfiles
+	} else if len(args.Profiles) > 0 {
+		// Validar perfis
+		for _, p := range args.Profiles {
+			found := false
+			for _, ap := range availableProfiles {
+				if p == ap {
+					profilesToScan = append(profilesToScan, p)
+					found = true
+					break
+				}
+			}
 			if !found {
 				uc.console.LogWarning("Profile '%s' not found.", p)
 			}
@@ -713,6 +885,10 @@ func (uc *DashboardUseCase) initializeProfiles(ctx context.Context, args *types.
 	uc.console.LogSuccess("AWS credentials verified.")
 	// --- FIM DA MELHORIA ---
 
+	if args.AssumeRole != "" {
+		return uc.initializeAssumeRoleGroups(ctx, profilesToScan, args)
+	}
+
 	if !args.Combine {
 		groups := make([]entity.ProfileGroup, len(profilesToScan))
 		for i, p := range profilesToScan {
@@ -755,24 +931,23 @@ func (uc *DashboardUseCase) initializeProfiles(ctx context.Context, args *types.
 	return groups, nil
 }
 
-func (uc *DashboardUseCase) getDisplayTablePeriodInfo(ctx context.Context, profile string, timeRange *int) (string, string, string, string) {
-	costData, err := uc.awsRepo.GetCostData(ctx, profile, timeRange, nil, false)
-
-	if err == nil {
-		pFormat := "2006-01-02"
-		prevDates := fmt.Sprintf("%s to %s", costData.PreviousPeriodStart.Format(pFormat), costData.PreviousPeriodEnd.Format(pFormat))
-		currDates := fmt.Sprintf("%s to %s", costData.CurrentPeriodStart.Format(pFormat), costData.CurrentPeriodEnd.Format(pFormat))
-		return costData.PreviousPeriodName, costData.CurrentPeriodName, prevDates, currDates
-	}
-	return "Last Month Due", "Current Month Cost", "N/A", "N/A"
+// getDisplayTablePeriodInfo retorna os nomes e as datas dos períodos anterior e atual para o cabeçalho da tabela.
+func getDisplayTablePeriodInfo(period entity.Period) (string, string, string, string) {
+	prev := period.Previous()
+	pFormat := "2006-01-02"
+	// End é exclusivo; a tabela mostra o último dia incluído no período.
+	prevDates := fmt.Sprintf("%s to %s", prev.Start.Format(pFormat), prev.End.AddDate(0, 0, -1).Format(pFormat))
+	currDates := fmt.Sprintf("%s to %s", period.Start.Format(pFormat), period.End.AddDate(0, 0, -1).Format(pFormat))
+	return prev.Name("cost"), period.Name("cost"), prevDates, currDates
 }
 
-func (uc *DashboardUseCase) createDisplayTable(previousPeriodDates, currentPeriodDates, previousPeriodName, currentPeriodName string) types.TableInterface {
+func (uc *DashboardUseCase) createDisplayTable(previousPeriodDates, currentPeriodDates, previousPeriodName, currentPeriodName, costColumn string) types.TableInterface {
 	table := uc.console.CreateTable()
 	table.AddColumn("AWS Account Profile")
 	table.AddColumn(fmt.Sprintf("%s\n(%s)", previousPeriodName, previousPeriodDates))
 	table.AddColumn(fmt.Sprintf("%s\n(%s)", currentPeriodName, currentPeriodDates))
-	table.AddColumn("Cost By Service")
+	table.AddColumn("Forecast (EOM)")
+	table.AddColumn(costColumn)
 	table.AddColumn("Budget Status")
 	table.AddColumn("EC2 Instance Summary")
 	return table
@@ -784,6 +959,7 @@ func (uc *DashboardUseCase) addProfileToTable(table types.TableInterface, data e
 			pterm.FgMagenta.Sprint(data.Profile),
 			pterm.FgRed.Sprint("Error"),
 			pterm.FgRed.Sprint("Error"),
+			pterm.FgRed.Sprint("N/A"),
 			pterm.FgRed.Sprintf("Failed: %v", data.Err),
 			pterm.FgRed.Sprint("N/A"),
 			pterm.FgRed.Sprint("N/A"),
@@ -803,16 +979,52 @@ func (uc *DashboardUseCase) addProfileToTable(table types.TableInterface, data e
 		}
 	}
 
+	label := pterm.FgMagenta.Sprintf("Profile: %s\nAccount: %s", data.Profile, data.AccountID)
+	if data.AccountName != "" || data.OUPath != "" {
+		label = pterm.FgMagenta.Sprintf("%s\nAccount: %s\nOU: %s", data.AccountName, data.AccountID, data.OUPath)
+	}
+
 	table.AddRow(
-		pterm.FgMagenta.Sprintf("Profile: %s\nAccount: %s", data.Profile, data.AccountID),
+		label,
 		pterm.Bold.Sprintf("$%.2f", data.LastMonth),
-		fmt.Sprintf("%s%s", pterm.Bold.Sprintf("$%.2f", data.CurrentMonth), changeText),
+		fmt.Sprintf("%s%s%s", pterm.Bold.Sprintf("$%.2f", data.CurrentMonth), changeText, formatExcludedCosts(data.ExcludedCosts)),
+		formatForecast(data.Forecast),
 		strings.Join(data.ServiceCostsFormatted, "\n"),
 		strings.Join(data.BudgetInfo, "\n\n"),
 		strings.Join(data.EC2SummaryFormatted, "\n"),
 	)
 }
 
+// formatExcludedCosts lista os valores removidos do total por --exclude, para conciliar com a fatura.
+func formatExcludedCosts(excluded []entity.ServiceCost) string {
+	if len(excluded) == 0 {
+		return ""
+	}
+	lines := []string{"\n"}
+	for _, e := range excluded {
+		lines = append(lines, pterm.FgGray.Sprintf("Excl. %s: $%.2f", e.ServiceName, e.Cost))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatForecast formata a previsão do fim do mês com o intervalo de confiança e os orçamentos ultrapassados.
+func formatForecast(forecast *entity.CostForecast) string {
+	if forecast == nil {
+		return pterm.FgGray.Sprint("N/A")
+	}
+	lines := []string{
+		pterm.Bold.Sprintf("$%.2f", forecast.Amount),
+		pterm.FgGray.Sprintf("(%s)", forecast.BoundsLabel()),
+	}
+	if forecast.Source == entity.ForecastSourceLinear {
+		lines = append(lines, pterm.FgGray.Sprint("linear projection"))
+	}
+	if len(forecast.ExceededBudgets) > 0 {
+		lines = append(lines, pterm.FgRed.Sprintf("\n⚠ Exceeds budget:\n%s", strings.Join(forecast.ExceededBudgets, "\n")))
+	}
+	return strings.Join(lines, "\n")
+}
+
 func (uc *DashboardUseCase) exportCostDashboardReports(results []entity.ProfileData, args *types.CLIArgs, prevDates, currDates string) {
 	uc.console.LogInfo("Exporting reports...")
 	for _, reportType := range args.ReportType {
@@ -843,151 +1055,59 @@ func (uc *DashboardUseCase) exportCostDashboardReports(results []entity.ProfileD
 }
 
 // runAuditReport com progress bars por perfil (MultiPrinter) e sem updates concorrentes no spinner.
-func (uc *DashboardUseCase) runAuditReport(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) error {
+func (uc *DashboardUseCase) runAuditReport(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) error {
 	uc.console.LogInfo("Preparing your audit report...")
 
-	livePrinter, _ := uc.console.GetMultiPrinter().Start()
-	defer livePrinter.Stop()
+	auditDataList := uc.collectAuditData(ctx, profileGroups, args, opts)
+
+	var savingsSource string
+	for _, data := range auditDataList {
+		if data.PotentialSavings != nil {
+			savingsSource = data.PotentialSavings.Source
+		}
+	}
 
 	// Tabela do terminal
 	table := uc.console.CreateTable()
 	table.AddColumn("Profile")
 	table.AddColumn("Account ID")
-	table.AddColumn("Budget Alerts")
-	table.AddColumn("High-Cost NAT GWs")
-	table.AddColumn("Unused VPC Endpoints")
-	table.AddColumn("Idle Load Balancers")
-	table.AddColumn("Stopped EC2")
-	table.AddColumn("Unused Volumes")
-	table.AddColumn("Unused Elastic IPs")
-	table.AddColumn("Untagged Resources")
-
-	var auditDataList []entity.AuditData
-	var mu sync.Mutex
-	var wg sync.WaitGroup
-
-	for _, group := range profileGroups {
-		wg.Add(1)
-		go func(g entity.ProfileGroup) {
-			defer wg.Done()
-
-			// Uma barra por perfil (8 etapas)
-			const totalSteps = 8
-			bar := uc.console.NewProgressbar(totalSteps, fmt.Sprintf("Auditing: %s", g.Identifier))
-			bar.Start()
-
-			profile := g.Profiles[0]
-
-			var timeRange *int
-			if args.TimeRange != nil && *args.TimeRange > 0 {
-				timeRange = args.TimeRange
-			}
-
-			regions := args.Regions
-			if len(regions) == 0 {
-				regions, _ = uc.awsRepo.GetAccessibleRegions(ctx, profile)
-			}
-
-			var (
-				natCosts        []entity.NatGatewayCost
-				idleLBs         entity.IdleLoadBalancers
-				stopped         entity.StoppedEC2Instances
-				unusedVols      entity.UnusedVolumes
-				unusedEIPs      entity.UnusedEIPs
-				untagged        entity.UntaggedResources
-				unusedEndpoints entity.UnusedVpcEndpoints
-				budgets         []entity.BudgetInfo
-			)
-
-			var auditWg sync.WaitGroup
-			auditWg.Add(totalSteps)
-
-			go func() {
-				defer auditWg.Done()
-				natCosts, _ = uc.awsRepo.GetNatGatewayCost(ctx, profile, timeRange, args.Tag)
-				bar.Increment()
-			}()
-			go func() {
-				defer auditWg.Done()
-				idleLBs, _ = uc.awsRepo.GetIdleLoadBalancers(ctx, profile, regions)
-				bar.Increment()
-			}()
-			go func() {
-				defer auditWg.Done()
-				stopped, _ = uc.awsRepo.GetStoppedInstances(ctx, profile, regions)
-				bar.Increment()
-			}()
-			go func() {
-				defer auditWg.Done()
-				unusedVols, _ = uc.awsRepo.GetUnusedVolumes(ctx, profile, regions)
-				bar.Increment()
-			}()
-			go func() {
-				defer auditWg.Done()
-				unusedEIPs, _ = uc.awsRepo.GetUnusedEIPs(ctx, profile, regions)
-				bar.Increment()
-			}()
-			go func() {
-				defer auditWg.Done()
-				untagged, _ = uc.awsRepo.GetUntaggedResources(ctx, profile, regions)
-				bar.Increment()
-			}()
-			go func() {
-				defer auditWg.Done()
-				unusedEndpoints, _ = uc.awsRepo.GetUnusedVpcEndpoints(ctx, profile, regions)
-				bar.Increment()
-			}()
-			go func() { defer auditWg.Done(); budgets, _ = uc.awsRepo.GetBudgets(ctx, profile); bar.Increment() }()
-
-			auditWg.Wait() // barra chega ao total e some (RemoveWhenDone = true)
-
-			accountID, _ := uc.awsRepo.GetAccountID(ctx, profile)
-			natCostsStr := formatNatGatewayCosts(natCosts)
-			idleLBsStr := formatAuditMap(idleLBs, "Idle Load Balancers")
-			stoppedStr := formatAuditMap(stopped, "Stopped Instances")
-			volsStr := formatAuditMap(unusedVols, "Unused Volumes")
-			eipsStr := formatAuditMap(unusedEIPs, "Unused Elastic IPs")
-			untaggedStr := formatAuditMapForUntagged(untagged)
-			unusedEndpointsStr := formatAuditMap(unusedEndpoints, "Unused VPC Endpoints")
-			alertsStr := formatBudgetAlerts(budgets)
-
-			mu.Lock()
-			auditDataList = append(auditDataList, entity.AuditData{
-				Profile:            profile,
-				AccountID:          accountID,
-				NatGatewayCosts:    natCostsStr,
-				IdleLoadBalancers:  idleLBsStr,
-				StoppedInstances:   stoppedStr,
-				UnusedVolumes:      volsStr,
-				UnusedEIPs:         eipsStr,
-				UntaggedResources:  untaggedStr,
-				UnusedVpcEndpoints: unusedEndpointsStr,
-				BudgetAlerts:       alertsStr,
-			})
-			mu.Unlock()
-		}(group)
+	for _, c := range opts.Checks {
+		table.AddColumn(c.Title())
 	}
-	wg.Wait()
-
-	// Ordenação por perfil
-	sort.Slice(auditDataList, func(i, j int) bool { return auditDataList[i].Profile < auditDataList[j].Profile })
+	table.AddColumn("Potential Monthly Savings")
 
 	// Escreve a tabela
 	for _, data := range auditDataList {
-		table.AddRow(
-			pterm.FgMagenta.Sprint(data.Profile),
-			data.AccountID,
-			data.BudgetAlerts,
-			data.NatGatewayCosts,
-			data.UnusedVpcEndpoints,
-			data.IdleLoadBalancers,
-			data.StoppedInstances,
-			data.UnusedVolumes,
-			data.UnusedEIPs,
-			data.UntaggedResources,
-		)
+		row := []interface{}{pterm.FgMagenta.Sprint(data.Profile), data.AccountID}
+		for _, c := range opts.Checks {
+			row = append(row, formatCheckFindings(c.ID(), entity.FilterFindings(data.Findings, c.ID()), !opts.OnlyNew))
+		}
+		row = append(row, formatPotentialSavings(data.PotentialSavings, data.SavingsError))
+		table.AddRow(row...)
 	}
 	uc.console.Println("\n" + table.Render())
+	if savingsSource != "" {
+		uc.console.Println(pterm.FgGray.Sprintf("Potential savings: on-demand prices from %s; EBS of stopped instances, Elastic IP and load balancer hours (capacity units not included).", savingsSource))
+	}
+	for _, data := range auditDataList {
+		if data.SavingsError != "" {
+			uc.console.LogWarning("Potential savings for %s are N/A: could not get on-demand prices: %s", data.Profile, data.SavingsError)
+		}
+	}
+	for _, data := range auditDataList {
+		if n := len(data.Suppressed); n > 0 {
+			uc.console.Println(pterm.FgGray.Sprintf("%s: %d findings suppressed by %s (listed in the exports).", data.Profile, n, args.Suppressions))
+		}
+	}
+	for _, data := range auditDataList {
+		if b := data.Baseline; b != nil {
+			line := fmt.Sprintf("%s: %d new, %d unchanged, %d resolved since %s", data.Profile, b.New, b.Unchanged, b.Resolved, b.Source)
+			if opts.OnlyNew {
+				line += " (showing new findings only)"
+			}
+			uc.console.Println(pterm.FgGray.Sprint(line + "."))
+		}
+	}
 
 	if args.ReportName != "" {
 		uc.console.LogInfo("Exporting audit reports...")
@@ -1018,174 +1138,211 @@ func (uc *DashboardUseCase) runAuditReport(ctx context.Context, profileGroups []
 		}
 	}
 
+	snapshot := newSnapshot(args, opts, opts.Period)
+	snapshot.Audits = auditDataList
+	uc.saveSnapshot(args, snapshot)
+
 	return nil
 }
 
-func formatNatGatewayCosts(costs []entity.NatGatewayCost) string {
-	if len(costs) == 0 {
+// collectAuditData executa as verificações de auditoria de cada grupo de perfis em paralelo.
+func (uc *DashboardUseCase) collectAuditData(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) []entity.AuditData {
+	runs := uc.collectAuditRuns(ctx, profileGroups, args, opts, true)
+
+	auditDataList := make([]entity.AuditData, 0, len(runs))
+	for _, run := range runs {
+		for _, id := range slices.Sorted(maps.Keys(run.CheckErrs)) {
+			uc.console.LogWarning("Check %s failed for %s: %v", id, run.Profile, run.CheckErrs[id])
+		}
+		auditDataList = append(auditDataList, newAuditData(run, opts))
+	}
+	return auditDataList
+}
+
+// collectAuditRuns executa as verificações selecionadas de cada grupo de perfis em paralelo, ordenadas por
+// perfil. Com withPrices, os recursos ociosos também são precificados para a estimativa de economia.
+func (uc *DashboardUseCase) collectAuditRuns(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions, withPrices bool) []auditRun {
+	livePrinter, _ := uc.console.GetMultiPrinter().Start()
+	defer livePrinter.Stop()
+
+	results := make([]auditRun, 0, len(profileGroups))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, group := range profileGroups {
+		wg.Add(1)
+		go func(g entity.ProfileGroup) {
+			defer wg.Done()
+
+			// Uma barra por perfil, com uma etapa por verificação
+			bar := uc.console.NewProgressbar(len(opts.Checks), fmt.Sprintf("Auditing: %s", g.Identifier))
+			bar.Start()
+
+			profile := g.Profiles[0]
+
+			regions := args.Regions
+			if len(regions) == 0 {
+				regions, _ = uc.awsRepo.GetAccessibleRegions(ctx, profile)
+			}
+			accountID, _ := uc.awsRepo.GetAccountID(ctx, profile)
+
+			env := uc.newCheckEnv(profile, accountID, regions, args, opts, withPrices)
+			run := runAuditChecks(ctx, env, opts.Checks, func() { bar.Increment() }) // barra some ao chegar ao total
+			run.Profile = g.Identifier
+
+			mu.Lock()
+			results = append(results, run)
+			mu.Unlock()
+		}(group)
+	}
+	wg.Wait()
+
+	// Ordenação por perfil
+	sort.Slice(results, func(i, j int) bool { return results[i].Profile < results[j].Profile })
+	return results
+}
+
+// formatCheckFindings formata a célula de uma verificação na tabela de auditoria. Com markNew, os achados
+// novos desde a auditoria de --baseline são destacados.
+func formatCheckFindings(checkID string, findings []entity.Finding, markNew bool) string {
+	switch checkID {
+	case entity.CheckBudgetExceeded:
+		return formatBudgetFindings(findings, markNew)
+	case entity.CheckHighCostNatGateway:
+		return formatNatGatewayFindings(findings, markNew)
+	case entity.CheckUntaggedResource:
+		return formatUntaggedFindings(findings, markNew)
+	default:
+		return formatRegionalFindings(findings, markNew)
+	}
+}
+
+// newFindingMarker destaca um achado novo desde a auditoria de --baseline.
+func newFindingMarker(f entity.Finding, markNew bool) string {
+	if !markNew || f.Baseline != entity.BaselineNew {
+		return ""
+	}
+	return " " + pterm.FgLightYellow.Sprint("[new]")
+}
+
+// formatNatGatewayFindings mostra os NAT Gateways mais caros do período.
+func formatNatGatewayFindings(findings []entity.Finding, markNew bool) string {
+	if len(findings) == 0 {
 		return "None"
 	}
+	sorted := slices.Clone(findings)
+	sort.SliceStable(sorted, func(i, j int) bool { return findingCost(sorted[i]) > findingCost(sorted[j]) })
+
 	var builder strings.Builder
 	// Limita a exibição aos 5 mais caros para não poluir a UI
 	limit := 5
-	if len(costs) < limit {
-		limit = len(costs)
+	if len(sorted) < limit {
+		limit = len(sorted)
 	}
 
-	for i := 0; i < limit; i++ {
-		c := costs[i]
-		builder.WriteString(pterm.FgRed.Sprintf("$%.2f", c.Cost))
-		builder.WriteString(fmt.Sprintf(" - %s (%s)\n", c.ResourceID, c.Region))
+	for _, f := range sorted[:limit] {
+		builder.WriteString(pterm.FgRed.Sprintf("$%.2f", findingCost(f)))
+		builder.WriteString(fmt.Sprintf(" - %s (%s)%s\n", f.ResourceID, f.Region, newFindingMarker(f, markNew)))
 	}
 	return strings.TrimSpace(builder.String())
 }
 
+// findingCost é o custo estimado de um achado, zero quando não há preço.
+func findingCost(f entity.Finding) float64 {
+	if f.EstimatedCost == nil {
+		return 0
+	}
+	return *f.EstimatedCost
+}
+
 // Limite “de produção” para não poluir terminal/exports quando há muitos itens.
 const maxItemsPerRegion = 50
 
-// formatAuditMapForUntagged com limite por região e ordenação determinística.
-func formatAuditMapForUntagged(data entity.UntaggedResources) string {
-	var builder strings.Builder
-	hasContent := false
-
-	services := make([]string, 0, len(data))
-	for s := range data {
+// formatUntaggedFindings agrupa os recursos sem tags por serviço e região, com limite por região.
+func formatUntaggedFindings(findings []entity.Finding, markNew bool) string {
+	if len(findings) == 0 {
+		return "None"
+	}
+	byService := make(map[string][]entity.Finding)
+	for _, f := range findings {
+		byService[f.Service] = append(byService[f.Service], f)
+	}
+	services := make([]string, 0, len(byService))
+	for s := range byService {
 		services = append(services, s)
 	}
 	sort.Strings(services)
 
+	var builder strings.Builder
 	for _, service := range services {
-		regions := data[service]
-		if len(regions) == 0 {
-			continue
-		}
-		hasContent = true
 		builder.WriteString(pterm.FgYellow.Sprintf("%s:\n", service))
-
-		regionNames := make([]string, 0, len(regions))
-		for r := range regions {
-			regionNames = append(regionNames, r)
-		}
-		sort.Strings(regionNames)
-
-		for _, region := range regionNames {
-			items := regions[region]
-			if len(items) == 0 {
-				continue
-			}
-			sort.Strings(items)
-
-			builder.WriteString(pterm.FgCyan.Sprintf("  %s:\n", region))
-
-			// Aplica limite por região
-			limit := len(items)
-			if limit > maxItemsPerRegion {
-				limit = maxItemsPerRegion
-			}
-			for _, item := range items[:limit] {
-				builder.WriteString(fmt.Sprintf("    - %s\n", item))
-			}
-			if len(items) > limit {
-				builder.WriteString(fmt.Sprintf("    ... (+%d more)\n", len(items)-limit))
-			}
-		}
-	}
-
-	if !hasContent {
-		return "None"
+		writeFindingsByRegion(&builder, byService[service], "  ", func(f entity.Finding) string {
+			return f.ResourceID + newFindingMarker(f, markNew)
+		})
 	}
 	return builder.String()
 }
 
-// formatAuditMap genérico (Idle LBs, Stopped, Volumes, EIPs, VPC Endpoints) com limite por região.
-func formatAuditMap[V ~map[string][]string](data V, title string) string {
-	if len(data) == 0 {
+// formatRegionalFindings lista os achados (Idle LBs, Stopped, Volumes, EIPs, VPC Endpoints) por região,
+// com os detalhes e o custo mensal estimado de cada recurso.
+func formatRegionalFindings(findings []entity.Finding, markNew bool) string {
+	if len(findings) == 0 {
 		return "None"
 	}
 	var builder strings.Builder
+	writeFindingsByRegion(&builder, findings, "", func(f entity.Finding) string {
+		label := f.ResourceID
+		if f.Details != "" {
+			label += " (" + f.Details + ")"
+		}
+		if f.EstimatedCost != nil {
+			label += " - " + pterm.FgRed.Sprintf("$%.2f/mo", *f.EstimatedCost)
+		}
+		return label + newFindingMarker(f, markNew)
+	})
+	return builder.String()
+}
 
-	regions := make([]string, 0, len(data))
-	for r := range data {
+// writeFindingsByRegion escreve os achados agrupados por região, em ordem, com limite por região.
+func writeFindingsByRegion(builder *strings.Builder, findings []entity.Finding, indent string, label func(entity.Finding) string) {
+	byRegion := make(map[string][]entity.Finding)
+	for _, f := range findings {
+		byRegion[f.Region] = append(byRegion[f.Region], f)
+	}
+	regions := make([]string, 0, len(byRegion))
+	for r := range byRegion {
 		regions = append(regions, r)
 	}
 	sort.Strings(regions)
 
 	for _, region := range regions {
-		items := data[region]
-		if len(items) == 0 {
-			continue
-		}
-		sort.Strings(items)
+		items := byRegion[region]
+		sort.SliceStable(items, func(i, j int) bool { return items[i].ResourceID < items[j].ResourceID })
 
-		builder.WriteString(pterm.FgCyan.Sprintf("%s:\n", region))
+		builder.WriteString(pterm.FgCyan.Sprintf("%s%s:\n", indent, region))
 
 		limit := len(items)
 		if limit > maxItemsPerRegion {
 			limit = maxItemsPerRegion
 		}
 		for _, item := range items[:limit] {
-			builder.WriteString(fmt.Sprintf("  - %s\n", item))
+			builder.WriteString(fmt.Sprintf("%s  - %s\n", indent, label(item)))
 		}
 		if len(items) > limit {
-			builder.WriteString(fmt.Sprintf("  ... (+%d more)\n", len(items)-limit))
+			builder.WriteString(fmt.Sprintf("%s  ... (+%d more)\n", indent, len(items)-limit))
 		}
 	}
-	return builder.String()
 }
 
-func formatBudgetAlerts(budgets []entity.BudgetInfo) string {
-	var alerts []string
-	for _, b := range budgets {
-		if b.Actual > b.Limit {
-			alerts = append(alerts, pterm.FgRed.Sprintf("%s: $%.2f > $%.2f", b.Name, b.Actual, b.Limit))
-		}
-	}
-	if len(alerts) == 0 {
+func formatBudgetFindings(findings []entity.Finding, markNew bool) string {
+	if len(findings) == 0 {
 		return "No budgets exceeded"
 	}
-	return strings.Join(alerts, "\n")
-}
-
-func (uc *DashboardUseCase) runTrendAnalysis(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) error {
-	uc.console.LogInfo("Analysing cost trends...")
-	status := uc.console.Status("Fetching trend data...")
-	defer status.Stop()
-
-	for _, group := range profileGroups {
-		status.Update(fmt.Sprintf("Fetching trend for %s...", group.Identifier))
-		profileForAPI := group.Profiles[0] // Usa o primeiro perfil para a chamada de API
-
-		trendData, err := uc.awsRepo.GetTrendData(ctx, profileForAPI, args.Tag)
-		if err != nil {
-			uc.console.LogError("Error getting trend for %s: %v", group.Identifier, err)
-			continue
-		}
-
-		monthlyCosts, ok := trendData["monthly_costs"].([]entity.MonthlyCost)
-		if !ok || len(monthlyCosts) == 0 {
-			uc.console.LogWarning("No trend data available for %s", group.Identifier)
-			continue
-		}
-
-		accountID, _ := trendData["account_id"].(string)
-
-		var title string
-		if group.IsCombined {
-			title = fmt.Sprintf("Account: %s (Profiles: %s)", accountID, group.Identifier)
-		} else {
-			title = fmt.Sprintf("Account: %s (Profile: %s)", accountID, group.Identifier)
-		}
-		uc.console.Println("\n" + pterm.FgYellow.Sprint(title))
-
-		// Converter para o tipo esperado pela UI
-		uiMonthlyCosts := make([]types.MonthlyCost, len(monthlyCosts))
-		for i, mc := range monthlyCosts {
-			uiMonthlyCosts[i] = types.MonthlyCost{Month: mc.Month, Cost: mc.Cost}
-		}
-		uc.console.DisplayTrendBars(uiMonthlyCosts)
+	alerts := make([]string, 0, len(findings))
+	for _, f := range findings {
+		alerts = append(alerts, pterm.FgRed.Sprintf("%s: %s", f.ResourceID, f.Details)+newFindingMarker(f, markNew))
 	}
-
-	return nil
+	return strings.Join(alerts, "\n")
 }
 
 func (uc *DashboardUseCase) formatServiceCosts(costs []entity.ServiceCost) []string {
@@ -1265,133 +1422,18 @@ func (uc *DashboardUseCase) calculatePercentageChange(current, previous float64)
 	return nil
 }
 
+// s3Row é o resultado da auditoria de S3 para um grupo de perfis.
+type s3Row struct {
+	Profile   string
+	AccountID string
+	Audit     entity.S3LifecycleAudit
+	Err       error
+}
+
 func (uc *DashboardUseCase) runS3LifecycleAudit(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) error {
 	uc.console.LogInfo("Auditing S3 lifecycle, encryption and public access...")
 
-	livePrinter, _ := uc.console.GetMultiPrinter().Start()
-	defer livePrinter.Stop()
-
-	type row struct {
-		Profile   string
-		AccountID string
-		Audit     entity.S3LifecycleAudit
-		Err       error
-	}
-
-	results := make([]row, 0, len(profileGroups))
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-
-	for _, group := range profileGroups {
-		wg.Add(1)
-		go func(g entity.ProfileGroup) {
-			defer wg.Done()
-			bar := uc.console.NewProgressbar(2, fmt.Sprintf("S3 Audit: %s", g.Identifier))
-			bar.Start()
-
-			profile := g.Profiles[0]
-			accountID, _ := uc.awsRepo.GetAccountID(ctx, profile)
-			bar.Increment()
-
-			statuses, err := uc.awsRepo.GetS3LifecycleStatus(ctx, profile)
-			if err != nil {
-				mu.Lock()
-				results = append(results, row{Profile: g.Identifier, Err: err})
-				mu.Unlock()
-				bar.Increment()
-				return
-			}
-			bar.Increment()
-
-			// Agrega
-			total := len(statuses)
-			noLifecycle := 0
-			versionedMissingNoncurrent := 0
-			noIT := 0
-			noDefaultEnc := 0
-			publicRisk := 0
-
-			regionMap := make(map[string]int)
-
-			sampleNoLifecycle := make([]entity.S3BucketLifecycleStatus, 0, 10)
-			sampleVersionedNoNoncurrent := make([]entity.S3BucketLifecycleStatus, 0, 10)
-			sampleNoIT := make([]entity.S3BucketLifecycleStatus, 0, 10)
-			sampleNoEnc := make([]entity.S3BucketLifecycleStatus, 0, 10)
-			samplePublic := make([]entity.S3BucketLifecycleStatus, 0, 10)
-
-			// Ordena por nome para amostras determinísticas
-			sort.Slice(statuses, func(i, j int) bool { return statuses[i].Bucket < statuses[j].Bucket })
-
-			for _, s := range statuses {
-				// Lifecycle faltando
-				if !s.HasLifecycle {
-					noLifecycle++
-					regionMap[s.Region]++
-					if len(sampleNoLifecycle) < 10 {
-						sampleNoLifecycle = append(sampleNoLifecycle, s)
-					}
-				}
-				// Versioning sem Noncurrent
-				if s.VersioningEnabled && !s.HasNoncurrentLifecycle {
-					versionedMissingNoncurrent++
-					if len(sampleVersionedNoNoncurrent) < 10 {
-						sampleVersionedNoNoncurrent = append(sampleVersionedNoNoncurrent, s)
-					}
-				}
-				// Intelligent-Tiering ausente (nem via Lifecycle, nem config explícita)
-				if !s.HasIntelligentTieringCfg && !s.HasIntelligentTieringViaLifecycle {
-					noIT++
-					if len(sampleNoIT) < 10 {
-						sampleNoIT = append(sampleNoIT, s)
-					}
-				}
-				// Criptografia padrão ausente
-				if !s.DefaultEncryptionEnabled {
-					noDefaultEnc++
-					if len(sampleNoEnc) < 10 {
-						sampleNoEnc = append(sampleNoEnc, s)
-					}
-				}
-				// Risco de público
-				if s.IsPublic || !s.BlockPublicAcls || !s.BlockPublicPolicy || !s.IgnorePublicAcls || !s.RestrictPublicBuckets {
-					publicRisk++
-					if len(samplePublic) < 10 {
-						samplePublic = append(samplePublic, s)
-					}
-				}
-			}
-
-			audit := entity.S3LifecycleAudit{
-				Profile:                              g.Identifier,
-				AccountID:                            accountID,
-				TotalBuckets:                         total,
-				NoLifecycleCount:                     noLifecycle,
-				VersionedWithoutNoncurrentLifecycle:  versionedMissingNoncurrent,
-				NoIntelligentTieringCount:            noIT,
-				NoDefaultEncryptionCount:             noDefaultEnc,
-				PublicRiskCount:                      publicRisk,
-				SampleNoLifecycle:                    sampleNoLifecycle,
-				SampleVersionedWithoutNoncurrentRule: sampleVersionedNoNoncurrent,
-				SampleNoIntelligentTiering:           sampleNoIT,
-				SampleNoDefaultEncryption:            sampleNoEnc,
-				SamplePublicRisk:                     samplePublic,
-				RegionsNoLifecycle:                   regionMap,
-				RecommendedMessage:                   "Set lifecycle (incl. noncurrent rules), enable default encryption (SSE-S3/KMS), enforce Public Access Block and avoid public ACL/policies; consider Intelligent-Tiering for unpredictable access.",
-			}
-
-			mu.Lock()
-			results = append(results, row{
-				Profile:   g.Identifier,
-				AccountID: accountID,
-				Audit:     audit,
-			})
-			mu.Unlock()
-		}(group)
-	}
-	wg.Wait()
-
-	// Ordena por perfil
-	sort.Slice(results, func(i, j int) bool { return results[i].Profile < results[j].Profile })
+	results := uc.collectS3Audits(ctx, profileGroups, args)
 
 	// Monta tabela
 	table := uc.console.CreateTable()
@@ -1499,19 +1541,12 @@ func (uc *DashboardUseCase) runS3LifecycleAudit(ctx context.Context, profileGrou
 	return nil
 }
 
-func (uc *DashboardUseCase) runCommitmentsReport(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) error {
-	uc.console.LogInfo("Analysing Savings Plans / Reserved Instances coverage & utilization...")
-
+// collectS3Audits audita os buckets S3 de cada grupo de perfis em paralelo.
+func (uc *DashboardUseCase) collectS3Audits(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) []s3Row {
 	livePrinter, _ := uc.console.GetMultiPrinter().Start()
 	defer livePrinter.Stop()
 
-	type row struct {
-		Profile string
-		Report  entity.CommitmentsReport
-		Err     error
-	}
-	results := make([]row, 0, len(profileGroups))
-
+	results := make([]s3Row, 0, len(profileGroups))
 	var wg sync.WaitGroup
 	var mu sync.Mutex
 
@@ -1519,59 +1554,128 @@ func (uc *DashboardUseCase) runCommitmentsReport(ctx context.Context, profileGro
 		wg.Add(1)
 		go func(g entity.ProfileGroup) {
 			defer wg.Done()
-			bar := uc.console.NewProgressbar(2, fmt.Sprintf("Commitments: %s", g.Identifier))
+			bar := uc.console.NewProgressbar(2, fmt.Sprintf("S3 Audit: %s", g.Identifier))
 			bar.Start()
 
 			profile := g.Profiles[0]
-
-			var timeRange *int
-			if args.TimeRange != nil && *args.TimeRange > 0 {
-				timeRange = args.TimeRange
-			}
-
-			sp, err1 := uc.awsRepo.GetSavingsPlansSummary(ctx, profile, timeRange, args.Tag)
-			bar.Increment()
-			ri, err2 := uc.awsRepo.GetReservationSummary(ctx, profile, timeRange, args.Tag)
+			accountID, _ := uc.awsRepo.GetAccountID(ctx, profile)
 			bar.Increment()
 
-			if err1 != nil {
+			statuses, err := uc.awsRepo.GetS3LifecycleStatus(ctx, profile)
+			if err != nil {
 				mu.Lock()
-				results = append(results, row{
-					Profile: g.Identifier,
-					Err:     fmt.Errorf("SP error: %w", err1),
-				})
+				results = append(results, s3Row{Profile: g.Identifier, Err: err})
 				mu.Unlock()
+				bar.Increment()
 				return
 			}
-			if err2 != nil {
-				mu.Lock()
-				results = append(results, row{
-					Profile: g.Identifier,
-					Err:     fmt.Errorf("RI error: %w", err2),
-				})
-				mu.Unlock()
-				return
+			bar.Increment()
+
+			// Agrega
+			total := len(statuses)
+			noLifecycle := 0
+			versionedMissingNoncurrent := 0
+			noIT := 0
+			noDefaultEnc := 0
+			publicRisk := 0
+
+			regionMap := make(map[string]int)
+
+			sampleNoLifecycle := make([]entity.S3BucketLifecycleStatus, 0, 10)
+			sampleVersionedNoNoncurrent := make([]entity.S3BucketLifecycleStatus, 0, 10)
+			sampleNoIT := make([]entity.S3BucketLifecycleStatus, 0, 10)
+			sampleNoEnc := make([]entity.S3BucketLifecycleStatus, 0, 10)
+			samplePublic := make([]entity.S3BucketLifecycleStatus, 0, 10)
+
+			// Ordena por nome para amostras determinísticas
+			sort.Slice(statuses, func(i, j int) bool { return statuses[i].Bucket < statuses[j].Bucket })
+
+			for _, s := range statuses {
+				// Lifecycle faltando
+				if !s.HasLifecycle {
+					noLifecycle++
+					regionMap[s.Region]++
+					if len(sampleNoLifecycle) < 10 {
+						sampleNoLifecycle = append(sampleNoLifecycle, s)
+					}
+				}
+				// Versioning sem Noncurrent
+				if s.VersioningEnabled && !s.HasNoncurrentLifecycle {
+					versionedMissingNoncurrent++
+					if len(sampleVersionedNoNoncurrent) < 10 {
+						sampleVersionedNoNoncurrent = append(sampleVersionedNoNoncurrent, s)
+					}
+				}
+				// Intelligent-Tiering ausente (nem via Lifecycle, nem config explícita)
+				if !s.HasIntelligentTieringCfg && !s.HasIntelligentTieringViaLifecycle {
+					noIT++
+					if len(sampleNoIT) < 10 {
+						sampleNoIT = append(sampleNoIT, s)
+					}
+				}
+				// Criptografia padrão ausente
+				if !s.DefaultEncryptionEnabled {
+					noDefaultEnc++
+					if len(sampleNoEnc) < 10 {
+						sampleNoEnc = append(sampleNoEnc, s)
+					}
+				}
+				// Risco de público
+				if s.IsPublic || !s.BlockPublicAcls || !s.BlockPublicPolicy || !s.IgnorePublicAcls || !s.RestrictPublicBuckets {
+					publicRisk++
+					if len(samplePublic) < 10 {
+						samplePublic = append(samplePublic, s)
+					}
+				}
 			}
 
-			rep := entity.CommitmentsReport{
-				AccountID:  sp.AccountID,
-				Profile:    g.Identifier,
-				SPSummary:  sp,
-				RISummary:  ri,
-				PeriodName: sp.PeriodName,
+			audit := entity.S3LifecycleAudit{
+				Profile:                              g.Identifier,
+				AccountID:                            accountID,
+				TotalBuckets:                         total,
+				NoLifecycleCount:                     noLifecycle,
+				VersionedWithoutNoncurrentLifecycle:  versionedMissingNoncurrent,
+				NoIntelligentTieringCount:            noIT,
+				NoDefaultEncryptionCount:             noDefaultEnc,
+				PublicRiskCount:                      publicRisk,
+				SampleNoLifecycle:                    sampleNoLifecycle,
+				SampleVersionedWithoutNoncurrentRule: sampleVersionedNoNoncurrent,
+				SampleNoIntelligentTiering:           sampleNoIT,
+				SampleNoDefaultEncryption:            sampleNoEnc,
+				SamplePublicRisk:                     samplePublic,
+				RegionsNoLifecycle:                   regionMap,
+				RecommendedMessage:                   "Set lifecycle (incl. noncurrent rules), enable default encryption (SSE-S3/KMS), enforce Public Access Block and avoid public ACL/policies; consider Intelligent-Tiering for unpredictable access.",
 			}
 
 			mu.Lock()
-			results = append(results, row{
-				Profile: g.Identifier,
-				Report:  rep,
+			results = append(results, s3Row{
+				Profile:   g.Identifier,
+				AccountID: accountID,
+				Audit:     audit,
 			})
 			mu.Unlock()
 		}(group)
 	}
 	wg.Wait()
 
+	// Ordena por perfil
 	sort.Slice(results, func(i, j int) bool { return results[i].Profile < results[j].Profile })
+	return results
+}
+
+// commitmentsRow é o resultado do relatório de SP/RI para um grupo de perfis.
+type commitmentsRow struct {
+	Profile string
+	Report  entity.CommitmentsReport
+	Err     error
+	// RecommendationsErr é o erro das recomendações de compra, que não invalida a cobertura.
+	RecommendationsErr error
+}
+
+func (uc *DashboardUseCase) runCommitmentsReport(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) error {
+	uc.console.LogInfo("Analysing Savings Plans / Reserved Instances coverage & utilization...")
+
+	results := uc.collectCommitmentsReports(ctx, profileGroups, args, opts, true)
 
 	// Monta tabela
 	table := uc.console.CreateTable()
@@ -1675,6 +1779,23 @@ func (uc *DashboardUseCase) runCommitmentsReport(ctx context.Context, profileGro
 		}
 	}
 
+	uc.console.Println(pterm.FgYellow.Sprintf("\nPurchase Recommendations (%s)", opts.Purchase.Label()))
+	uc.console.Println(uc.renderPurchaseRecommendations(results))
+	for _, r := range results {
+		if r.Err != nil {
+			continue
+		}
+		if r.RecommendationsErr != nil {
+			uc.console.LogWarning("Purchase recommendations unavailable for %s: %v", r.Profile, r.RecommendationsErr)
+			continue
+		}
+		if recs := r.Report.Recommendations; recs != nil {
+			for _, u := range recs.Unavailable {
+				uc.console.LogWarning("%s %s recommendations unavailable for %s: %s", u.Type, u.Kind, r.Profile, u.Error)
+			}
+		}
+	}
+
 	// Export
 	if args.ReportName != "" {
 		uc.console.LogInfo("Exporting commitments reports...")
@@ -1714,18 +1835,308 @@ func (uc *DashboardUseCase) runCommitmentsReport(ctx context.Context, profileGro
 	return nil
 }
 
-func (uc *DashboardUseCase) runFullAuditReport(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) error {
-	uc.console.LogInfo("Running Full Audit...")
+// renderPurchaseRecommendations monta a tabela das recomendações de compra de SP e RI de cada perfil.
+// Savings Plans e reservas cobrem o mesmo uso, então os totais de cada lista são exibidos separadamente.
+func (uc *DashboardUseCase) renderPurchaseRecommendations(results []commitmentsRow) string {
+	const maxRows = 10
+
+	table := uc.console.CreateTable()
+	table.AddColumn("Profile")
+	table.AddColumn("Kind")
+	table.AddColumn("Type")
+	table.AddColumn("Details")
+	table.AddColumn("Qty")
+	table.AddColumn("Hourly Commitment")
+	table.AddColumn("Upfront")
+	table.AddColumn("Est. Monthly Savings")
+	table.AddColumn("Savings %")
+	table.AddColumn("ROI")
+	table.AddColumn("Break-even")
+
+	for _, r := range results {
+		if r.Err != nil {
+			continue
+		}
+		recs := r.Report.Recommendations
+		if recs == nil {
+			table.AddRow(pterm.FgMagenta.Sprint(r.Profile), pterm.FgRed.Sprint("Recommendations unavailable"), "-", "-", "-", "-", "-", "-", "-", "-", "-")
+			continue
+		}
+		for _, group := range []struct {
+			kind string
+			list []entity.PurchaseRecommendation
+		}{
+			{entity.PurchaseSavingsPlans, recs.SavingsPlans},
+			{entity.PurchaseReservedInstances, recs.ReservedInstances},
+		} {
+			unavailable := recs.UnavailableOf(group.kind)
+			for _, u := range unavailable {
+				table.AddRow(pterm.FgMagenta.Sprint(r.Profile), group.kind, u.Type, pterm.FgRed.Sprint("Unavailable"), "-", "-", "-", "-", "-", "-", "-")
+			}
+			if len(group.list) == 0 {
+				if len(unavailable) > 0 {
+					continue
+				}
+				table.AddRow(pterm.FgMagenta.Sprint(r.Profile), group.kind, pterm.FgGreen.Sprint("No recommendations"), "-", "-", "-", "-", "-", "-", "-", "-")
+				continue
+			}
+			limit := len(group.list)
+			if limit > maxRows {
+				limit = maxRows
+			}
+			for _, rec := range group.list[:limit] {
+				qty := "-"
+				if rec.Quantity > 0 {
+					qty = fmt.Sprint(rec.Quantity)
+				}
+				table.AddRow(
+					pterm.FgMagenta.Sprint(r.Profile),
+					rec.Kind,
+					rec.Type,
+					rec.Details,
+					qty,
+					fmt.Sprintf("$%.3f/h", rec.HourlyCommitment),
+					fmt.Sprintf("$%.2f", rec.UpfrontCost),
+					pterm.FgGreen.Sprintf("$%.2f", rec.EstimatedMonthlySavings),
+					fmt.Sprintf("%.1f%%", rec.EstimatedSavingsPercent),
+					fmt.Sprintf("%.1f%%", rec.EstimatedROI),
+					formatBreakEven(rec.BreakEvenMonths),
+				)
+			}
+			if len(group.list) > limit {
+				table.AddRow(pterm.FgMagenta.Sprint(r.Profile), group.kind, fmt.Sprintf("... (+%d more)", len(group.list)-limit), "", "", "", "", "", "", "", "")
+			}
+			total := recs.SavingsPlansMonthlySavings()
+			if group.kind == entity.PurchaseReservedInstances {
+				total = recs.ReservedInstancesMonthlySavings()
+			}
+			table.AddRow(pterm.FgMagenta.Sprint(r.Profile), group.kind, pterm.Bold.Sprint("Total"), "", "", "", "", pterm.FgGreen.Sprintf("$%.2f", total), "", "", "")
+		}
+	}
+	return table.Render()
+}
 
+// formatBreakEven formata o ponto de equilíbrio; sem custo adiantado a economia é imediata.
+func formatBreakEven(months float64) string {
+	if months <= 0 {
+		return "Immediate"
+	}
+	return fmt.Sprintf("%.1f months", months)
+}
+
+// collectCommitmentsReports obtém cobertura e utilização de SP/RI de cada grupo de perfis em paralelo.
+// Com withRecommendations, inclui também as recomendações de compra (sete chamadas extras ao Cost Explorer).
+func (uc *DashboardUseCase) collectCommitmentsReports(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions, withRecommendations bool) []commitmentsRow {
 	livePrinter, _ := uc.console.GetMultiPrinter().Start()
 	defer livePrinter.Stop()
 
-	type row struct {
-		Profile string
-		Report  entity.FullAuditReport
-		Err     error
+	results := make([]commitmentsRow, 0, len(profileGroups))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	for _, group := range profileGroups {
+		wg.Add(1)
+		go func(g entity.ProfileGroup) {
+			defer wg.Done()
+			steps := 2
+			if withRecommendations {
+				steps++
+			}
+			bar := uc.console.NewProgressbar(steps, fmt.Sprintf("Commitments: %s", g.Identifier))
+			bar.Start()
+
+			profile := g.Profiles[0]
+
+			sp, err1 := uc.awsRepo.GetSavingsPlansSummary(ctx, profile, opts.Period, args.Tag)
+			bar.Increment()
+			ri, err2 := uc.awsRepo.GetReservationSummary(ctx, profile, opts.Period, args.Tag)
+			bar.Increment()
+
+			if err1 != nil {
+				mu.Lock()
+				results = append(results, commitmentsRow{
+					Profile: g.Identifier,
+					Err:     fmt.Errorf("SP error: %w", err1),
+				})
+				mu.Unlock()
+				return
+			}
+			if err2 != nil {
+				mu.Lock()
+				results = append(results, commitmentsRow{
+					Profile: g.Identifier,
+					Err:     fmt.Errorf("RI error: %w", err2),
+				})
+				mu.Unlock()
+				return
+			}
+
+			rep := entity.CommitmentsReport{
+				AccountID:  sp.AccountID,
+				Profile:    g.Identifier,
+				SPSummary:  sp,
+				RISummary:  ri,
+				PeriodName: sp.PeriodName,
+			}
+			// As recomendações são opcionais: uma falha (ex: sem permissão) não invalida a cobertura.
+			var recsErr error
+			if withRecommendations {
+				recs, err := uc.awsRepo.GetPurchaseRecommendations(ctx, profile, opts.Purchase)
+				if err != nil {
+					recsErr = err
+				} else {
+					rep.Recommendations = &recs
+				}
+				bar.Increment()
+			}
+
+			mu.Lock()
+			results = append(results, commitmentsRow{
+				Profile:            g.Identifier,
+				Report:             rep,
+				RecommendationsErr: recsErr,
+			})
+			mu.Unlock()
+		}(group)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Profile < results[j].Profile })
+	return results
+}
+
+// fullAuditRow é o resultado da auditoria completa para um grupo de perfis.
+type fullAuditRow struct {
+	Profile string
+	Report  entity.FullAuditReport
+	Err     error
+	// CheckErrs são os erros das verificações da auditoria principal, por ID.
+	CheckErrs map[string]error
+}
+
+func (uc *DashboardUseCase) runFullAuditReport(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) error {
+	uc.console.LogInfo("Running Full Audit...")
+
+	results := uc.collectFullAuditReports(ctx, profileGroups, args, opts)
+	for _, r := range results {
+		for _, id := range slices.Sorted(maps.Keys(r.CheckErrs)) {
+			uc.console.LogWarning("Check %s failed for %s: %v", id, r.Profile, r.CheckErrs[id])
+		}
+	}
+
+	// Exibe um resumo no terminal
+	uc.console.Println("\n" + pterm.DefaultSection.WithLevel(1).Sprint("Full Audit Summary"))
+	for _, r := range results {
+		if r.Err != nil {
+			uc.console.Println(pterm.FgRed.Sprintf("Error auditing profile %s: %v", r.Profile, r.Err))
+			continue
+		}
+		rep := r.Report
+		uc.console.Println(pterm.FgMagenta.Sprintf("\nProfile: %s (Account: %s)", rep.Profile, rep.AccountID))
+
+		// Resumo de cada sub-relatório
+		if a := rep.MainAudit; a != nil {
+			var findings []string
+			if n := a.Count(entity.CheckHighCostNatGateway); n > 0 {
+				findings = append(findings, fmt.Sprintf("%d High-Cost NATs", n))
+			}
+			if n := a.Count(entity.CheckUnusedVolume); n > 0 {
+				findings = append(findings, fmt.Sprintf("%d Unused Volumes", n))
+			}
+			if n := a.Count(entity.CheckUntaggedResource); n > 0 {
+				findings = append(findings, fmt.Sprintf("%d Untagged Resources", n))
+			}
+			line := fmt.Sprintf("  - Main Audit: %s", strings.Join(findings, ", "))
+			if s := a.PotentialSavings; s != nil {
+				line += fmt.Sprintf(" (potential savings $%.2f/mo)", s.Total())
+			} else if a.SavingsError != "" {
+				line += fmt.Sprintf(" (potential savings N/A: %s)", a.SavingsError)
+			}
+			if n := len(a.Suppressed); n > 0 {
+				line += fmt.Sprintf(" [%d suppressed]", n)
+			}
+			if b := a.Baseline; b != nil {
+				line += fmt.Sprintf(" [%d new, %d unchanged, %d resolved]", b.New, b.Unchanged, b.Resolved)
+			}
+			uc.console.Println(line)
+		}
+		if t := rep.TransferAudit; t != nil {
+			uc.console.Println(fmt.Sprintf("  - Data Transfer: Total $%.2f", t.Total))
+		}
+		if l := rep.LogsAudit; l != nil {
+			uc.console.Println(fmt.Sprintf("  - CloudWatch Logs: %d groups with no retention", l.NoRetentionCount))
+		}
+		if s := rep.S3Audit; s != nil {
+			uc.console.Println(fmt.Sprintf("  - S3 Buckets: %d with no lifecycle, %d with public risk", s.NoLifecycleCount, s.PublicRiskCount))
+		}
+		if c := rep.CommitmentsAudit; c != nil {
+			uc.console.Println(fmt.Sprintf("  - Commitments: SP Coverage %.2f%%, RI Coverage %.2f%%", c.SPSummary.CoveragePercent, c.RISummary.CoveragePercent))
+		}
+		if e := rep.ExpirationsAudit; e != nil {
+			line := fmt.Sprintf("  - Commitment Expirations: %d active, %d ending within %d days", len(e.Commitments), e.ExpiringSoon(), e.WarningDays)
+			if e.ExpiringSoon() > 0 {
+				line = pterm.FgRed.Sprint(line)
+			}
+			uc.console.Println(line)
+		}
+	}
+
+	// Exporta os relatórios
+	if args.ReportName != "" {
+		uc.console.LogInfo("Exporting full audit reports...")
+
+		fullReports := make([]entity.FullAuditReport, 0, len(results))
+		for _, r := range results {
+			if r.Err == nil {
+				fullReports = append(fullReports, r.Report)
+			}
+		}
+
+		for _, reportType := range args.ReportType {
+			switch strings.ToLower(reportType) {
+			case "csv":
+				paths, err := uc.exportRepo.ExportFullAuditReportToCSV(fullReports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export full audit to CSV package: %v", err)
+				} else {
+					uc.console.LogSuccess("Full audit CSV package saved to: %s", strings.Join(paths, ", "))
+				}
+			case "json":
+				path, err := uc.exportRepo.ExportFullAuditReportToJSON(fullReports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export full audit to JSON: %v", err)
+				} else {
+					uc.console.LogSuccess("Full audit JSON report saved to: %s", path)
+				}
+			case "pdf":
+				path, err := uc.exportRepo.ExportFullAuditReportToPDF(fullReports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export full audit to PDF: %v", err)
+				} else {
+					uc.console.LogSuccess("Full audit PDF report saved to: %s", path)
+				}
+			}
+		}
 	}
-	results := make([]row, 0, len(profileGroups))
+
+	snapshot := newSnapshot(args, opts, opts.Period)
+	for _, r := range results {
+		if r.Err == nil && r.Report.MainAudit != nil {
+			snapshot.Audits = append(snapshot.Audits, *r.Report.MainAudit)
+		}
+	}
+	uc.saveSnapshot(args, snapshot)
+
+	return nil
+}
+
+// collectFullAuditReports executa todas as auditorias de cada grupo de perfis em paralelo.
+func (uc *DashboardUseCase) collectFullAuditReports(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) []fullAuditRow {
+	livePrinter, _ := uc.console.GetMultiPrinter().Start()
+	defer livePrinter.Stop()
+
+	results := make([]fullAuditRow, 0, len(profileGroups))
 
 	var wg sync.WaitGroup
 	var mu sync.Mutex
@@ -1734,7 +2145,7 @@ func (uc *DashboardUseCase) runFullAuditReport(ctx context.Context, profileGroup
 		wg.Add(1)
 		go func(g entity.ProfileGroup) {
 			defer wg.Done()
-			const totalSteps = 6 // Main Audit, Transfer, Logs, S3, SP, RI
+			const totalSteps = 7 // Main Audit, Transfer, Logs, S3, SP, RI, Expirations
 			bar := uc.console.NewProgressbar(totalSteps, fmt.Sprintf("Full Audit: %s", g.Identifier))
 			bar.Start()
 
@@ -1742,6 +2153,7 @@ func (uc *DashboardUseCase) runFullAuditReport(ctx context.Context, profileGroup
 			accountID, _ := uc.awsRepo.GetAccountID(ctx, profile)
 			report := entity.FullAuditReport{Profile: g.Identifier, AccountID: accountID}
 
+			var checkErrs map[string]error
 			var innerWg sync.WaitGroup
 			innerWg.Add(totalSteps)
 
@@ -1753,34 +2165,20 @@ func (uc *DashboardUseCase) runFullAuditReport(ctx context.Context, profileGroup
 				if len(regions) == 0 {
 					regions, _ = uc.awsRepo.GetAccessibleRegions(ctx, profile)
 				}
-				natCosts, _ := uc.awsRepo.GetNatGatewayCost(ctx, profile, args.TimeRange, args.Tag)
-				idleLBs, _ := uc.awsRepo.GetIdleLoadBalancers(ctx, profile, regions)
-				stopped, _ := uc.awsRepo.GetStoppedInstances(ctx, profile, regions)
-				unusedVols, _ := uc.awsRepo.GetUnusedVolumes(ctx, profile, regions)
-				unusedEIPs, _ := uc.awsRepo.GetUnusedEIPs(ctx, profile, regions)
-				untagged, _ := uc.awsRepo.GetUntaggedResources(ctx, profile, regions)
-				unusedEndpoints, _ := uc.awsRepo.GetUnusedVpcEndpoints(ctx, profile, regions)
-				budgets, _ := uc.awsRepo.GetBudgets(ctx, profile)
-
-				report.MainAudit = &entity.AuditData{
-					Profile:            g.Identifier,
-					AccountID:          accountID,
-					NatGatewayCosts:    formatNatGatewayCosts(natCosts),
-					IdleLoadBalancers:  formatAuditMap(idleLBs, "Idle Load Balancers"),
-					StoppedInstances:   formatAuditMap(stopped, "Stopped Instances"),
-					UnusedVolumes:      formatAuditMap(unusedVols, "Unused Volumes"),
-					UnusedEIPs:         formatAuditMap(unusedEIPs, "Unused Elastic IPs"),
-					UntaggedResources:  formatAuditMapForUntagged(untagged),
-					UnusedVpcEndpoints: formatAuditMap(unusedEndpoints, "Unused VPC Endpoints"),
-					BudgetAlerts:       formatBudgetAlerts(budgets),
-				}
+				env := uc.newCheckEnv(profile, accountID, regions, args, opts, true)
+				run := runAuditChecks(ctx, env, opts.Checks, nil)
+				run.Profile = g.Identifier
+				checkErrs = run.CheckErrs
+
+				mainAudit := newAuditData(run, opts)
+				report.MainAudit = &mainAudit
 			}()
 
 			// 2. Transfer Audit
 			go func() {
 				defer innerWg.Done()
 				defer bar.Increment()
-				if transfer, err := uc.awsRepo.GetDataTransferBreakdown(ctx, profile, args.TimeRange, args.Tag); err == nil {
+				if transfer, err := uc.awsRepo.GetDataTransferBreakdown(ctx, profile, opts.Period, opts.Metric, args.Tag); err == nil {
 					report.TransferAudit = &transfer
 				}
 			}()
@@ -1887,7 +2285,7 @@ func (uc *DashboardUseCase) runFullAuditReport(ctx context.Context, profileGroup
 			go func() {
 				defer innerWg.Done()
 				defer bar.Increment()
-				if sp, err := uc.awsRepo.GetSavingsPlansSummary(ctx, profile, args.TimeRange, args.Tag); err == nil {
+				if sp, err := uc.awsRepo.GetSavingsPlansSummary(ctx, profile, opts.Period, args.Tag); err == nil {
 					mu.Lock()
 					if report.CommitmentsAudit == nil {
 						report.CommitmentsAudit = &entity.CommitmentsReport{}
@@ -1901,7 +2299,7 @@ func (uc *DashboardUseCase) runFullAuditReport(ctx context.Context, profileGroup
 			go func() {
 				defer innerWg.Done()
 				defer bar.Increment()
-				if ri, err := uc.awsRepo.GetReservationSummary(ctx, profile, args.TimeRange, args.Tag); err == nil {
+				if ri, err := uc.awsRepo.GetReservationSummary(ctx, profile, opts.Period, args.Tag); err == nil {
 					mu.Lock()
 					if report.CommitmentsAudit == nil {
 						report.CommitmentsAudit = &entity.CommitmentsReport{}
@@ -1911,6 +2309,15 @@ func (uc *DashboardUseCase) runFullAuditReport(ctx context.Context, profileGroup
 				}
 			}()
 
+			// 7. Commitment Expirations
+			go func() {
+				defer innerWg.Done()
+				defer bar.Increment()
+				if row := uc.getExpirationReport(ctx, g, args.Regions, opts); row.Err == nil {
+					report.ExpirationsAudit = &row.Report
+				}
+			}()
+
 			innerWg.Wait()
 
 			// Finaliza o CommitmentsReport
@@ -1921,7 +2328,7 @@ func (uc *DashboardUseCase) runFullAuditReport(ctx context.Context, profileGroup
 			}
 
 			mu.Lock()
-			results = append(results, row{Profile: g.Identifier, Report: report})
+			results = append(results, fullAuditRow{Profile: g.Identifier, Report: report, CheckErrs: checkErrs})
 			mu.Unlock()
 		}(group)
 	}
@@ -1929,82 +2336,5 @@ func (uc *DashboardUseCase) runFullAuditReport(ctx context.Context, profileGroup
 
 	// Ordena os resultados por perfil
 	sort.Slice(results, func(i, j int) bool { return results[i].Profile < results[j].Profile })
-
-	// Exibe um resumo no terminal
-	uc.console.Println("\n" + pterm.DefaultSection.WithLevel(1).Sprint("Full Audit Summary"))
-	for _, r := range results {
-		if r.Err != nil {
-			uc.console.Println(pterm.FgRed.Sprintf("Error auditing profile %s: %v", r.Profile, r.Err))
-			continue
-		}
-		rep := r.Report
-		uc.console.Println(pterm.FgMagenta.Sprintf("\nProfile: %s (Account: %s)", rep.Profile, rep.AccountID))
-
-		// Resumo de cada sub-relatório
-		if a := rep.MainAudit; a != nil {
-			var findings []string
-			if a.NatGatewayCosts != "None" {
-				findings = append(findings, "High-Cost NATs")
-			}
-			if a.UnusedVolumes != "None" {
-				findings = append(findings, "Unused Volumes")
-			}
-			if a.UntaggedResources != "None" {
-				findings = append(findings, "Untagged Resources")
-			}
-			uc.console.Println(fmt.Sprintf("  - Main Audit: %s", strings.Join(findings, ", ")))
-		}
-		if t := rep.TransferAudit; t != nil {
-			uc.console.Println(fmt.Sprintf("  - Data Transfer: Total $%.2f", t.Total))
-		}
-		if l := rep.LogsAudit; l != nil {
-			uc.console.Println(fmt.Sprintf("  - CloudWatch Logs: %d groups with no retention", l.NoRetentionCount))
-		}
-		if s := rep.S3Audit; s != nil {
-			uc.console.Println(fmt.Sprintf("  - S3 Buckets: %d with no lifecycle, %d with public risk", s.NoLifecycleCount, s.PublicRiskCount))
-		}
-		if c := rep.CommitmentsAudit; c != nil {
-			uc.console.Println(fmt.Sprintf("  - Commitments: SP Coverage %.2f%%, RI Coverage %.2f%%", c.SPSummary.CoveragePercent, c.RISummary.CoveragePercent))
-		}
-	}
-
-	// Exporta os relatórios
-	if args.ReportName != "" {
-		uc.console.LogInfo("Exporting full audit reports...")
-
-		fullReports := make([]entity.FullAuditReport, 0, len(results))
-		for _, r := range results {
-			if r.Err == nil {
-				fullReports = append(fullReports, r.Report)
-			}
-		}
-
-		for _, reportType := range args.ReportType {
-			switch strings.ToLower(reportType) {
-			case "csv":
-				paths, err := uc.exportRepo.ExportFullAuditReportToCSV(fullReports, args.ReportName, args.Dir)
-				if err != nil {
-					uc.console.LogError("Failed to export full audit to CSV package: %v", err)
-				} else {
-					uc.console.LogSuccess("Full audit CSV package saved to: %s", strings.Join(paths, ", "))
-				}
-			case "json":
-				path, err := uc.exportRepo.ExportFullAuditReportToJSON(fullReports, args.ReportName, args.Dir)
-				if err != nil {
-					uc.console.LogError("Failed to export full audit to JSON: %v", err)
-				} else {
-					uc.console.LogSuccess("Full audit JSON report saved to: %s", path)
-				}
-			case "pdf":
-				path, err := uc.exportRepo.ExportFullAuditReportToPDF(fullReports, args.ReportName, args.Dir)
-				if err != nil {
-					uc.console.LogError("Failed to export full audit to PDF: %v", err)
-				} else {
-					uc.console.LogSuccess("Full audit PDF report saved to: %s", path)
-				}
-			}
-		}
-	}
-
-	return nil
+	return results
 }
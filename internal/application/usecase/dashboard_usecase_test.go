@@ -0,0 +1,896 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/adapter/driven/fake"
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+func TestMain(m *testing.M) {
+	// Sem estilos, as células das tabelas podem ser comparadas como texto; sem saída, os spinners e as barras
+	// de progresso não escrevem no terminal.
+	pterm.DisableStyling()
+	pterm.DisableOutput()
+	os.Exit(m.Run())
+}
+
+// stubConfigRepository devolve uma configuração fixa para os testes de mergeConfig.
+type stubConfigRepository struct {
+	cfg   *types.Config
+	err   error
+	loads int
+}
+
+func (r *stubConfigRepository) LoadConfigFile(filePath string) (*types.Config, error) {
+	r.loads++
+	if r.err != nil {
+		return nil, r.err
+	}
+	cfg := *r.cfg
+	return &cfg, nil
+}
+
+func (r *stubConfigRepository) LoadPriceList(filePath string) (entity.PriceList, error) {
+	return nil, errors.New("not configured")
+}
+
+func (r *stubConfigRepository) LoadSuppressions(filePath string) ([]entity.Suppression, error) {
+	return nil, errors.New("not configured")
+}
+
+func (r *stubConfigRepository) LoadAuditBaseline(filePath string) ([]entity.AuditData, error) {
+	return nil, errors.New("not configured")
+}
+
+// newTestRepo cria um repositório com três perfis; dev e prod usam a mesma conta.
+func newTestRepo() *fake.AWSRepository {
+	return &fake.AWSRepository{
+		Profiles: []string{"default", "dev", "prod"},
+		AccountIDs: map[string]string{
+			"default": "111111111111",
+			"dev":     "222222222222",
+			"prod":    "222222222222",
+		},
+		Regions: map[string][]string{
+			"default": {"us-east-1"},
+			"dev":     {"us-east-1"},
+			"prod":    {"us-east-1", "eu-west-1"},
+		},
+	}
+}
+
+func newTestUseCase(repo *fake.AWSRepository) (*DashboardUseCase, *fake.Console) {
+	console := fake.NewConsole()
+	return NewDashboardUseCase(repo, nil, &stubConfigRepository{cfg: &types.Config{}}, nil, console), console
+}
+
+// newTestArgs são os argumentos de um relatório fechado (agosto de 2026) sem cache nem histórico em disco.
+func newTestArgs(report types.ReportKind) *types.CLIArgs {
+	return &types.CLIArgs{Report: report, Month: "2026-08", NoCache: true, NoHistory: true}
+}
+
+// tableWithColumn retorna a primeira tabela com a coluna informada.
+func tableWithColumn(t *testing.T, console *fake.Console, column string) *fake.Table {
+	t.Helper()
+	for _, table := range console.Tables() {
+		if slices.Contains(table.Columns, column) {
+			return table
+		}
+	}
+	t.Fatalf("no table with column %q; tables: %d", column, len(console.Tables()))
+	return nil
+}
+
+func assertContains(t *testing.T, got, want string) {
+	t.Helper()
+	if !strings.Contains(got, want) {
+		t.Errorf("expected %q to contain %q", got, want)
+	}
+}
+
+func checkTitle(t *testing.T, id string) string {
+	t.Helper()
+	for _, c := range RegisteredChecks() {
+		if c.ID() == id {
+			return c.Title()
+		}
+	}
+	t.Fatalf("check %s is not registered", id)
+	return ""
+}
+
+func TestRunDashboardReports(t *testing.T) {
+	tests := []struct {
+		name   string
+		report types.ReportKind
+		args   func(*types.CLIArgs)
+		setup  func(*fake.AWSRepository)
+		check  func(*testing.T, *fake.AWSRepository, *fake.Console)
+		// wantErrors indica que o relatório deve registrar erros no console.
+		wantErrors bool
+	}{
+		{
+			name:   "cost dashboard",
+			report: types.ReportCost,
+			args:   func(a *types.CLIArgs) { a.Report = "" },
+			setup: func(r *fake.AWSRepository) {
+				r.CostData = map[string]entity.CostData{"default": {
+					AccountID:        "111111111111",
+					CurrentMonthCost: 150,
+					LastMonthCost:    100,
+					CurrentMonthCostByService: []entity.ServiceCost{
+						{ServiceName: "Amazon EC2", Cost: 100},
+						{ServiceName: "Amazon S3", Cost: 50},
+					},
+					Budgets: []entity.BudgetInfo{{Name: "monthly", Limit: 200, Actual: 150}},
+				}}
+				r.EC2Summaries = map[string]entity.EC2Summary{"default": {"running": 2, "stopped": 1}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "AWS Account Profile")
+				if len(table.Rows) != 1 {
+					t.Fatalf("expected 1 row, got %d", len(table.Rows))
+				}
+				row := table.Rows[0]
+				assertContains(t, row[0], "Profile: default\nAccount: 111111111111")
+				assertContains(t, row[1], "$100.00")
+				assertContains(t, row[2], "$150.00")
+				assertContains(t, row[2], "⬆ 50.00%")
+				assertContains(t, row[3], "N/A")
+				assertContains(t, row[4], "Amazon EC2: $100.00\nAmazon S3: $50.00")
+				assertContains(t, row[5], "monthly Limit: $200.00")
+				assertContains(t, row[6], "running: 2\nstopped: 1")
+				if n := r.Calls("GetCostForecast"); n != 0 {
+					t.Errorf("a closed month must not be forecast, got %d calls", n)
+				}
+				assertContains(t, c.Output(), "Cost metric: Unblended")
+			},
+		},
+		{
+			name:   "cost dashboard with failing profile",
+			report: types.ReportCost,
+			args:   func(a *types.CLIArgs) { a.Report = types.ReportCost },
+			setup: func(r *fake.AWSRepository) {
+				r.Errors = map[string]error{"GetCostData:default": errors.New("access denied")}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "AWS Account Profile")
+				assertContains(t, table.Rows[0][4], "Failed: failed to get cost data: access denied")
+				if n := r.Calls("GetEC2Summary"); n != 0 {
+					t.Errorf("expected no EC2 calls after a cost error, got %d", n)
+				}
+			},
+		},
+		{
+			name:   "cost dashboard with forecast",
+			report: types.ReportCost,
+			args:   func(a *types.CLIArgs) { a.Month = "" },
+			setup: func(r *fake.AWSRepository) {
+				r.CostData = map[string]entity.CostData{"default": {
+					CurrentMonthCost: 80,
+					Budgets:          []entity.BudgetInfo{{Name: "monthly", Limit: 100, Actual: 80}},
+				}}
+				r.Forecasts = map[string]entity.CostForecast{"default": {Amount: 120, LowerBound: 110, UpperBound: 130}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				cell := tableWithColumn(t, c, "Forecast (EOM)").Cell(0, "Forecast (EOM)")
+				assertContains(t, cell, "$120.00")
+				assertContains(t, cell, "($110.00 - $130.00)")
+				assertContains(t, cell, "Exceeds budget:\nmonthly")
+			},
+		},
+		{
+			name:   "cost dashboard by organization account",
+			report: types.ReportCost,
+			args:   func(a *types.CLIArgs) { a.Org = true },
+			setup: func(r *fake.AWSRepository) {
+				r.OrgAccounts = map[string][]entity.OrgAccount{"default": {
+					{ID: "333333333333", Name: "staging", OUPath: "Root/Workloads"},
+					{ID: "444444444444", Name: "production", OUPath: "Root/Workloads"},
+				}}
+				r.LinkedAccountCosts = map[string][]entity.LinkedAccountCost{"default": {
+					{AccountID: "333333333333", CurrentCost: 10},
+					{AccountID: "444444444444", CurrentCost: 90, PreviousCost: 90},
+				}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "AWS Account Profile")
+				if len(table.Rows) != 2 {
+					t.Fatalf("expected one row per member account, got %d", len(table.Rows))
+				}
+				assertContains(t, table.Rows[0][0], "production\nAccount: 444444444444\nOU: Root/Workloads")
+				assertContains(t, table.Rows[0][2], "➡ 0.00%")
+				assertContains(t, table.Rows[1][0], "staging")
+				assertContains(t, table.Rows[1][6], orgNotCollected)
+				if n := r.Calls("GetCostData"); n != 0 {
+					t.Errorf("organization mode must not call GetCostData, got %d calls", n)
+				}
+			},
+		},
+		{
+			name:   "audit",
+			report: types.ReportAudit,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.StoppedInstances = map[string]entity.StoppedEC2Instances{"default": {
+					"us-east-1": {{ID: "i-0123", InstanceType: "t3.micro", Volumes: []entity.EBSVolume{{ID: "vol-1", Type: "gp3", SizeGiB: 8}}}},
+				}}
+				r.Budgets = map[string][]entity.BudgetInfo{"default": {{Name: "monthly", Limit: 100, Actual: 150}}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "Potential Monthly Savings")
+				if len(table.Columns) != len(RegisteredChecks())+3 {
+					t.Errorf("expected a column per check, got %v", table.Columns)
+				}
+				assertContains(t, table.Cell(0, checkTitle(t, entity.CheckStoppedInstance)), "us-east-1:\n  - i-0123")
+				assertContains(t, table.Cell(0, checkTitle(t, entity.CheckBudgetExceeded)), "monthly")
+				assertContains(t, table.Cell(0, checkTitle(t, entity.CheckUnusedVolume)), "None")
+				assertContains(t, table.Cell(0, "Potential Monthly Savings"), "(+1 unpriced)")
+			},
+		},
+		{
+			name:   "audit with a failing check",
+			report: types.ReportAudit,
+			args:   func(a *types.CLIArgs) { a.Checks = []string{entity.CheckUnusedVolume} },
+			setup: func(r *fake.AWSRepository) {
+				r.Errors = map[string]error{"GetUnusedVolumes": errors.New("throttled")}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "Potential Monthly Savings")
+				if len(table.Columns) != 4 {
+					t.Errorf("expected only the selected check, got %v", table.Columns)
+				}
+				warnings := strings.Join(c.Messages(fake.LevelWarning), "\n")
+				assertContains(t, warnings, "Check unused-volume failed for default: throttled")
+			},
+		},
+		{
+			name:   "full audit",
+			report: types.ReportFullAudit,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.DataTransfer = map[string]entity.DataTransferReport{"default": {Total: 12.5}}
+				r.LogGroups = map[string][]entity.CloudWatchLogGroupInfo{"default": {{GroupName: "/app", Region: "us-east-1"}}}
+				r.S3Buckets = map[string][]entity.S3BucketLifecycleStatus{"default": {{Bucket: "logs", Region: "us-east-1"}}}
+				r.SavingsPlans = map[string]entity.SPSummary{"default": {CoveragePercent: 40}}
+				r.Reservations = map[string]entity.RISummary{"default": {CoveragePercent: 20}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				out := c.Output()
+				assertContains(t, out, "Full Audit Summary")
+				assertContains(t, out, "Profile: default (Account: 111111111111)")
+				assertContains(t, out, "Data Transfer: Total $12.50")
+				assertContains(t, out, "CloudWatch Logs: 1 groups with no retention")
+				assertContains(t, out, "S3 Buckets: 1 with no lifecycle")
+				assertContains(t, out, "Commitments: SP Coverage 40.00%, RI Coverage 20.00%")
+				assertContains(t, out, "Commitment Expirations: 0 active")
+			},
+		},
+		{
+			name:   "full audit with a failing check",
+			report: types.ReportFullAudit,
+			args:   func(a *types.CLIArgs) { a.Checks = []string{entity.CheckUnusedVolume} },
+			setup: func(r *fake.AWSRepository) {
+				r.Errors = map[string]error{"GetUnusedVolumes": errors.New("throttled")}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				warnings := strings.Join(c.Messages(fake.LevelWarning), "\n")
+				assertContains(t, warnings, "Check unused-volume failed for default: throttled")
+			},
+		},
+		{
+			name:   "trend",
+			report: types.ReportTrend,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.Trends = map[string]entity.CostTrend{"default": {
+					AccountID:   "111111111111",
+					Granularity: entity.TrendMonthly,
+					Points:      []entity.TrendPoint{{Label: "Jul 2026", Cost: 100}, {Label: "Aug 2026", Cost: 120}},
+				}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				charts := c.TrendCharts()
+				if len(charts) != 1 || len(charts[0].Bars) != 2 {
+					t.Fatalf("expected one chart with two bars, got %+v", charts)
+				}
+				if charts[0].Unit != "Month" || charts[0].Bars[1] != (types.TrendBar{Label: "Aug 2026", Cost: 120}) {
+					t.Errorf("unexpected chart %+v", charts[0])
+				}
+				assertContains(t, c.Output(), "Account: 111111111111 (Profile: default)")
+			},
+		},
+		{
+			name:   "trend with excluded charges",
+			report: types.ReportTrend,
+			args:   func(a *types.CLIArgs) { a.Exclude = []string{"credits", "tax"} },
+			setup: func(r *fake.AWSRepository) {
+				r.Trends = map[string]entity.CostTrend{"default": {
+					Granularity:   entity.TrendMonthly,
+					Points:        []entity.TrendPoint{{Label: "Aug 2026", Cost: 120}},
+					ExcludedCosts: []entity.ServiceCost{{ServiceName: "Credit", Cost: -50}, {ServiceName: "Tax", Cost: 12}},
+				}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				assertContains(t, c.Output(), "Excluded from totals: Credit: $-50.00, Tax: $12.00")
+			},
+		},
+		{
+			name:   "trend with a failing profile",
+			report: types.ReportTrend,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.Errors = map[string]error{"GetTrendData:default": errors.New("AccessDenied")}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				assertContains(t, strings.Join(c.Messages(fake.LevelError), "\n"), "Error getting trend for default: AccessDenied")
+			},
+			wantErrors: true,
+		},
+		{
+			name:   "trend without data",
+			report: types.ReportTrend,
+			args:   func(a *types.CLIArgs) {},
+			setup:  func(r *fake.AWSRepository) {},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				if len(c.TrendCharts()) != 0 {
+					t.Errorf("expected no chart")
+				}
+				assertContains(t, strings.Join(c.Messages(fake.LevelWarning), "\n"), "No trend data available for default")
+			},
+		},
+		{
+			name:   "data transfer",
+			report: types.ReportTransfer,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.DataTransfer = map[string]entity.DataTransferReport{"default": {
+					AccountID: "111111111111",
+					Total:     30,
+					Categories: []entity.DataTransferCategoryCost{
+						{Category: "Internet", Cost: 20},
+						{Category: "NAT Gateway", Cost: 10},
+					},
+					TopLines: []entity.DataTransferLine{{Service: "Amazon EC2", UsageType: "DataTransfer-Out-Bytes", Cost: 20}},
+				}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "Inter-Region")
+				for column, want := range map[string]string{"Total": "$30.00", "Internet": "$20.00", "NAT Gateway": "$10.00", "Other": "$0.00"} {
+					if got := table.Cell(0, column); got != want {
+						t.Errorf("%s: expected %q, got %q", column, want, got)
+					}
+				}
+				assertContains(t, c.Output(), "  - Amazon EC2 | DataTransfer-Out-Bytes: $20.00")
+			},
+		},
+		{
+			name:   "cloudwatch logs",
+			report: types.ReportLogs,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.LogGroups = map[string][]entity.CloudWatchLogGroupInfo{"default": {
+					{GroupName: "/app/api", Region: "us-east-1", StoredBytes: 2 << 30},
+					{GroupName: "/app/worker", Region: "us-east-1", RetentionDays: 14, StoredBytes: 1 << 30},
+				}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "No Retention (count)")
+				if got := table.Cell(0, "No Retention (count)"); got != "1" {
+					t.Errorf("expected 1 group without retention, got %q", got)
+				}
+				if got := table.Cell(0, "Total Stored (GB)"); got != "3.00" {
+					t.Errorf("expected 3.00 GB, got %q", got)
+				}
+				assertContains(t, table.Rows[0][4], "us-east-1 | /app/api | 2.00 GB")
+			},
+		},
+		{
+			name:   "cloudwatch logs with error",
+			report: types.ReportLogs,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.Errors = map[string]error{"GetCloudWatchLogGroups": errors.New("boom")}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "No Retention (count)")
+				assertContains(t, table.Cell(0, "Total Stored (GB)"), "Error: boom")
+			},
+		},
+		{
+			name:   "s3 lifecycle",
+			report: types.ReportS3,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.S3Buckets = map[string][]entity.S3BucketLifecycleStatus{"default": {
+					{Bucket: "archive", Region: "us-east-1", HasLifecycle: true},
+					{Bucket: "uploads", Region: "eu-west-1"},
+				}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "No Lifecycle")
+				if got := table.Cell(0, "Buckets"); got != "2" {
+					t.Errorf("expected 2 buckets, got %q", got)
+				}
+				if got := table.Cell(0, "No Lifecycle"); got != "1" {
+					t.Errorf("expected 1 bucket without lifecycle, got %q", got)
+				}
+				assertContains(t, table.Cell(0, "Samples"), "[NoLifecycle] uploads (eu-west-1)")
+			},
+		},
+		{
+			name:   "commitments",
+			report: types.ReportCommitments,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.SavingsPlans = map[string]entity.SPSummary{"default": {CoveragePercent: 75, UtilizationPercent: 90, UnusedCommitment: 12}}
+				r.Reservations = map[string]entity.RISummary{"default": {DataUnavailable: true}}
+				r.PurchaseRecommendations = map[string]entity.PurchaseRecommendations{"default": {
+					SavingsPlans: []entity.PurchaseRecommendation{{Kind: entity.PurchaseSavingsPlans, Type: "Compute", HourlyCommitment: 1.5, EstimatedMonthlySavings: 200}},
+				}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "SP Coverage %")
+				for column, want := range map[string]string{"SP Coverage %": "75.00%", "SP Util %": "90.00%", "SP Unused ($)": "$12.00", "RI Coverage %": "Data Unavailable"} {
+					if got := table.Cell(0, column); got != want {
+						t.Errorf("%s: expected %q, got %q", column, want, got)
+					}
+				}
+				recs := tableWithColumn(t, c, "Break-even")
+				if len(recs.Rows) == 0 || recs.Cell(0, "Type") != "Compute" {
+					t.Errorf("expected the Compute Savings Plan recommendation, got %v", recs.Rows)
+				}
+			},
+		},
+		{
+			name:   "commitments with partial recommendations",
+			report: types.ReportCommitments,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.PurchaseRecommendations = map[string]entity.PurchaseRecommendations{"default": {
+					SavingsPlans: []entity.PurchaseRecommendation{{Kind: entity.PurchaseSavingsPlans, Type: "Compute", EstimatedMonthlySavings: 200}},
+					Unavailable:  []entity.UnavailableRecommendation{{Kind: entity.PurchaseReservedInstances, Type: "Amazon RDS", Error: "AccessDenied"}},
+				}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				recs := tableWithColumn(t, c, "Break-even")
+				var recTypes []string
+				for i := range recs.Rows {
+					recTypes = append(recTypes, recs.Cell(i, "Type"))
+				}
+				assertContains(t, strings.Join(recTypes, ","), "Compute")
+				assertContains(t, strings.Join(recTypes, ","), "Amazon RDS")
+				assertContains(t, strings.Join(c.Messages(fake.LevelWarning), "\n"), "Amazon RDS Reserved Instances recommendations unavailable for default: AccessDenied")
+			},
+		},
+		{
+			name:   "commitments without recommendations",
+			report: types.ReportCommitments,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.Errors = map[string]error{"GetPurchaseRecommendations:default": errors.New("AccessDenied")}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "SP Coverage %")
+				if len(table.Rows) != 1 {
+					t.Errorf("expected the coverage row despite the recommendations error, got %d rows", len(table.Rows))
+				}
+				assertContains(t, strings.Join(c.Messages(fake.LevelWarning), "\n"), "Purchase recommendations unavailable for default: AccessDenied")
+			},
+		},
+		{
+			name:   "expirations",
+			report: types.ReportExpirations,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				now := time.Now()
+				r.Commitments = map[string][]entity.CommitmentExpiration{"default": {
+					{Kind: entity.PurchaseSavingsPlans, ID: "sp-1", Type: "Compute", HourlyCommitment: 2, Start: now.AddDate(-1, 0, 0), End: now.AddDate(0, 0, 10)},
+					{Kind: entity.PurchaseReservedInstances, ID: "ri-1", Type: "Amazon RDS", Quantity: 2, Start: now.AddDate(-1, 0, 0), End: now.AddDate(1, 0, 0)},
+					{Kind: entity.PurchaseReservedInstances, ID: "ri-old", Type: "Amazon EC2", Start: now.AddDate(-2, 0, 0), End: now.AddDate(0, 0, -1)},
+					{Kind: entity.PurchaseReservedInstances, ID: "ri-cache", Type: "Amazon ElastiCache", Quantity: 3, Start: now.AddDate(-1, 0, 0), End: now.AddDate(0, 0, 20)},
+				}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "Days Left")
+				if len(table.Rows) != 3 {
+					t.Fatalf("expected the three active commitments, got %d rows", len(table.Rows))
+				}
+				if got := table.Cell(0, "ID"); got != "sp-1" {
+					t.Errorf("expected the commitment ending first on top, got %q", got)
+				}
+				assertContains(t, table.Cell(0, "Days Left"), "(expiring)")
+				assertContains(t, table.Cell(1, "Days Left"), "(expiring)")
+				assertContains(t, strings.Join(c.Messages(fake.LevelWarning), "\n"), "default: 2 of 3 commitments end within 30 days")
+				if n := r.Calls("GetAccessibleRegions:default"); n == 0 {
+					t.Errorf("expected the reservations to be listed in the accessible regions")
+				}
+			},
+		},
+		{
+			name:   "anomalies from AWS monitors",
+			report: types.ReportAnomalies,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.AnomalyMonitors = map[string][]entity.AnomalyMonitor{"default": {{ARN: "arn:monitor", Name: "services"}}}
+				r.Anomalies = map[string][]entity.CostAnomaly{"default": {{
+					Monitor:   "arn:monitor",
+					StartDate: time.Date(2026, 8, 3, 0, 0, 0, 0, time.UTC),
+					Service:   "Amazon EC2",
+					Region:    "us-east-1",
+					Impact:    42,
+				}}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "Detection")
+				if got := table.Cell(0, "Detection"); got != "AWS monitors (1)" {
+					t.Errorf("unexpected detection %q", got)
+				}
+				assertContains(t, table.Rows[0][4], "2026-08-03 | Amazon EC2 | - | us-east-1 | +$42.00")
+				if n := r.Calls("GetDailyServiceCosts"); n != 0 {
+					t.Errorf("local detection must not run with monitors, got %d calls", n)
+				}
+			},
+		},
+		{
+			name:   "anomalies from local detection",
+			report: types.ReportAnomalies,
+			args:   func(a *types.CLIArgs) { a.Exclude = []string{"credits"} },
+			setup:  func(r *fake.AWSRepository) {},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				assertContains(t, strings.Join(c.Messages(fake.LevelInfo), "\n"), "Excluding record types from the local detector: Credit")
+				table := tableWithColumn(t, c, "Detection")
+				assertContains(t, table.Cell(0, "Detection"), "Local z-score")
+				assertContains(t, table.Rows[0][4], "No anomalies found")
+				if n := r.Calls("GetCostAnomalies"); n != 0 {
+					t.Errorf("expected no GetCostAnomalies calls without monitors, got %d", n)
+				}
+			},
+		},
+		{
+			name:   "rightsizing",
+			report: types.ReportRightsizing,
+			args:   func(a *types.CLIArgs) {},
+			setup: func(r *fake.AWSRepository) {
+				r.Rightsizing = map[string][]entity.RightsizingRecommendation{"default": {
+					{InstanceID: "i-1", Region: "us-east-1", Action: entity.RightsizingTerminate, CurrentType: "m5.large", EstimatedMonthlySavings: 70},
+					{InstanceID: "i-2", Region: "us-east-1", Action: entity.RightsizingModify, CurrentType: "m5.xlarge", TargetType: "m5.large", EstimatedMonthlySavings: 30},
+				}}
+			},
+			check: func(t *testing.T, r *fake.AWSRepository, c *fake.Console) {
+				table := tableWithColumn(t, c, "Est. Monthly Savings")
+				for column, want := range map[string]string{"Terminate": "1", "Modify": "1", "Est. Monthly Savings": "$100.00"} {
+					if got := table.Cell(0, column); got != want {
+						t.Errorf("%s: expected %q, got %q", column, want, got)
+					}
+				}
+				assertContains(t, table.Rows[0][5], "i-2")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newTestRepo()
+			tt.setup(repo)
+			uc, console := newTestUseCase(repo)
+
+			args := newTestArgs(tt.report)
+			tt.args(args)
+			if err := uc.RunDashboard(context.Background(), args); err != nil {
+				t.Fatalf("RunDashboard: %v", err)
+			}
+			if errs := console.Messages(fake.LevelError); tt.wantErrors != (len(errs) > 0) {
+				t.Errorf("expected errors: %v, got %v", tt.wantErrors, errs)
+			}
+			tt.check(t, repo, console)
+		})
+	}
+}
+
+func TestRunDashboardCacheAndRecording(t *testing.T) {
+	t.Run("cache enabled with the default TTL", func(t *testing.T) {
+		repo := newTestRepo()
+		uc, _ := newTestUseCase(repo)
+		args := newTestArgs(types.ReportS3)
+		args.NoCache = false
+		args.CacheDir = t.TempDir()
+		if err := uc.RunDashboard(context.Background(), args); err != nil {
+			t.Fatal(err)
+		}
+		opts := repo.ResponseCache()
+		if opts == nil || opts.Dir != args.CacheDir || opts.TTL != defaultCacheTTL {
+			t.Errorf("unexpected cache options %+v", opts)
+		}
+	})
+	t.Run("replay disables cache and history", func(t *testing.T) {
+		repo := newTestRepo()
+		uc, _ := newTestUseCase(repo)
+		args := newTestArgs(types.ReportS3)
+		args.NoCache, args.NoHistory = false, false
+		args.Replay = "testdata/recording"
+		if err := uc.RunDashboard(context.Background(), args); err != nil {
+			t.Fatal(err)
+		}
+		if repo.ReplayDir() != args.Replay || repo.ResponseCache() != nil || !args.NoHistory {
+			t.Errorf("replay must skip the cache and the history: dir %q, cache %+v, no history %v", repo.ReplayDir(), repo.ResponseCache(), args.NoHistory)
+		}
+	})
+	t.Run("record reports the responses left out", func(t *testing.T) {
+		repo := newTestRepo()
+		repo.RecordingError = errors.New("2 API responses were not recorded, the first because of: disk full")
+		uc, console := newTestUseCase(repo)
+		args := newTestArgs(types.ReportS3)
+		args.Record = t.TempDir()
+		if err := uc.RunDashboard(context.Background(), args); err != nil {
+			t.Fatal(err)
+		}
+		if repo.RecordDir() != args.Record || !args.NoCache {
+			t.Errorf("record must skip the cache: dir %q, no cache %v", repo.RecordDir(), args.NoCache)
+		}
+		assertContains(t, strings.Join(console.Messages(fake.LevelWarning), "\n"), "is incomplete and may not replay this run: 2 API responses were not recorded")
+	})
+	t.Run("record with replay", func(t *testing.T) {
+		uc, _ := newTestUseCase(newTestRepo())
+		args := newTestArgs(types.ReportS3)
+		args.Record, args.Replay = "a", "b"
+		if err := uc.RunDashboard(context.Background(), args); !errors.Is(err, types.ErrRecordWithReplay) {
+			t.Errorf("expected ErrRecordWithReplay, got %v", err)
+		}
+	})
+}
+
+func TestInitializeProfiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		profiles []string
+		args     types.CLIArgs
+		errors   map[string]error
+		want     []entity.ProfileGroup
+		wantErr  error
+		warning  string
+	}{
+		{
+			name: "default profile",
+			want: []entity.ProfileGroup{{Identifier: "default", Profiles: []string{"default"}}},
+		},
+		{
+			name:     "all profiles without default",
+			profiles: []string{"dev", "prod"},
+			want: []entity.ProfileGroup{
+				{Identifier: "dev", Profiles: []string{"dev"}},
+				{Identifier: "prod", Profiles: []string{"prod"}},
+			},
+		},
+		{
+			name: "--all",
+			args: types.CLIArgs{All: true},
+			want: []entity.ProfileGroup{
+				{Identifier: "default", Profiles: []string{"default"}},
+				{Identifier: "dev", Profiles: []string{"dev"}},
+				{Identifier: "prod", Profiles: []string{"prod"}},
+			},
+		},
+		{
+			name:    "--profiles skips unknown profiles",
+			args:    types.CLIArgs{Profiles: []string{"prod", "qa"}},
+			want:    []entity.ProfileGroup{{Identifier: "prod", Profiles: []string{"prod"}}},
+			warning: "Profile 'qa' not found.",
+		},
+		{
+			name:    "no valid profile",
+			args:    types.CLIArgs{Profiles: []string{"qa"}},
+			wantErr: types.ErrNoValidProfilesFound,
+		},
+		{
+			name:    "expired credentials",
+			errors:  map[string]error{"GetAccountID:default": errors.New("token expired")},
+			wantErr: errors.New("credential validation failed for profile 'default'"),
+		},
+		{
+			name: "--combine groups profiles by account",
+			args: types.CLIArgs{All: true, Combine: true},
+			want: []entity.ProfileGroup{
+				{Identifier: "default", AccountID: "111111111111", Profiles: []string{"default"}, IsCombined: true},
+				{Identifier: "dev, prod", AccountID: "222222222222", Profiles: []string{"dev", "prod"}, IsCombined: true},
+			},
+		},
+		{
+			name:   "--combine skips profiles without account",
+			args:   types.CLIArgs{Profiles: []string{"prod", "dev"}, Combine: true},
+			errors: map[string]error{"GetAccountID:dev": errors.New("no credentials")},
+			want: []entity.ProfileGroup{
+				{Identifier: "prod", AccountID: "222222222222", Profiles: []string{"prod"}, IsCombined: true},
+			},
+			warning: "Could not get Account ID for profile 'dev', skipping.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newTestRepo()
+			if tt.profiles != nil {
+				repo.Profiles = tt.profiles
+			}
+			repo.Errors = tt.errors
+			uc, console := newTestUseCase(repo)
+
+			args := tt.args
+			groups, err := uc.initializeProfiles(context.Background(), &args)
+			switch {
+			case tt.wantErr != nil && err == nil:
+				t.Fatalf("expected error %v", tt.wantErr)
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) && !strings.Contains(err.Error(), tt.wantErr.Error()) {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Com --combine a ordem dos grupos segue um map; compara ordenado.
+			sort.Slice(groups, func(i, j int) bool { return groups[i].Identifier < groups[j].Identifier })
+			if len(groups) != len(tt.want) {
+				t.Fatalf("expected %d groups, got %+v", len(tt.want), groups)
+			}
+			for i := range groups {
+				if groups[i].Identifier != tt.want[i].Identifier || groups[i].AccountID != tt.want[i].AccountID ||
+					groups[i].IsCombined != tt.want[i].IsCombined || !slices.Equal(groups[i].Profiles, tt.want[i].Profiles) {
+					t.Errorf("group %d: expected %+v, got %+v", i, tt.want[i], groups[i])
+				}
+			}
+			if tt.warning != "" {
+				assertContains(t, strings.Join(console.Messages(fake.LevelWarning), "\n"), tt.warning)
+			}
+		})
+	}
+}
+
+func TestMergeConfig(t *testing.T) {
+	intPtr := func(n int) *int { return &n }
+	cfg := &types.Config{
+		Profiles:     []string{"dev"},
+		Regions:      []string{"eu-west-1"},
+		Combine:      true,
+		ReportName:   "monthly",
+		ReportType:   []string{"json", "pdf"},
+		TimeRange:    7,
+		Metric:       "amortized",
+		Tag:          []string{"Team=data"},
+		CacheTTL:     "30m",
+		NoHistory:    true,
+		RoleDuration: "2h",
+		Audit:        true,
+	}
+
+	tests := []struct {
+		name    string
+		args    types.CLIArgs
+		cfg     *types.Config
+		loadErr error
+		check   func(*testing.T, *types.CLIArgs)
+		wantErr error
+	}{
+		{
+			name: "config fills missing options",
+			args: types.CLIArgs{ConfigFile: "config.toml", ReportType: []string{"csv"}},
+			check: func(t *testing.T, a *types.CLIArgs) {
+				if !slices.Equal(a.Profiles, []string{"dev"}) || !slices.Equal(a.Regions, []string{"eu-west-1"}) || !a.Combine {
+					t.Errorf("profiles, regions and combine not taken from the config: %+v", a)
+				}
+				if a.ReportName != "monthly" || !slices.Equal(a.ReportType, []string{"json", "pdf"}) {
+					t.Errorf("report name %q and types %v not taken from the config", a.ReportName, a.ReportType)
+				}
+				if a.TimeRange == nil || *a.TimeRange != 7 || a.Metric != "amortized" || !slices.Equal(a.Tag, []string{"Team=data"}) {
+					t.Errorf("period, metric and tag not taken from the config: %+v", a)
+				}
+				if a.CacheTTL != 30*time.Minute || a.RoleDuration != 2*time.Hour || !a.NoHistory {
+					t.Errorf("durations and flags not taken from the config: %+v", a)
+				}
+				if a.Report != types.ReportAudit {
+					t.Errorf("expected the audit report from the config, got %q", a.Report)
+				}
+			},
+		},
+		{
+			name: "command line wins",
+			args: types.CLIArgs{
+				ConfigFile: "config.toml",
+				Profiles:   []string{"prod"},
+				ReportType: []string{"csv", "json"},
+				Month:      "2026-08",
+				Metric:     "net-unblended",
+				CacheTTL:   5 * time.Minute,
+				Report:     types.ReportTrend,
+			},
+			check: func(t *testing.T, a *types.CLIArgs) {
+				if !slices.Equal(a.Profiles, []string{"prod"}) || a.Metric != "net-unblended" || a.CacheTTL != 5*time.Minute {
+					t.Errorf("command line options replaced by the config: %+v", a)
+				}
+				if !slices.Equal(a.ReportType, []string{"csv", "json"}) {
+					t.Errorf("explicit report types replaced by the config: %v", a.ReportType)
+				}
+				if a.TimeRange != nil || a.Month != "2026-08" {
+					t.Errorf("config period must not mix with --month: time range %v, month %q", a.TimeRange, a.Month)
+				}
+				if a.Report != types.ReportTrend {
+					t.Errorf("expected the trend report from the command line, got %q", a.Report)
+				}
+			},
+		},
+		{
+			name: "command line period keeps config dates out",
+			args: types.CLIArgs{ConfigFile: "config.toml", TimeRange: intPtr(30)},
+			cfg:  &types.Config{From: "2026-01-01", To: "2026-01-31"},
+			check: func(t *testing.T, a *types.CLIArgs) {
+				if *a.TimeRange != 30 || a.From != "" || a.To != "" {
+					t.Errorf("expected only --time-range, got %d, %q, %q", *a.TimeRange, a.From, a.To)
+				}
+			},
+		},
+		{
+			name:    "invalid cache TTL",
+			args:    types.CLIArgs{ConfigFile: "config.toml"},
+			cfg:     &types.Config{CacheTTL: "soon"},
+			wantErr: errors.New(`invalid cache_ttl "soon"`),
+		},
+		{
+			name:    "audit and trend in the config",
+			args:    types.CLIArgs{ConfigFile: "config.toml"},
+			cfg:     &types.Config{Audit: true, Trend: true},
+			wantErr: types.ErrConflictingReports,
+		},
+		{
+			name:    "unreadable config",
+			args:    types.CLIArgs{ConfigFile: "config.toml"},
+			loadErr: os.ErrNotExist,
+			wantErr: os.ErrNotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configRepo := &stubConfigRepository{cfg: cfg, err: tt.loadErr}
+			if tt.cfg != nil {
+				configRepo.cfg = tt.cfg
+			}
+			uc := NewDashboardUseCase(newTestRepo(), nil, configRepo, nil, fake.NewConsole())
+
+			args := tt.args
+			err := uc.mergeConfig(&args)
+			if tt.wantErr != nil {
+				if err == nil || (!errors.Is(err, tt.wantErr) && !strings.Contains(err.Error(), tt.wantErr.Error())) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tt.check(t, &args)
+		})
+	}
+
+	t.Run("no config file", func(t *testing.T) {
+		configRepo := &stubConfigRepository{cfg: cfg}
+		uc := NewDashboardUseCase(newTestRepo(), nil, configRepo, nil, fake.NewConsole())
+		args := types.CLIArgs{}
+		if err := uc.mergeConfig(&args); err != nil {
+			t.Fatal(err)
+		}
+		if configRepo.loads != 0 || args.Profiles != nil {
+			t.Errorf("no config file must leave the arguments untouched: %+v", args)
+		}
+	})
+}
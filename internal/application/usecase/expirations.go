@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+// expirationRow é a lista de compromissos ativos de um grupo de perfis.
+type expirationRow struct {
+	Profile string
+	Report  entity.CommitmentExpirationReport
+	Err     error
+}
+
+func (uc *DashboardUseCase) runExpirationReport(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) error {
+	uc.console.LogInfo("Listing active Savings Plans and reservations (highlighting those ending within %d days)...", opts.ExpiringWithin)
+
+	results := uc.collectExpirationReports(ctx, profileGroups, args.Regions, opts)
+
+	table := uc.console.CreateTable()
+	table.AddColumn("Profile")
+	table.AddColumn("Account ID")
+	table.AddColumn("Kind")
+	table.AddColumn("Type")
+	table.AddColumn("Details")
+	table.AddColumn("Commitment")
+	table.AddColumn("ID")
+	table.AddColumn("Start")
+	table.AddColumn("End")
+	table.AddColumn("Days Left")
+
+	for _, r := range results {
+		if r.Err != nil {
+			table.AddRow(pterm.FgMagenta.Sprint(r.Profile), "N/A", pterm.FgRed.Sprintf("Error: %v", r.Err), "-", "-", "-", "-", "-", "-", "-")
+			continue
+		}
+		rep := r.Report
+		if len(rep.Commitments) == 0 {
+			table.AddRow(pterm.FgMagenta.Sprint(rep.Profile), rep.AccountID, pterm.FgGray.Sprint("No active commitments"), "-", "-", "-", "-", "-", "-", "-")
+			continue
+		}
+		for _, c := range rep.Commitments {
+			daysLeft := pterm.FgGreen.Sprint(c.DaysRemaining)
+			end := c.End.Format("2006-01-02")
+			if c.ExpiringSoon {
+				daysLeft = pterm.FgRed.Sprintf("%d (expiring)", c.DaysRemaining)
+				end = pterm.FgRed.Sprint(end)
+			}
+			table.AddRow(
+				pterm.FgMagenta.Sprint(rep.Profile),
+				rep.AccountID,
+				c.Kind,
+				c.Type,
+				c.Details,
+				formatCommitmentSize(c),
+				c.ID,
+				c.Start.Format("2006-01-02"),
+				end,
+				daysLeft,
+			)
+		}
+	}
+	uc.console.Println("\n" + table.Render())
+
+	for _, r := range results {
+		if r.Err != nil {
+			continue
+		}
+		if n := r.Report.ExpiringSoon(); n > 0 {
+			uc.console.LogWarning("%s: %d of %d commitments end within %d days", r.Profile, n, len(r.Report.Commitments), r.Report.WarningDays)
+		}
+	}
+
+	if args.ReportName != "" {
+		uc.console.LogInfo("Exporting commitment expiration reports...")
+		reports := make([]entity.CommitmentExpirationReport, 0, len(results))
+		for _, r := range results {
+			if r.Err == nil {
+				reports = append(reports, r.Report)
+			}
+		}
+		for _, reportType := range args.ReportType {
+			switch strings.ToLower(reportType) {
+			case "csv":
+				path, err := uc.exportRepo.ExportExpirationReportToCSV(reports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export expirations CSV: %v", err)
+				} else {
+					uc.console.LogSuccess("Expirations CSV saved to: %s", path)
+				}
+			case "json":
+				path, err := uc.exportRepo.ExportExpirationReportToJSON(reports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export expirations JSON: %v", err)
+				} else {
+					uc.console.LogSuccess("Expirations JSON saved to: %s", path)
+				}
+			case "pdf":
+				path, err := uc.exportRepo.ExportExpirationReportToPDF(reports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export expirations PDF: %v", err)
+				} else {
+					uc.console.LogSuccess("Expirations PDF saved to: %s", path)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatCommitmentSize mostra o compromisso por hora dos Savings Plans ou a quantidade das reservas.
+func formatCommitmentSize(c entity.CommitmentExpiration) string {
+	if c.Kind == entity.PurchaseSavingsPlans {
+		return fmt.Sprintf("$%.3f/h", c.HourlyCommitment)
+	}
+	if c.Quantity > 0 {
+		return fmt.Sprintf("x%d", c.Quantity)
+	}
+	return "-"
+}
+
+// collectExpirationReports busca os Savings Plans e as reservas ativos de cada grupo de perfis, ordenados por perfil.
+func (uc *DashboardUseCase) collectExpirationReports(ctx context.Context, profileGroups []entity.ProfileGroup, userRegions []string, opts reportOptions) []expirationRow {
+	livePrinter, _ := uc.console.GetMultiPrinter().Start()
+	defer livePrinter.Stop()
+
+	results := make([]expirationRow, 0, len(profileGroups))
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	for _, group := range profileGroups {
+		wg.Add(1)
+		go func(g entity.ProfileGroup) {
+			defer wg.Done()
+
+			bar := uc.console.NewProgressbar(1, fmt.Sprintf("Expirations: %s", g.Identifier))
+			bar.Start()
+
+			row := uc.getExpirationReport(ctx, g, userRegions, opts)
+			bar.Increment()
+
+			mu.Lock()
+			results = append(results, row)
+			mu.Unlock()
+		}(group)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Profile < results[j].Profile })
+	return results
+}
+
+// getExpirationReport monta o relatório de vencimentos de um grupo de perfis; usado também pela auditoria completa.
+// Sem regiões informadas, as reservas são buscadas em todas as regiões acessíveis.
+func (uc *DashboardUseCase) getExpirationReport(ctx context.Context, g entity.ProfileGroup, userRegions []string, opts reportOptions) expirationRow {
+	profile := g.Profiles[0]
+	regions := userRegions
+	if len(regions) == 0 {
+		regions, _ = uc.awsRepo.GetAccessibleRegions(ctx, profile)
+	}
+	accountID, _ := uc.awsRepo.GetAccountID(ctx, profile)
+	commitments, err := uc.awsRepo.GetActiveCommitments(ctx, profile, regions)
+	if err != nil {
+		return expirationRow{Profile: g.Identifier, Err: err}
+	}
+	report := entity.NewCommitmentExpirationReport(g.Identifier, accountID, commitments, time.Now(), opts.ExpiringWithin)
+	return expirationRow{Profile: g.Identifier, Report: report}
+}
@@ -0,0 +1,339 @@
+package usecase
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+func TestFormatServiceCosts(t *testing.T) {
+	uc := &DashboardUseCase{}
+	tests := []struct {
+		name  string
+		costs []entity.ServiceCost
+		want  []string
+	}{
+		{name: "no costs", want: []string{"No costs found"}},
+		{
+			name:  "flat",
+			costs: []entity.ServiceCost{{ServiceName: "Amazon EC2", Cost: 10.5}, {ServiceName: "Tax", Cost: 1}},
+			want:  []string{"Amazon EC2: $10.50", "Tax: $1.00"},
+		},
+		{
+			name: "with breakdown",
+			costs: []entity.ServiceCost{{ServiceName: "Amazon EC2", Cost: 12, SubCosts: []entity.ServiceCost{
+				{ServiceName: "BoxUsage:t3.micro", Cost: 8},
+				{ServiceName: "EBS:VolumeUsage.gp3", Cost: 4},
+			}}},
+			want: []string{"Amazon EC2: $12.00 (details below)", "  └─ BoxUsage:t3.micro: $8.00", "  └─ EBS:VolumeUsage.gp3: $4.00"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := uc.formatServiceCosts(tt.costs); !slices.Equal(got, tt.want) {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatBudgetInfo(t *testing.T) {
+	uc := &DashboardUseCase{}
+	tests := []struct {
+		name    string
+		budgets []entity.BudgetInfo
+		want    []string
+	}{
+		{name: "no budgets", want: []string{"No budgets configured"}},
+		{
+			name:    "without forecast",
+			budgets: []entity.BudgetInfo{{Name: "dev", Limit: 100, Actual: 25.5}},
+			want:    []string{"dev Limit: $100.00\ndev Actual: $25.50"},
+		},
+		{
+			name:    "with forecast",
+			budgets: []entity.BudgetInfo{{Name: "prod", Limit: 1000, Actual: 400, Forecast: 1200}},
+			want:    []string{"prod Limit: $1000.00\nprod Actual: $400.00\nprod Forecast: $1200.00"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := uc.formatBudgetInfo(tt.budgets); !slices.Equal(got, tt.want) {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatEC2Summary(t *testing.T) {
+	uc := &DashboardUseCase{}
+	tests := []struct {
+		name    string
+		summary entity.EC2Summary
+		want    []string
+	}{
+		{name: "empty", want: []string{"No instances found"}},
+		{name: "only zero counts", summary: entity.EC2Summary{"running": 0}, want: []string{"No instances found"}},
+		{
+			name:    "sorted by state",
+			summary: entity.EC2Summary{"stopped": 1, "running": 3, "pending": 2, "terminated": 0},
+			want:    []string{"pending: 2", "running: 3", "stopped: 1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := uc.formatEC2Summary(tt.summary); !slices.Equal(got, tt.want) {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCalculatePercentageChange(t *testing.T) {
+	uc := &DashboardUseCase{}
+	pct := func(v float64) *float64 { return &v }
+	tests := []struct {
+		name              string
+		current, previous float64
+		want              *float64
+	}{
+		{name: "increase", current: 150, previous: 100, want: pct(50)},
+		{name: "decrease", current: 25, previous: 100, want: pct(-75)},
+		{name: "no cost in both periods", current: 0, previous: 0, want: pct(0)},
+		{name: "new cost", current: 10, previous: 0, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := uc.calculatePercentageChange(tt.current, tt.previous)
+			switch {
+			case tt.want == nil && got != nil:
+				t.Errorf("expected nil, got %v", *got)
+			case tt.want != nil && (got == nil || *got != *tt.want):
+				t.Errorf("expected %v, got %v", *tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatForecast(t *testing.T) {
+	tests := []struct {
+		name     string
+		forecast *entity.CostForecast
+		want     string
+	}{
+		{name: "no forecast", want: "N/A"},
+		{
+			name:     "cost explorer",
+			forecast: &entity.CostForecast{Amount: 120, LowerBound: 100, UpperBound: 140},
+			want:     "$120.00\n($100.00 - $140.00)",
+		},
+		{
+			name:     "linear projection over budget",
+			forecast: &entity.CostForecast{Amount: 90, LowerBound: 90, UpperBound: 90, Source: entity.ForecastSourceLinear, ExceededBudgets: []string{"dev", "team"}},
+			want:     "$90.00\n($90.00 - $90.00)\nlinear projection\n\n⚠ Exceeds budget:\ndev\nteam",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatForecast(tt.forecast); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatExcludedCosts(t *testing.T) {
+	tests := []struct {
+		name     string
+		excluded []entity.ServiceCost
+		want     string
+	}{
+		{name: "nothing excluded", want: ""},
+		{
+			name:     "credits and tax",
+			excluded: []entity.ServiceCost{{ServiceName: "Credit", Cost: -50}, {ServiceName: "Tax", Cost: 12}},
+			want:     "\n\nExcl. Credit: $-50.00\nExcl. Tax: $12.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatExcludedCosts(tt.excluded); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetDisplayTablePeriodInfo(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name                                     string
+		period                                   entity.Period
+		prevName, currName, prevDates, currDates string
+	}{
+		{
+			name:      "current month",
+			period:    entity.CurrentMonthPeriod(now),
+			prevName:  "Last month's cost",
+			currName:  "Current month's cost",
+			prevDates: "2026-09-01 to 2026-09-30",
+			currDates: "2026-10-01 to 2026-10-15",
+		},
+		{
+			name:      "last days",
+			period:    entity.LastDaysPeriod(now, 7),
+			prevName:  "Previous 7 days cost",
+			currName:  "Current 7 days cost",
+			prevDates: "2026-10-02 to 2026-10-08",
+			currDates: "2026-10-09 to 2026-10-15",
+		},
+		{
+			name:      "calendar month",
+			period:    entity.MonthPeriod(2026, time.August),
+			prevName:  "2026-07 cost",
+			currName:  "2026-08 cost",
+			prevDates: "2026-07-01 to 2026-07-31",
+			currDates: "2026-08-01 to 2026-08-31",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prevName, currName, prevDates, currDates := getDisplayTablePeriodInfo(tt.period)
+			got := []string{prevName, currName, prevDates, currDates}
+			want := []string{tt.prevName, tt.currName, tt.prevDates, tt.currDates}
+			if !slices.Equal(got, want) {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestFormatSnapshotPeriod(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       string
+	}{
+		{name: "no period", want: "-"},
+		{
+			name:  "inclusive end",
+			start: time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+			want:  "2026-08-01 to 2026-08-31",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatSnapshotPeriod(tt.start, tt.end); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatTrendChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to float64
+		want     string
+	}{
+		{name: "no previous cost", from: 0, to: 10, want: "N/A"},
+		{name: "increase", from: 100, to: 125, want: "+25.00%"},
+		{name: "decrease", from: 100, to: 80, want: "-20.00%"},
+		{name: "stable", from: 100, to: 100, want: "0.00%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatTrendChange(tt.from, tt.to); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatPotentialSavings(t *testing.T) {
+	tests := []struct {
+		name       string
+		estimate   *entity.SavingsEstimate
+		savingsErr string
+		want       string
+	}{
+		{name: "not estimated", want: "N/A"},
+		{name: "prices unavailable", savingsErr: "access denied", want: "N/A\n(prices unavailable)"},
+		{
+			name:     "priced",
+			estimate: &entity.SavingsEstimate{StoppedInstances: 10, UnusedVolumes: 5.5, UnusedEIPs: 3.65, IdleLoadBalancers: 16.2},
+			want:     "$35.35/mo",
+		},
+		{
+			name:     "with unpriced resources",
+			estimate: &entity.SavingsEstimate{UnusedVolumes: 8, Unpriced: 2},
+			want:     "$8.00/mo\n(+2 unpriced)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatPotentialSavings(tt.estimate, tt.savingsErr); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatCheckFindings(t *testing.T) {
+	cost := func(v float64) *float64 { return &v }
+	tests := []struct {
+		name     string
+		checkID  string
+		findings []entity.Finding
+		markNew  bool
+		want     string
+	}{
+		{name: "no budget exceeded", checkID: entity.CheckBudgetExceeded, want: "No budgets exceeded"},
+		{
+			name:     "budget exceeded",
+			checkID:  entity.CheckBudgetExceeded,
+			findings: []entity.Finding{{ResourceID: "dev", Details: "$150.00 of $100.00"}},
+			want:     "dev: $150.00 of $100.00",
+		},
+		{
+			name:    "nat gateways by cost",
+			checkID: entity.CheckHighCostNatGateway,
+			findings: []entity.Finding{
+				{ResourceID: "nat-cheap", Region: "us-east-1", EstimatedCost: cost(40)},
+				{ResourceID: "nat-costly", Region: "eu-west-1", EstimatedCost: cost(90)},
+			},
+			want: "$90.00 - nat-costly (eu-west-1)\n$40.00 - nat-cheap (us-east-1)",
+		},
+		{
+			name:    "untagged by service and region",
+			checkID: entity.CheckUntaggedResource,
+			findings: []entity.Finding{
+				{Service: "EC2", Region: "us-east-1", ResourceID: "i-2"},
+				{Service: "EC2", Region: "us-east-1", ResourceID: "i-1", Baseline: entity.BaselineNew},
+				{Service: "RDS", Region: "eu-west-1", ResourceID: "db-1"},
+			},
+			markNew: true,
+			want:    "EC2:\n  us-east-1:\n    - i-1 [new]\n    - i-2\nRDS:\n  eu-west-1:\n    - db-1\n",
+		},
+		{name: "no regional findings", checkID: entity.CheckUnusedVolume, want: "None"},
+		{
+			name:    "regional with details and cost",
+			checkID: entity.CheckUnusedVolume,
+			findings: []entity.Finding{
+				{Region: "us-east-1", ResourceID: "vol-1", Details: "gp3, 100 GiB", EstimatedCost: cost(8)},
+				{Region: "us-east-1", ResourceID: "vol-2", Baseline: entity.BaselineNew},
+			},
+			want: "us-east-1:\n  - vol-1 (gp3, 100 GiB) - $8.00/mo\n  - vol-2\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatCheckFindings(tt.checkID, tt.findings, tt.markNew); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
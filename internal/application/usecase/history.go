@@ -0,0 +1,337 @@
+package usecase
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+// latestSnapshot é o ID aceito por 'history show' para o snapshot mais recente.
+const latestSnapshot = "latest"
+
+// historyDir retorna o diretório do histórico: --history-dir ou ~/.aws-finops/history.
+func historyDir(args *types.CLIArgs) (string, error) {
+	if args.HistoryDir != "" {
+		return args.HistoryDir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("could not find the home directory for the history store (set --history-dir): %w", err)
+	}
+	return filepath.Join(home, ".aws-finops", "history"), nil
+}
+
+// newSnapshot prepara o snapshot de uma execução com o período, a métrica e os filtros do relatório.
+func newSnapshot(args *types.CLIArgs, opts reportOptions, period entity.Period) entity.Snapshot {
+	now := time.Now()
+	report := args.Report
+	if report == "" {
+		report = types.ReportCost
+	}
+	return entity.Snapshot{
+		ID:          entity.NewSnapshotID(now, string(report)),
+		CreatedAt:   now,
+		Report:      string(report),
+		Metric:      opts.Metric,
+		PeriodStart: period.Start,
+		PeriodEnd:   period.End,
+		Tag:         args.Tag,
+		Exclude:     opts.Exclude,
+	}
+}
+
+// saveSnapshot grava o resultado da execução no histórico local, a menos que --no-history tenha sido
+// usado. Uma falha não interrompe o relatório, que já foi exibido.
+func (uc *DashboardUseCase) saveSnapshot(args *types.CLIArgs, snapshot entity.Snapshot) {
+	if args.NoHistory {
+		return
+	}
+	dir, err := historyDir(args)
+	if err == nil {
+		var id string
+		id, err = uc.historyRepo.SaveSnapshot(dir, snapshot)
+		if err == nil {
+			uc.console.Println(pterm.FgGray.Sprintf("Snapshot %s saved to the history in %s.", id, dir))
+			return
+		}
+	}
+	uc.console.LogWarning("Could not save the snapshot to the history: %v", err)
+}
+
+// loadHistory lê o índice do histórico, filtrado por --profiles quando informado.
+func (uc *DashboardUseCase) loadHistory(args *types.CLIArgs) (string, []entity.SnapshotSummary, error) {
+	if err := uc.mergeConfig(args); err != nil {
+		return "", nil, fmt.Errorf("failed to process configuration: %w", err)
+	}
+	dir, err := historyDir(args)
+	if err != nil {
+		return "", nil, err
+	}
+	summaries, err := uc.historyRepo.ListSnapshots(dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var out []entity.SnapshotSummary
+	for _, s := range summaries {
+		if len(args.Profiles) > 0 && !containsAny(s.Profiles, args.Profiles) {
+			continue
+		}
+		out = append(out, s)
+	}
+	return dir, out, nil
+}
+
+// ListHistory displays the snapshots saved in the local history store.
+func (uc *DashboardUseCase) ListHistory(args *types.CLIArgs) error {
+	dir, summaries, err := uc.loadHistory(args)
+	if err != nil {
+		return err
+	}
+	if len(summaries) == 0 {
+		uc.console.LogWarning("No snapshots in %s. Run a cost, trend, audit or full-audit report to record one.", dir)
+		return nil
+	}
+
+	table := uc.console.CreateTable()
+	table.AddColumn("ID")
+	table.AddColumn("Created")
+	table.AddColumn("Report")
+	table.AddColumn("Period")
+	table.AddColumn("Metric")
+	table.AddColumn("Profiles")
+	table.AddColumn("Cost")
+	table.AddColumn("Findings")
+	for _, s := range summaries {
+		cost, findings := "-", "-"
+		if s.Cost != nil {
+			cost = fmt.Sprintf("$%.2f", *s.Cost)
+		}
+		if s.Findings != nil {
+			findings = fmt.Sprint(*s.Findings)
+		}
+		table.AddRow(
+			s.ID,
+			s.CreatedAt.Local().Format("2006-01-02 15:04"),
+			s.Report,
+			formatSnapshotPeriod(s.PeriodStart, s.PeriodEnd),
+			s.Metric.Label(),
+			strings.Join(s.Profiles, "\n"),
+			cost,
+			findings,
+		)
+	}
+	uc.console.Println(table.Render())
+	uc.console.Println(pterm.FgGray.Sprintf("%d snapshots in %s.", len(summaries), dir))
+	return nil
+}
+
+// ShowHistory displays a snapshot from the local history store; "latest" shows the most recent one.
+func (uc *DashboardUseCase) ShowHistory(args *types.CLIArgs, id string) error {
+	dir, summaries, err := uc.loadHistory(args)
+	if err != nil {
+		return err
+	}
+	if id == "" || id == latestSnapshot {
+		if len(summaries) == 0 {
+			return fmt.Errorf("%w: the history in %s is empty", entity.ErrSnapshotNotFound, dir)
+		}
+		id = summaries[len(summaries)-1].ID
+	}
+	snapshot, err := uc.historyRepo.GetSnapshot(dir, id)
+	if err != nil {
+		return err
+	}
+	s := snapshot.FilterProfiles(args.Profiles)
+
+	uc.console.Println(pterm.DefaultSection.WithLevel(1).Sprintf("Snapshot %s", s.ID))
+	uc.console.Println(fmt.Sprintf("Report: %s | Created: %s | Period: %s | Cost metric: %s",
+		s.Report, s.CreatedAt.Local().Format("2006-01-02 15:04"), formatSnapshotPeriod(s.PeriodStart, s.PeriodEnd), s.Metric.Label()))
+	if len(s.Tag) > 0 || len(s.Exclude) > 0 {
+		uc.console.Println(pterm.FgGray.Sprintf("Filters: %s; excluded: %s", strings.Join(s.Tag, " AND "), entity.RecordTypesLabel(s.Exclude)))
+	}
+
+	switch {
+	case len(s.Profiles) > 0:
+		table := uc.console.CreateTable()
+		table.AddColumn("Profile")
+		table.AddColumn("Account ID")
+		table.AddColumn("Previous Period Cost")
+		table.AddColumn("Current Period Cost")
+		table.AddColumn("Change")
+		table.AddColumn(s.Profiles[0].GroupBy.Title())
+		for _, p := range s.Profiles {
+			table.AddRow(
+				pterm.FgMagenta.Sprint(p.Profile),
+				p.AccountID,
+				fmt.Sprintf("$%.2f", p.LastMonth),
+				fmt.Sprintf("$%.2f", p.CurrentMonth),
+				formatTrendChange(p.LastMonth, p.CurrentMonth),
+				strings.Join(uc.formatServiceCosts(p.ServiceCosts), "\n"),
+			)
+		}
+		uc.console.Println(table.Render())
+	case len(s.Trends) > 0:
+		for _, t := range s.Trends {
+			uc.console.Println("\n" + pterm.FgYellow.Sprintf("Account: %s (Profile: %s)", t.AccountID, t.Profile))
+			uc.displayTrend(t)
+		}
+	case len(s.Audits) > 0:
+		table := uc.console.CreateTable()
+		table.AddColumn("Profile")
+		table.AddColumn("Account ID")
+		table.AddColumn("Findings")
+		table.AddColumn("By Check")
+		table.AddColumn("Potential Monthly Savings")
+		for _, a := range s.Audits {
+			byCheck := make([]string, 0, len(a.Checks))
+			for _, id := range a.Checks {
+				if n := a.Count(id); n > 0 {
+					byCheck = append(byCheck, fmt.Sprintf("%s: %d", id, n))
+				}
+			}
+			table.AddRow(
+				pterm.FgMagenta.Sprint(a.Profile),
+				a.AccountID,
+				fmt.Sprint(len(a.Findings)),
+				strings.Join(byCheck, "\n"),
+				formatPotentialSavings(a.PotentialSavings, a.SavingsError),
+			)
+		}
+		uc.console.Println(table.Render())
+	default:
+		uc.console.LogWarning("Snapshot %s has no results for the selected profiles.", s.ID)
+	}
+	return nil
+}
+
+// HistoryTrend charts the monthly costs and the audit finding counts recorded in the local history
+// store, without calling AWS.
+func (uc *DashboardUseCase) HistoryTrend(args *types.CLIArgs) error {
+	dir, summaries, err := uc.loadHistory(args)
+	if err != nil {
+		return err
+	}
+	if len(summaries) == 0 {
+		uc.console.LogWarning("No snapshots in %s. Run a cost, trend, audit or full-audit report to record one.", dir)
+		return nil
+	}
+
+	snapshots := make([]entity.Snapshot, 0, len(summaries))
+	for _, sum := range summaries {
+		s, err := uc.historyRepo.GetSnapshot(dir, sum.ID)
+		if err != nil {
+			uc.console.LogWarning("Skipping snapshot %s: %v", sum.ID, err)
+			continue
+		}
+		snapshots = append(snapshots, s.FilterProfiles(args.Profiles))
+	}
+
+	costs := entity.BuildCostHistory(snapshots)
+	if len(costs) > 0 {
+		uc.console.Println("\n" + pterm.DefaultSection.WithLevel(1).Sprint("Monthly Cost History"))
+	}
+	for _, t := range costs {
+		title := fmt.Sprintf("Profile: %s (Account: %s) - %s", t.Profile, t.AccountID, t.Metric.Label())
+		uc.console.Println("\n" + pterm.FgYellow.Sprint(title))
+		uc.displayTrend(t)
+	}
+
+	findings := entity.BuildFindingHistory(snapshots)
+	if len(findings) > 0 {
+		uc.console.Println("\n" + pterm.DefaultSection.WithLevel(1).Sprint("Audit Findings History"))
+	}
+	for _, h := range findings {
+		uc.console.Println("\n" + pterm.FgYellow.Sprintf("Profile: %s (Account: %s)", h.Profile, h.AccountID))
+		uc.console.Println(uc.renderFindingHistory(h))
+	}
+
+	if len(costs) == 0 && len(findings) == 0 {
+		uc.console.LogWarning("No monthly costs or audit findings in the selected snapshots.")
+	}
+	return nil
+}
+
+// displayTrend exibe as barras de uma tendência e, quando houver, a tabela das séries.
+func (uc *DashboardUseCase) displayTrend(t entity.CostTrend) {
+	bars := make([]types.TrendBar, len(t.Points))
+	for i, p := range t.Points {
+		bars[i] = types.TrendBar{Label: p.Label, Cost: p.Cost}
+	}
+	uc.console.DisplayTrendBars(t.Granularity.Unit(), bars)
+	if len(t.Series) > 0 && len(t.Points) > 0 {
+		uc.console.Println(uc.renderTrendSeries(t))
+	}
+	// Os valores excluídos não entram nos pontos; aparecem à parte para conciliar com a fatura.
+	if len(t.ExcludedCosts) > 0 {
+		parts := make([]string, len(t.ExcludedCosts))
+		for i, e := range t.ExcludedCosts {
+			parts[i] = fmt.Sprintf("%s: $%.2f", e.ServiceName, e.Cost)
+		}
+		uc.console.Println(pterm.FgGray.Sprintf("Excluded from totals: %s", strings.Join(parts, ", ")))
+	}
+}
+
+// renderFindingHistory monta a tabela do número de achados de um perfil em cada snapshot, com barras
+// proporcionais ao maior número.
+func (uc *DashboardUseCase) renderFindingHistory(h entity.FindingHistory) string {
+	most := 0
+	for _, p := range h.Points {
+		most = max(most, p.Findings)
+	}
+
+	table := uc.console.CreateTable()
+	table.AddColumn("Snapshot")
+	table.AddColumn("Findings")
+	table.AddColumn("")
+	table.AddColumn("Change")
+	table.AddColumn("Potential Monthly Savings")
+	for i, p := range h.Points {
+		bar := ""
+		if most > 0 {
+			bar = pterm.FgBlue.Sprint(strings.Repeat("█", p.Findings*40/most))
+		}
+		change := ""
+		if i > 0 {
+			switch diff := p.Findings - h.Points[i-1].Findings; {
+			case diff > 0:
+				change = pterm.FgRed.Sprintf("+%d", diff)
+			case diff < 0:
+				change = pterm.FgGreen.Sprintf("%d", diff)
+			default:
+				change = pterm.FgYellow.Sprint("0")
+			}
+		}
+		savings := "N/A"
+		if p.Savings != nil {
+			savings = fmt.Sprintf("$%.2f/mo", *p.Savings)
+		}
+		table.AddRow(p.CreatedAt.Local().Format("2006-01-02 15:04"), fmt.Sprint(p.Findings), bar, change, savings)
+	}
+	return table.Render()
+}
+
+// formatSnapshotPeriod descreve o período de um snapshot com a data final inclusiva.
+func formatSnapshotPeriod(start, end time.Time) string {
+	if start.IsZero() {
+		return "-"
+	}
+	return fmt.Sprintf("%s to %s", start.Format("2006-01-02"), end.AddDate(0, 0, -1).Format("2006-01-02"))
+}
+
+// containsAny indica se alguma das strings de want está em list.
+func containsAny(list, want []string) bool {
+	for _, w := range want {
+		if slices.Contains(list, w) {
+			return true
+		}
+	}
+	return false
+}
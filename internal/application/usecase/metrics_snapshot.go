@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+)
+
+// MetricsSnapshot is a point-in-time collection of cost, commitment and audit data for all profiles.
+type MetricsSnapshot struct {
+	CollectedAt time.Time
+	Duration    time.Duration
+	Profiles    []ProfileMetrics
+}
+
+// ProfileMetrics holds the data published as metrics for a single profile group.
+// Um campo nulo indica que aquela coleta falhou para o perfil.
+type ProfileMetrics struct {
+	Profile     string
+	AccountID   string
+	Cost        *entity.ProfileData
+	Commitments *entity.CommitmentsReport
+	Audit       *AuditCounts
+	Errors      []string
+}
+
+// AuditCounts is the number of resources found by each audit check.
+type AuditCounts struct {
+	StoppedInstances   int
+	UnusedVolumes      int
+	UnusedEIPs         int
+	IdleLoadBalancers  int
+	UnusedVpcEndpoints int
+	UntaggedResources  int
+	NatGateways        int
+}
+
+// CollectMetrics gathers the data for a metrics snapshot: cost dashboard, SP/RI commitments and audit counts.
+func (uc *DashboardUseCase) CollectMetrics(ctx context.Context, args *types.CLIArgs) (*MetricsSnapshot, error) {
+	start := time.Now()
+	if err := uc.mergeConfig(args); err != nil {
+		return nil, fmt.Errorf("failed to process configuration: %w", err)
+	}
+	// As métricas são por perfil; o modo organização (uma linha por conta-membro) não se aplica.
+	args.Org = false
+
+	// O período é recalculado a cada coleta para acompanhar a virada do mês.
+	opts, err := resolveReportOptions(args, start)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.resolveSuppressions(args, &opts, start); err != nil {
+		return nil, err
+	}
+	// A métrica aws_finops_service_cost_usd é sempre por serviço, mesmo com group_by no arquivo de configuração.
+	opts.GroupBy = entity.DefaultGrouping()
+
+	profileGroups, err := uc.initializeProfiles(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	byProfile := make(map[string]*ProfileMetrics, len(profileGroups))
+	ordered := make([]*ProfileMetrics, 0, len(profileGroups))
+	for _, g := range profileGroups {
+		pm := &ProfileMetrics{Profile: g.Identifier, AccountID: g.AccountID}
+		byProfile[g.Identifier] = pm
+		ordered = append(ordered, pm)
+	}
+
+	for _, data := range uc.collectCostDashboard(ctx, profileGroups, args, opts) {
+		pm := byProfile[data.Profile]
+		if pm == nil {
+			continue
+		}
+		if data.Err != nil {
+			pm.Errors = append(pm.Errors, data.Err.Error())
+			continue
+		}
+		d := data
+		pm.Cost = &d
+		pm.AccountID = d.AccountID
+	}
+
+	for _, r := range uc.collectCommitmentsReports(ctx, profileGroups, args, opts, false) {
+		pm := byProfile[r.Profile]
+		if pm == nil {
+			continue
+		}
+		if r.Err != nil {
+			pm.Errors = append(pm.Errors, r.Err.Error())
+			continue
+		}
+		rep := r.Report
+		pm.Commitments = &rep
+	}
+
+	for _, run := range uc.collectAuditRuns(ctx, profileGroups, args, opts, false) {
+		pm := byProfile[run.Profile]
+		if pm == nil {
+			continue
+		}
+		// Uma verificação com erro marca o perfil como fora, em vez de publicar zero achados como se ela tivesse passado.
+		for _, id := range slices.Sorted(maps.Keys(run.CheckErrs)) {
+			pm.Errors = append(pm.Errors, fmt.Sprintf("check %s: %v", id, run.CheckErrs[id]))
+		}
+		findings, _ := entity.SuppressFindings(run.Findings, opts.Suppressions)
+		pm.Audit = countFindings(findings)
+	}
+
+	snapshot := &MetricsSnapshot{CollectedAt: time.Now(), Duration: time.Since(start)}
+	for _, pm := range ordered {
+		snapshot.Profiles = append(snapshot.Profiles, *pm)
+	}
+	return snapshot, nil
+}
+
+// countFindings conta os achados de cada verificação publicada como métrica.
+func countFindings(findings []entity.Finding) *AuditCounts {
+	counts := &AuditCounts{}
+	for _, f := range findings {
+		switch f.CheckID {
+		case entity.CheckStoppedInstance:
+			counts.StoppedInstances++
+		case entity.CheckUnusedVolume:
+			counts.UnusedVolumes++
+		case entity.CheckUnusedElasticIP:
+			counts.UnusedEIPs++
+		case entity.CheckIdleLoadBalancer:
+			counts.IdleLoadBalancers++
+		case entity.CheckUnusedVpcEndpoint:
+			counts.UnusedVpcEndpoints++
+		case entity.CheckUntaggedResource:
+			counts.UntaggedResources++
+		case entity.CheckHighCostNatGateway:
+			counts.NatGateways++
+		}
+	}
+	return counts
+}
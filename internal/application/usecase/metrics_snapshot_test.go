@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+)
+
+func TestCollectMetricsCheckErrors(t *testing.T) {
+	repo := newTestRepo()
+	repo.Errors = map[string]error{"GetUnusedVolumes": errors.New("throttled")}
+	uc, _ := newTestUseCase(repo)
+
+	args := newTestArgs(types.ReportCost)
+	args.Checks = []string{entity.CheckUnusedVolume}
+	snapshot, err := uc.CollectMetrics(context.Background(), args)
+	if err != nil {
+		t.Fatalf("CollectMetrics: %v", err)
+	}
+	if len(snapshot.Profiles) != 1 {
+		t.Fatalf("expected one profile, got %d", len(snapshot.Profiles))
+	}
+	// Sem o erro, o perfil ficaria up com zero volumes sem uso.
+	pm := snapshot.Profiles[0]
+	if len(pm.Errors) != 1 || pm.Errors[0] != "check unused-volume: throttled" {
+		t.Errorf("expected the failed check in the profile errors, got %q", pm.Errors)
+	}
+}
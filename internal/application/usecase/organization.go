@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+// orgNotCollected é exibido nas colunas que dependem de credenciais dentro de cada conta-membro.
+const orgNotCollected = "N/A (organization mode)"
+
+// generateOrganizationData gera uma linha do dashboard por conta-membro da organização de cada grupo
+// de perfis, a partir das chamadas de Organizations e Cost Explorer feitas na conta de gerenciamento.
+func (uc *DashboardUseCase) generateOrganizationData(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) []entity.ProfileData {
+	livePrinter, _ := uc.console.GetMultiPrinter().Start()
+	defer livePrinter.Stop()
+
+	var results []entity.ProfileData
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, group := range profileGroups {
+		wg.Add(1)
+		go func(g entity.ProfileGroup) {
+			defer wg.Done()
+
+			bar := uc.console.NewProgressbar(2, fmt.Sprintf("Organization: %s", g.Identifier))
+			bar.Start()
+
+			rows, err := uc.collectOrganizationRows(ctx, g, args, opts, bar)
+			if err != nil {
+				rows = []entity.ProfileData{{Profile: g.Identifier, AccountID: g.AccountID, Err: err}}
+			}
+
+			mu.Lock()
+			results = append(results, rows...)
+			mu.Unlock()
+		}(group)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// collectOrganizationRows busca as contas e os custos por conta-membro de um grupo de perfis,
+// ordenados pelo custo do período atual.
+func (uc *DashboardUseCase) collectOrganizationRows(ctx context.Context, g entity.ProfileGroup, args *types.CLIArgs, opts reportOptions, progress *pterm.ProgressbarPrinter) ([]entity.ProfileData, error) {
+	profile := g.Profiles[0]
+
+	accounts, err := uc.awsRepo.GetOrganizationAccounts(ctx, profile)
+	progress.Increment()
+	if err != nil {
+		progress.Increment()
+		return nil, fmt.Errorf("failed to list organization accounts: %w", err)
+	}
+
+	costs, err := uc.awsRepo.GetLinkedAccountCosts(ctx, profile, opts.Period, opts.Metric, opts.GroupBy, args.Tag, opts.Exclude)
+	progress.Increment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cost data by linked account: %w", err)
+	}
+
+	prev := opts.Period.Previous()
+	groupBy := opts.GroupBy
+	if len(groupBy) == 0 {
+		groupBy = entity.DefaultGrouping()
+	}
+	newRow := func(accountID string) entity.ProfileData {
+		return entity.ProfileData{
+			Profile:               g.Identifier,
+			AccountID:             accountID,
+			ServiceCostsFormatted: uc.formatServiceCosts(nil),
+			BudgetInfo:            []string{orgNotCollected},
+			EC2SummaryFormatted:   []string{orgNotCollected},
+			CurrentPeriodName:     opts.Period.Name("cost"),
+			PreviousPeriodName:    prev.Name("cost"),
+			Metric:                opts.Metric.OrDefault(),
+			GroupBy:               groupBy,
+			Success:               true,
+		}
+	}
+
+	rows := make([]entity.ProfileData, 0, len(accounts))
+	index := make(map[string]int, len(accounts))
+	for _, a := range accounts {
+		row := newRow(a.ID)
+		row.AccountName = a.Name
+		row.OUPath = a.OUPath
+		index[a.ID] = len(rows)
+		rows = append(rows, row)
+	}
+
+	// Contas que já saíram da organização ainda podem ter custo no período.
+	for _, c := range costs {
+		i, ok := index[c.AccountID]
+		if !ok {
+			i = len(rows)
+			index[c.AccountID] = i
+			rows = append(rows, newRow(c.AccountID))
+		}
+		rows[i].CurrentMonth = c.CurrentCost
+		rows[i].LastMonth = c.PreviousCost
+		rows[i].ServiceCosts = c.ServiceCosts
+		rows[i].ServiceCostsFormatted = uc.formatServiceCosts(c.ServiceCosts)
+	}
+
+	for i := range rows {
+		rows[i].PercentChangeInCost = uc.calculatePercentageChange(rows[i].CurrentMonth, rows[i].LastMonth)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].CurrentMonth > rows[j].CurrentMonth })
+	return rows, nil
+}
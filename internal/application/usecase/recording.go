@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+// resolveRecording liga a gravação (--record) ou a reprodução (--replay) das chamadas à AWS. Nos dois
+// modos o cache do Cost Explorer fica desligado, para que toda chamada passe pela gravação; na
+// reprodução, o snapshot também não vai para o histórico local, já que os dados não são atuais.
+func (uc *DashboardUseCase) resolveRecording(args *types.CLIArgs) error {
+	switch {
+	case args.Record != "" && args.Replay != "":
+		return types.ErrRecordWithReplay
+	case args.Record != "":
+		if err := uc.awsRepo.RecordAPIResponses(args.Record); err != nil {
+			return err
+		}
+		args.NoCache = true
+		uc.console.Println(pterm.FgGray.Sprintf("Recording AWS API responses to %s (credentials are redacted).", args.Record))
+	case args.Replay != "":
+		if err := uc.awsRepo.ReplayAPIResponses(args.Replay); err != nil {
+			return err
+		}
+		args.NoCache = true
+		args.NoHistory = true
+		uc.console.LogInfo("Replaying the AWS API responses recorded in %s; AWS is not called.", args.Replay)
+	}
+	return nil
+}
+
+// reportRecording avisa, ao fim da execução, se alguma resposta da AWS ficou fora da gravação de --record.
+func (uc *DashboardUseCase) reportRecording(args *types.CLIArgs) {
+	if err := uc.awsRepo.RecordingErr(); err != nil {
+		uc.console.LogWarning("The recording in %s is incomplete and may not replay this run: %v", args.Record, err)
+	}
+}
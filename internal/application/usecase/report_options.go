@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+)
+
+// reportOptions são as opções do Cost Explorer comuns a todos os relatórios.
+type reportOptions struct {
+	Period  entity.Period
+	Metric  entity.CostMetric
+	GroupBy entity.Grouping
+	// Exclude são os tipos de registro (créditos, impostos...) removidos dos totais.
+	Exclude []entity.RecordType
+
+	// Forecast indica se o período chega até hoje; só então a previsão do fim do mês é exibida.
+	Forecast bool
+
+	// Opções da análise de tendência: janela de --trend-months, granularidade e dimensão das séries.
+	TrendPeriod entity.Period
+	Granularity entity.TrendGranularity
+	TrendSeries *entity.GroupDimension
+
+	// Purchase são o prazo, a forma de pagamento e o período de análise das recomendações de compra.
+	Purchase entity.PurchaseOptions
+	// ExpiringWithin é a janela, em dias, para destacar compromissos perto do fim.
+	ExpiringWithin int
+
+	// Prices é a lista de preços offline de --price-list e PriceSource, o caminho do arquivo. Nil usa
+	// a AWS Pricing API na estimativa de economia da auditoria.
+	Prices      entity.PriceList
+	PriceSource string
+
+	// Checks são as verificações da auditoria selecionadas por --checks, na ordem do registro.
+	Checks []Check
+	// Suppressions são as supressões em vigor do arquivo de --suppressions; as vencidas ficam de fora.
+	Suppressions []entity.Suppression
+	// Baseline são os achados da auditoria de --baseline; nil sem base. OnlyNew mantém só os achados novos.
+	Baseline *entity.AuditBaseline
+	OnlyNew  bool
+}
+
+// resolveReportOptions valida o período, a métrica de custo, o agrupamento, as exclusões, as opções de
+// tendência, as de recomendação de compra, a janela de vencimento dos compromissos e as verificações da
+// auditoria.
+func resolveReportOptions(args *types.CLIArgs, now time.Time) (reportOptions, error) {
+	period, err := resolvePeriod(args, now)
+	if err != nil {
+		return reportOptions{}, err
+	}
+	metric, err := entity.ParseCostMetric(args.Metric)
+	if err != nil {
+		return reportOptions{}, err
+	}
+	groupBy, err := entity.ParseGrouping(args.GroupBy)
+	if err != nil {
+		return reportOptions{}, err
+	}
+	exclude, err := entity.ParseRecordTypes(args.Exclude)
+	if err != nil {
+		return reportOptions{}, err
+	}
+	// O filtro é validado aqui para que erros de sintaxe apareçam antes de qualquer chamada à AWS.
+	if _, err := entity.ParseCostFilter(args.Tag); err != nil {
+		return reportOptions{}, err
+	}
+	trendPeriod, granularity, trendSeries, err := resolveTrendOptions(args, now)
+	if err != nil {
+		return reportOptions{}, err
+	}
+	purchase, err := entity.ParsePurchaseOptions(args.Term, args.Payment, args.LookbackDays)
+	if err != nil {
+		return reportOptions{}, err
+	}
+	expiringWithin := args.ExpiringWithin
+	if expiringWithin < 0 {
+		return reportOptions{}, fmt.Errorf("%w: must be a positive number of days, got %d", entity.ErrInvalidExpirationWindow, expiringWithin)
+	}
+	if expiringWithin == 0 {
+		expiringWithin = entity.DefaultExpirationWarningDays
+	}
+	checks, err := selectChecks(args.Checks)
+	if err != nil {
+		return reportOptions{}, err
+	}
+	// No modo organização o dashboard já agrupa por conta-membro; sobra um nível para o detalhamento.
+	isCostReport := args.Report == "" || args.Report == types.ReportCost
+	if args.Org && isCostReport && (len(groupBy) > 1 || groupBy[0] == entity.LinkedAccountDimension()) {
+		return reportOptions{}, fmt.Errorf("%w: --org already groups costs by linked account and accepts a single other --group-by key", entity.ErrInvalidGrouping)
+	}
+	return reportOptions{
+		Period:         period,
+		Metric:         metric,
+		GroupBy:        groupBy,
+		Exclude:        exclude,
+		Forecast:       period.IsOngoing(now),
+		TrendPeriod:    trendPeriod,
+		Granularity:    granularity,
+		TrendSeries:    trendSeries,
+		Purchase:       purchase,
+		ExpiringWithin: expiringWithin,
+		Checks:         checks,
+	}, nil
+}
+
+// resolveTrendOptions valida a janela, a granularidade e a dimensão das séries da tendência.
+func resolveTrendOptions(args *types.CLIArgs, now time.Time) (entity.Period, entity.TrendGranularity, *entity.GroupDimension, error) {
+	months := args.TrendMonths
+	if months == 0 {
+		months = entity.DefaultTrendMonths
+	}
+	granularity, err := entity.ParseTrendGranularity(args.Granularity)
+	if err != nil {
+		return entity.Period{}, "", nil, err
+	}
+	period, err := entity.TrendPeriod(now, months, granularity)
+	if err != nil {
+		return entity.Period{}, "", nil, err
+	}
+	if args.TrendSeries == "" {
+		return period, granularity, nil, nil
+	}
+	series, err := entity.ParseGrouping([]string{args.TrendSeries})
+	if err != nil {
+		return entity.Period{}, "", nil, err
+	}
+	return period, granularity, &series[0], nil
+}
+
+// resolvePeriod calcula o período dos relatórios do Cost Explorer a partir de
+// --time-range, --month ou --from/--to. Sem nenhum deles, usa o mês corrente.
+func resolvePeriod(args *types.CLIArgs, now time.Time) (entity.Period, error) {
+	hasRange := args.From != "" || args.To != ""
+	hasDays := args.TimeRange != nil && *args.TimeRange > 0
+
+	set := 0
+	for _, ok := range []bool{hasRange, hasDays, args.Month != ""} {
+		if ok {
+			set++
+		}
+	}
+	if set > 1 {
+		return entity.Period{}, types.ErrConflictingPeriods
+	}
+
+	switch {
+	case args.Month != "":
+		return entity.ParseMonthPeriod(args.Month)
+	case hasRange:
+		if args.From == "" || args.To == "" {
+			return entity.Period{}, fmt.Errorf("%w: --from and --to must be used together", entity.ErrInvalidPeriod)
+		}
+		return entity.ParseDateRangePeriod(args.From, args.To)
+	case hasDays:
+		return entity.LastDaysPeriod(now, *args.TimeRange), nil
+	default:
+		return entity.CurrentMonthPeriod(now), nil
+	}
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+)
+
+// ReportResult is the structured output of a report, independent of how it is rendered.
+type ReportResult struct {
+	Report      types.ReportKind `json:"report"`
+	GeneratedAt time.Time        `json:"generated_at"`
+	Data        interface{}      `json:"data"`
+	Errors      []ProfileError   `json:"errors,omitempty"`
+}
+
+// ProfileError records a failure for a single profile group.
+type ProfileError struct {
+	Profile string `json:"profile"`
+	Error   string `json:"error"`
+}
+
+// WithConsole returns a copy of the use case that writes to the given console.
+// Os repositórios são compartilhados, incluindo o cache de clientes AWS.
+func (uc *DashboardUseCase) WithConsole(console types.ConsoleInterface) *DashboardUseCase {
+	clone := *uc
+	clone.console = console
+	return &clone
+}
+
+// GenerateReport collects the data of the selected report without rendering or exporting it.
+func (uc *DashboardUseCase) GenerateReport(ctx context.Context, args *types.CLIArgs) (*ReportResult, error) {
+	opts, err := resolveReportOptions(args, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	profileGroups, err := uc.initializeProfiles(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReportResult{Report: args.Report, GeneratedAt: time.Now().UTC()}
+	if result.Report == "" {
+		result.Report = types.ReportCost
+	}
+	addErr := func(profile string, err error) {
+		result.Errors = append(result.Errors, ProfileError{Profile: profile, Error: err.Error()})
+	}
+
+	switch result.Report {
+	case types.ReportCost:
+		results := uc.collectCostDashboard(ctx, profileGroups, args, opts)
+		for _, r := range results {
+			if r.Err != nil {
+				addErr(r.Profile, r.Err)
+			}
+		}
+		result.Data = results
+
+	case types.ReportAudit:
+		// Sem console (serve), os erros das verificações só chegam ao cliente por result.Errors.
+		runs := uc.collectAuditRuns(ctx, profileGroups, args, opts, true)
+		audits := make([]entity.AuditData, 0, len(runs))
+		for _, run := range runs {
+			for _, id := range slices.Sorted(maps.Keys(run.CheckErrs)) {
+				addErr(run.Profile, fmt.Errorf("check %s: %w", id, run.CheckErrs[id]))
+			}
+			audits = append(audits, newAuditData(run, opts))
+		}
+		result.Data = audits
+
+	case types.ReportTrend:
+		trends := make([]entity.CostTrend, 0, len(profileGroups))
+		for _, r := range uc.collectTrends(ctx, profileGroups, args, opts) {
+			if r.Err != nil {
+				addErr(r.Profile, r.Err)
+				continue
+			}
+			trends = append(trends, r.Trend)
+		}
+		result.Data = trends
+
+	case types.ReportTransfer:
+		reports := make([]entity.DataTransferReport, 0, len(profileGroups))
+		for _, r := range uc.collectTransferReports(ctx, profileGroups, args, opts) {
+			if r.Err != nil {
+				addErr(r.Profile, r.Err)
+				continue
+			}
+			reports = append(reports, r.Report)
+		}
+		result.Data = reports
+
+	case types.ReportLogs:
+		audits := make([]entity.CloudWatchLogsAudit, 0, len(profileGroups))
+		for _, r := range uc.collectLogsAudits(ctx, profileGroups, args) {
+			if r.Err != nil {
+				addErr(r.Profile, r.Err)
+				continue
+			}
+			audits = append(audits, r.Audit)
+		}
+		result.Data = audits
+
+	case types.ReportS3:
+		audits := make([]entity.S3LifecycleAudit, 0, len(profileGroups))
+		for _, r := range uc.collectS3Audits(ctx, profileGroups, args) {
+			if r.Err != nil {
+				addErr(r.Profile, r.Err)
+				continue
+			}
+			audits = append(audits, r.Audit)
+		}
+		result.Data = audits
+
+	case types.ReportCommitments:
+		reports := make([]entity.CommitmentsReport, 0, len(profileGroups))
+		for _, r := range uc.collectCommitmentsReports(ctx, profileGroups, args, opts, true) {
+			if r.Err != nil {
+				addErr(r.Profile, r.Err)
+				continue
+			}
+			if r.RecommendationsErr != nil {
+				addErr(r.Profile, fmt.Errorf("purchase recommendations: %w", r.RecommendationsErr))
+			}
+			reports = append(reports, r.Report)
+		}
+		result.Data = reports
+
+	case types.ReportExpirations:
+		reports := make([]entity.CommitmentExpirationReport, 0, len(profileGroups))
+		for _, r := range uc.collectExpirationReports(ctx, profileGroups, args.Regions, opts) {
+			if r.Err != nil {
+				addErr(r.Profile, r.Err)
+				continue
+			}
+			reports = append(reports, r.Report)
+		}
+		result.Data = reports
+
+	case types.ReportAnomalies:
+		reports := make([]entity.AnomalyReport, 0, len(profileGroups))
+		for _, r := range uc.collectAnomalyReports(ctx, profileGroups, args, opts) {
+			if r.Err != nil {
+				addErr(r.Profile, r.Err)
+				continue
+			}
+			reports = append(reports, r.Report)
+		}
+		result.Data = reports
+
+	case types.ReportRightsizing:
+		reports := make([]entity.RightsizingReport, 0, len(profileGroups))
+		for _, r := range uc.collectRightsizingReports(ctx, profileGroups, args) {
+			if r.Err != nil {
+				addErr(r.Profile, r.Err)
+				continue
+			}
+			reports = append(reports, r.Report)
+		}
+		result.Data = reports
+
+	case types.ReportFullAudit:
+		reports := make([]entity.FullAuditReport, 0, len(profileGroups))
+		for _, r := range uc.collectFullAuditReports(ctx, profileGroups, args, opts) {
+			if r.Err != nil {
+				addErr(r.Profile, r.Err)
+				continue
+			}
+			for _, id := range slices.Sorted(maps.Keys(r.CheckErrs)) {
+				addErr(r.Profile, fmt.Errorf("check %s: %w", id, r.CheckErrs[id]))
+			}
+			reports = append(reports, r.Report)
+		}
+		result.Data = reports
+
+	default:
+		return nil, types.ErrUnknownReport
+	}
+
+	return result, nil
+}
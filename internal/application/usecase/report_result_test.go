@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+)
+
+func TestGenerateReportCheckErrors(t *testing.T) {
+	for _, report := range []types.ReportKind{types.ReportAudit, types.ReportFullAudit} {
+		t.Run(string(report), func(t *testing.T) {
+			repo := newTestRepo()
+			repo.Errors = map[string]error{"GetUnusedVolumes": errors.New("throttled")}
+			uc, _ := newTestUseCase(repo)
+
+			args := newTestArgs(report)
+			args.Checks = []string{entity.CheckUnusedVolume}
+			result, err := uc.GenerateReport(context.Background(), args)
+			if err != nil {
+				t.Fatalf("GenerateReport: %v", err)
+			}
+			want := ProfileError{Profile: "default", Error: "check unused-volume: throttled"}
+			if len(result.Errors) != 1 || result.Errors[0] != want {
+				t.Errorf("expected %+v, got %+v", want, result.Errors)
+			}
+		})
+	}
+}
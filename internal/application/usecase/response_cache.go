@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+// defaultCacheTTL é a validade padrão das respostas do Cost Explorer no cache.
+const defaultCacheTTL = time.Hour
+
+// cacheDir retorna o diretório do cache: --cache-dir ou ~/.aws-finops/cache.
+func cacheDir(args *types.CLIArgs) (string, error) {
+	if args.CacheDir != "" {
+		return args.CacheDir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("could not find the home directory for the response cache (set --cache-dir): %w", err)
+	}
+	return filepath.Join(home, ".aws-finops", "cache"), nil
+}
+
+// enableResponseCache liga o cache em disco das respostas do Cost Explorer, a menos que --no-cache
+// tenha sido usado.
+func (uc *DashboardUseCase) enableResponseCache(args *types.CLIArgs) error {
+	if args.CacheTTL < 0 {
+		return fmt.Errorf("%w: %s must not be negative", types.ErrInvalidCacheTTL, args.CacheTTL)
+	}
+	if args.CacheTTL == 0 {
+		args.CacheTTL = defaultCacheTTL
+	}
+	if args.NoCache {
+		return nil
+	}
+	dir, err := cacheDir(args)
+	if err != nil {
+		return err
+	}
+	uc.awsRepo.EnableResponseCache(entity.ResponseCacheOptions{Dir: dir, TTL: args.CacheTTL})
+	return nil
+}
+
+// reportResponseCache exibe, no modo --verbose, quantas chamadas do Cost Explorer o cache evitou.
+func (uc *DashboardUseCase) reportResponseCache(args *types.CLIArgs) {
+	if args.NoCache {
+		uc.console.Println(pterm.FgGray.Sprint("Cost Explorer response cache disabled (--no-cache)."))
+		return
+	}
+	stats := uc.awsRepo.ResponseCacheStats()
+	dir, _ := cacheDir(args)
+	uc.console.Println(pterm.FgGray.Sprintf("Cost Explorer response cache: %d hits, %d requests sent (~$%.2f saved), TTL %s, in %s.",
+		stats.Hits, stats.Misses, stats.SavedCost(), args.CacheTTL, dir))
+	for _, op := range stats.Operations() {
+		uc.console.Println(pterm.FgGray.Sprintf("  %s: %d hits", op, stats.HitsByOperation[op]))
+	}
+}
+
+// ClearCache removes every Cost Explorer response from the on-disk cache.
+func (uc *DashboardUseCase) ClearCache(args *types.CLIArgs) error {
+	if err := uc.mergeConfig(args); err != nil {
+		return fmt.Errorf("failed to process configuration: %w", err)
+	}
+	dir, err := cacheDir(args)
+	if err != nil {
+		return err
+	}
+	removed, err := uc.awsRepo.ClearResponseCache(dir)
+	if err != nil {
+		return err
+	}
+	uc.console.LogSuccess("Removed %d cached Cost Explorer responses from %s.", removed, dir)
+	return nil
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+// rightsizingRow é o relatório de rightsizing de EC2 de um grupo de perfis.
+type rightsizingRow struct {
+	Profile string
+	Report  entity.RightsizingReport
+	Err     error
+}
+
+func (uc *DashboardUseCase) runRightsizingReport(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) error {
+	uc.console.LogInfo("Fetching EC2 rightsizing recommendations...")
+
+	results := uc.collectRightsizingReports(ctx, profileGroups, args)
+
+	table := uc.console.CreateTable()
+	table.AddColumn("Profile")
+	table.AddColumn("Account ID")
+	table.AddColumn("Terminate")
+	table.AddColumn("Modify")
+	table.AddColumn("Est. Monthly Savings")
+	table.AddColumn("Top Recommendations (Instance | Region | Current → Target | Max CPU/Mem | Savings)")
+
+	for _, r := range results {
+		if r.Err != nil {
+			table.AddRow(
+				pterm.FgMagenta.Sprint(r.Profile),
+				"N/A",
+				"N/A",
+				"N/A",
+				"N/A",
+				pterm.FgRed.Sprintf("Error: %v", r.Err),
+			)
+			continue
+		}
+
+		rep := r.Report
+		lines := []string{pterm.FgGreen.Sprint("No recommendations")}
+		if len(rep.Recommendations) > 0 {
+			lines = lines[:0]
+			limit := len(rep.Recommendations)
+			if limit > 10 {
+				limit = 10
+			}
+			for _, rec := range rep.Recommendations[:limit] {
+				lines = append(lines, formatRightsizingLine(rec))
+			}
+			if len(rep.Recommendations) > limit {
+				lines = append(lines, fmt.Sprintf("... (+%d more)", len(rep.Recommendations)-limit))
+			}
+		}
+
+		savings := pterm.FgGreen.Sprint("$0.00")
+		if rep.TotalSavings > 0 {
+			savings = pterm.FgYellow.Sprintf("$%.2f", rep.TotalSavings)
+		}
+
+		table.AddRow(
+			pterm.FgMagenta.Sprint(rep.Profile),
+			rep.AccountID,
+			fmt.Sprint(rep.Count(entity.RightsizingTerminate)),
+			fmt.Sprint(rep.Count(entity.RightsizingModify)),
+			savings,
+			strings.Join(lines, "\n"),
+		)
+	}
+	uc.console.Println("\n" + table.Render())
+
+	if args.ReportName != "" {
+		uc.console.LogInfo("Exporting rightsizing reports...")
+		reports := make([]entity.RightsizingReport, 0, len(results))
+		for _, r := range results {
+			if r.Err == nil {
+				reports = append(reports, r.Report)
+			}
+		}
+		for _, reportType := range args.ReportType {
+			switch strings.ToLower(reportType) {
+			case "csv":
+				path, err := uc.exportRepo.ExportRightsizingReportToCSV(reports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export rightsizing CSV: %v", err)
+				} else {
+					uc.console.LogSuccess("Rightsizing CSV saved to: %s", path)
+				}
+			case "json":
+				path, err := uc.exportRepo.ExportRightsizingReportToJSON(reports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export rightsizing JSON: %v", err)
+				} else {
+					uc.console.LogSuccess("Rightsizing JSON saved to: %s", path)
+				}
+			case "pdf":
+				path, err := uc.exportRepo.ExportRightsizingReportToPDF(reports, args.ReportName, args.Dir)
+				if err != nil {
+					uc.console.LogError("Failed to export rightsizing PDF: %v", err)
+				} else {
+					uc.console.LogSuccess("Rightsizing PDF saved to: %s", path)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatRightsizingLine formata uma recomendação como "instância | região | atual → alvo | CPU/memória | economia".
+func formatRightsizingLine(rec entity.RightsizingRecommendation) string {
+	instance := rec.InstanceID
+	if rec.InstanceName != "" {
+		instance = fmt.Sprintf("%s (%s)", rec.InstanceName, rec.InstanceID)
+	}
+	change := fmt.Sprintf("%s → %s", rec.CurrentType, rec.TargetType)
+	if rec.Action == entity.RightsizingTerminate {
+		change = fmt.Sprintf("%s → %s", rec.CurrentType, pterm.FgRed.Sprint("terminate"))
+	}
+	return fmt.Sprintf("%s | %s | %s | %s/%s | %s", instance, rec.Region, change,
+		formatUtilization(rec.MaxCPUUtilization), formatUtilization(rec.MaxMemoryUtilization),
+		pterm.FgYellow.Sprintf("$%.2f", rec.EstimatedMonthlySavings))
+}
+
+func formatUtilization(v *float64) string {
+	if v == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", *v)
+}
+
+// collectRightsizingReports busca as recomendações de rightsizing de cada grupo de perfis, ordenadas por perfil.
+func (uc *DashboardUseCase) collectRightsizingReports(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs) []rightsizingRow {
+	livePrinter, _ := uc.console.GetMultiPrinter().Start()
+	defer livePrinter.Stop()
+
+	results := make([]rightsizingRow, 0, len(profileGroups))
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	for _, group := range profileGroups {
+		wg.Add(1)
+		go func(g entity.ProfileGroup) {
+			defer wg.Done()
+
+			bar := uc.console.NewProgressbar(1, fmt.Sprintf("Rightsizing: %s", g.Identifier))
+			bar.Start()
+
+			profile := g.Profiles[0]
+			accountID, _ := uc.awsRepo.GetAccountID(ctx, profile)
+			recommendations, err := uc.awsRepo.GetRightsizingRecommendations(ctx, profile, args.Regions, args.Tag)
+			bar.Increment()
+
+			row := rightsizingRow{Profile: g.Identifier, Err: err}
+			if err == nil {
+				report := entity.RightsizingReport{Profile: g.Identifier, AccountID: accountID, Recommendations: recommendations}
+				for _, rec := range recommendations {
+					report.TotalSavings += rec.EstimatedMonthlySavings
+				}
+				row.Report = report
+			}
+
+			mu.Lock()
+			results = append(results, row)
+			mu.Unlock()
+		}(group)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Profile < results[j].Profile })
+	return results
+}
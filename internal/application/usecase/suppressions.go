@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+)
+
+// resolveSuppressions carrega o arquivo de --suppressions nas opções do relatório. As supressões vencidas
+// são avisadas e descartadas, de modo que os achados que cobriam voltam a aparecer.
+func (uc *DashboardUseCase) resolveSuppressions(args *types.CLIArgs, opts *reportOptions, now time.Time) error {
+	if args.Suppressions == "" {
+		return nil
+	}
+	suppressions, err := uc.configRepo.LoadSuppressions(args.Suppressions)
+	if err != nil {
+		return err
+	}
+
+	registered := make(map[string]bool, len(checkRegistry))
+	for _, c := range checkRegistry {
+		registered[c.ID()] = true
+	}
+
+	active := make([]entity.Suppression, 0, len(suppressions))
+	for i, s := range suppressions {
+		if s.Check != "" && !registered[s.Check] {
+			return fmt.Errorf("%s: suppression #%d: %w: unknown check %q (see 'aws-finops checks list')", args.Suppressions, i+1, entity.ErrInvalidSuppression, s.Check)
+		}
+		if s.Expired(now) {
+			uc.console.LogWarning("Suppression #%d (%s) expired on %s; its findings are reported again", i+1, s.Reason, s.Expires)
+			continue
+		}
+		active = append(active, s)
+	}
+	opts.Suppressions = active
+	return nil
+}
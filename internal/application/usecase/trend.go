@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+// trendRow é o histórico de custos de um grupo de perfis.
+type trendRow struct {
+	Profile    string
+	IsCombined bool
+	Trend      entity.CostTrend
+	Err        error
+}
+
+func (uc *DashboardUseCase) runTrendAnalysis(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) error {
+	uc.console.LogInfo("Analysing %s cost trends from %s to %s (%s cost)...", opts.Granularity, opts.TrendPeriod.StartDate(),
+		opts.TrendPeriod.End.AddDate(0, 0, -1).Format("2006-01-02"), strings.ToLower(opts.Metric.Label()))
+	if len(opts.Exclude) > 0 {
+		uc.console.LogInfo("Excluding record types: %s", entity.RecordTypesLabel(opts.Exclude))
+	}
+
+	results := uc.collectTrends(ctx, profileGroups, args, opts)
+
+	for _, r := range results {
+		if r.Err != nil {
+			uc.console.LogError("Error getting trend for %s: %v", r.Profile, r.Err)
+			continue
+		}
+		if len(r.Trend.Points) == 0 {
+			uc.console.LogWarning("No trend data available for %s", r.Profile)
+			continue
+		}
+
+		var title string
+		if r.IsCombined {
+			title = fmt.Sprintf("Account: %s (Profiles: %s)", r.Trend.AccountID, r.Profile)
+		} else {
+			title = fmt.Sprintf("Account: %s (Profile: %s)", r.Trend.AccountID, r.Profile)
+		}
+		uc.console.Println("\n" + pterm.FgYellow.Sprint(title))
+		uc.displayTrend(r.Trend)
+	}
+
+	if args.ReportName != "" {
+		uc.exportTrendReports(results, args)
+	}
+
+	snapshot := newSnapshot(args, opts, opts.TrendPeriod)
+	for _, r := range results {
+		if r.Err == nil {
+			snapshot.Trends = append(snapshot.Trends, r.Trend)
+		}
+	}
+	uc.saveSnapshot(args, snapshot)
+
+	return nil
+}
+
+// renderTrendSeries monta a tabela das séries da tendência, com o total, a participação e a
+// variação entre o primeiro e o último ponto de cada série.
+func (uc *DashboardUseCase) renderTrendSeries(trend entity.CostTrend) string {
+	unit := trend.Granularity.Unit()
+	first, last := trend.Points[0], trend.Points[len(trend.Points)-1]
+
+	table := uc.console.CreateTable()
+	table.AddColumn(trend.SeriesBy.Label())
+	table.AddColumn("Total")
+	table.AddColumn("Share")
+	table.AddColumn(fmt.Sprintf("First %s (%s)", unit, first.Label))
+	table.AddColumn(fmt.Sprintf("Last %s (%s)", unit, last.Label))
+	table.AddColumn("Change")
+
+	total := trend.Total()
+	for i, name := range trend.Series {
+		seriesTotal := trend.SeriesTotal(i)
+		share := 0.0
+		if total != 0 {
+			share = seriesTotal / total * 100
+		}
+		table.AddRow(
+			name,
+			fmt.Sprintf("$%.2f", seriesTotal),
+			fmt.Sprintf("%.1f%%", share),
+			fmt.Sprintf("$%.2f", first.SeriesCosts[i]),
+			fmt.Sprintf("$%.2f", last.SeriesCosts[i]),
+			formatTrendChange(first.SeriesCosts[i], last.SeriesCosts[i]),
+		)
+	}
+	return table.Render()
+}
+
+func formatTrendChange(from, to float64) string {
+	if from <= 0.01 {
+		return pterm.FgGray.Sprint("N/A")
+	}
+	change := (to - from) / from * 100
+	switch {
+	case change > 0.01:
+		return pterm.FgRed.Sprintf("+%.2f%%", change)
+	case change < -0.01:
+		return pterm.FgGreen.Sprintf("%.2f%%", change)
+	default:
+		return pterm.FgYellow.Sprint("0.00%")
+	}
+}
+
+func (uc *DashboardUseCase) exportTrendReports(results []trendRow, args *types.CLIArgs) {
+	uc.console.LogInfo("Exporting trend reports...")
+	trends := make([]entity.CostTrend, 0, len(results))
+	for _, r := range results {
+		if r.Err == nil {
+			trends = append(trends, r.Trend)
+		}
+	}
+	for _, reportType := range args.ReportType {
+		switch strings.ToLower(reportType) {
+		case "csv":
+			path, err := uc.exportRepo.ExportTrendReportToCSV(trends, args.ReportName, args.Dir)
+			if err != nil {
+				uc.console.LogError("Failed to export trend CSV: %v", err)
+			} else {
+				uc.console.LogSuccess("Trend CSV saved to: %s", path)
+			}
+		case "json":
+			path, err := uc.exportRepo.ExportTrendReportToJSON(trends, args.ReportName, args.Dir)
+			if err != nil {
+				uc.console.LogError("Failed to export trend JSON: %v", err)
+			} else {
+				uc.console.LogSuccess("Trend JSON saved to: %s", path)
+			}
+		case "pdf":
+			path, err := uc.exportRepo.ExportTrendReportToPDF(trends, args.ReportName, args.Dir)
+			if err != nil {
+				uc.console.LogError("Failed to export trend PDF: %v", err)
+			} else {
+				uc.console.LogSuccess("Trend PDF saved to: %s", path)
+			}
+		}
+	}
+}
+
+// collectTrends obtém o histórico de custos de cada grupo de perfis.
+func (uc *DashboardUseCase) collectTrends(ctx context.Context, profileGroups []entity.ProfileGroup, args *types.CLIArgs, opts reportOptions) []trendRow {
+	status := uc.console.Status("Fetching trend data...")
+	defer status.Stop()
+
+	results := make([]trendRow, 0, len(profileGroups))
+	for _, group := range profileGroups {
+		status.Update(fmt.Sprintf("Fetching trend for %s...", group.Identifier))
+		profileForAPI := group.Profiles[0] // Usa o primeiro perfil para a chamada de API
+
+		row := trendRow{Profile: group.Identifier, IsCombined: group.IsCombined}
+		trend, err := uc.awsRepo.GetTrendData(ctx, profileForAPI, opts.TrendPeriod, opts.Granularity, opts.Metric, opts.TrendSeries, args.Tag, opts.Exclude)
+		if err != nil {
+			row.Err = err
+			results = append(results, row)
+			continue
+		}
+
+		trend.Profile = group.Identifier
+		row.Trend = trend
+		results = append(results, row)
+	}
+
+	return results
+}
@@ -0,0 +1,155 @@
+package entity
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Origens possíveis de uma anomalia de custo.
+const (
+	AnomalySourceCostExplorer = "cost_explorer"
+	AnomalySourceLocal        = "local"
+)
+
+// Parâmetros do detector local de anomalias.
+const (
+	// AnomalyBaselineDays é a janela de dias anteriores usada como referência para cada dia.
+	AnomalyBaselineDays = 14
+	// AnomalyZScoreThreshold é o z-score mínimo para um dia ser considerado anômalo.
+	AnomalyZScoreThreshold = 3.0
+	// AnomalyMinImpact é o impacto mínimo, em dólares, para reportar um dia anômalo.
+	AnomalyMinImpact = 1.0
+)
+
+// AnomalyRootCause is one combination of service, account, region and usage type behind an anomaly.
+type AnomalyRootCause struct {
+	Service     string  `json:"service,omitempty"`
+	AccountID   string  `json:"account_id,omitempty"`
+	AccountName string  `json:"account_name,omitempty"`
+	Region      string  `json:"region,omitempty"`
+	UsageType   string  `json:"usage_type,omitempty"`
+	Impact      float64 `json:"impact"`
+}
+
+// CostAnomaly is an unusual cost pattern, reported by an AWS anomaly monitor or by the local detector.
+type CostAnomaly struct {
+	ID        string    `json:"id,omitempty"`
+	Source    string    `json:"source"`
+	Monitor   string    `json:"monitor,omitempty"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+
+	// Service, AccountID e Region identificam onde a anomalia ocorreu (a principal causa raiz).
+	Service   string `json:"service,omitempty"`
+	AccountID string `json:"account_id,omitempty"`
+	Region    string `json:"region,omitempty"`
+
+	// Impact é o custo acima do esperado no intervalo da anomalia.
+	Impact        float64 `json:"impact"`
+	ActualSpend   float64 `json:"actual_spend,omitempty"`
+	ExpectedSpend float64 `json:"expected_spend,omitempty"`
+	ImpactPercent float64 `json:"impact_percent,omitempty"`
+
+	// Score é o score máximo do monitor da AWS ou o maior z-score do detector local.
+	Score      float64            `json:"score"`
+	RootCauses []AnomalyRootCause `json:"root_causes,omitempty"`
+}
+
+// AnomalyReport lists the cost anomalies of a profile in a period.
+type AnomalyReport struct {
+	Profile     string    `json:"profile"`
+	AccountID   string    `json:"account_id"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+
+	// Monitors são os monitores de anomalia do Cost Explorer configurados na conta.
+	Monitors []string `json:"monitors,omitempty"`
+
+	// LocalDetection indica que o detector local foi executado (conta sem monitores).
+	LocalDetection bool          `json:"local_detection"`
+	Anomalies      []CostAnomaly `json:"anomalies"`
+	TotalImpact    float64       `json:"total_impact"`
+}
+
+// AnomalyMonitor is a Cost Explorer anomaly monitor.
+type AnomalyMonitor struct {
+	ARN  string `json:"arn"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// DailyCost is the cost of a single day.
+type DailyCost struct {
+	Date time.Time `json:"date"`
+	Cost float64   `json:"cost"`
+}
+
+// ServiceDailyCosts is the daily cost series of a service, ordered by date.
+type ServiceDailyCosts struct {
+	Service string      `json:"service"`
+	Costs   []DailyCost `json:"costs"`
+}
+
+// DetectCostAnomalies runs a z-score detector over the daily costs of each service.
+// Cada dia a partir de `from` é comparado com a média e o desvio padrão dos
+// AnomalyBaselineDays dias anteriores; dias anômalos consecutivos viram uma única anomalia.
+func DetectCostAnomalies(series []ServiceDailyCosts, from time.Time) []CostAnomaly {
+	var anomalies []CostAnomaly
+	for _, s := range series {
+		var current *CostAnomaly
+		flush := func() {
+			if current != nil {
+				if current.ExpectedSpend > 0 {
+					current.ImpactPercent = current.Impact / current.ExpectedSpend * 100
+				}
+				anomalies = append(anomalies, *current)
+				current = nil
+			}
+		}
+
+		for i, day := range s.Costs {
+			if day.Date.Before(from) || i < AnomalyBaselineDays {
+				continue
+			}
+			mean, stddev := meanStdDev(s.Costs[i-AnomalyBaselineDays : i])
+			impact := day.Cost - mean
+			// Séries constantes não têm desvio; qualquer salto relevante é anômalo.
+			z := math.Inf(1)
+			if stddev > 0 {
+				z = impact / stddev
+			}
+			if impact < AnomalyMinImpact || z < AnomalyZScoreThreshold {
+				flush()
+				continue
+			}
+			if current == nil {
+				current = &CostAnomaly{Source: AnomalySourceLocal, Service: s.Service, StartDate: day.Date}
+			}
+			current.EndDate = day.Date
+			current.Impact += impact
+			current.ActualSpend += day.Cost
+			current.ExpectedSpend += mean
+			if !math.IsInf(z, 1) {
+				current.Score = math.Max(current.Score, z)
+			}
+		}
+		flush()
+	}
+
+	sort.Slice(anomalies, func(i, j int) bool { return anomalies[i].Impact > anomalies[j].Impact })
+	return anomalies
+}
+
+func meanStdDev(costs []DailyCost) (float64, float64) {
+	var sum float64
+	for _, c := range costs {
+		sum += c.Cost
+	}
+	mean := sum / float64(len(costs))
+	var variance float64
+	for _, c := range costs {
+		variance += (c.Cost - mean) * (c.Cost - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(costs)))
+}
@@ -2,14 +2,30 @@ package entity
 
 // AuditData represents the audit information for a specific AWS profile.
 type AuditData struct {
-	Profile            string `json:"profile"`
-	AccountID          string `json:"account_id"`
-	UntaggedResources  string `json:"untagged_resources,omitempty"` // Opcional por enquanto
-	StoppedInstances   string `json:"stopped_instances"`
-	UnusedVolumes      string `json:"unused_volumes"`
-	UnusedEIPs         string `json:"unused_eips"`
-	IdleLoadBalancers  string `json:"idle_load_balancers"`
-	NatGatewayCosts    string `json:"nat_gateway_costs"`
-	UnusedVpcEndpoints string `json:"unused_vpc_endpoints"`
-	BudgetAlerts       string `json:"budget_alerts"`
+	Profile   string `json:"profile"`
+	AccountID string `json:"account_id"`
+	// Checks são os IDs das verificações executadas, na ordem do relatório.
+	Checks []string `json:"checks"`
+	// Findings são os recursos apontados pelas verificações, ordenados por verificação, região e ID.
+	Findings []Finding `json:"findings"`
+	// Suppressed são os achados cobertos pelo arquivo de supressões; ficam fora das contagens e da economia.
+	Suppressed []SuppressedFinding `json:"suppressed,omitempty"`
+	// Resolved são os achados da auditoria de --baseline que não aparecem mais.
+	Resolved []Finding `json:"resolved,omitempty"`
+	// Baseline resume a comparação com --baseline; nil sem base.
+	Baseline *BaselineSummary `json:"baseline,omitempty"`
+
+	// Metric é a métrica de custo usada nos custos de NAT Gateway.
+	Metric CostMetric `json:"metric,omitempty"`
+
+	// PotentialSavings é o custo mensal estimado dos recursos ociosos; nil quando os preços não
+	// puderam ser obtidos.
+	PotentialSavings *SavingsEstimate `json:"potential_monthly_savings,omitempty"`
+	// SavingsError é o motivo de PotentialSavings ser nil quando a consulta de preços falhou.
+	SavingsError string `json:"potential_monthly_savings_error,omitempty"`
+}
+
+// Count returns the number of findings of a check.
+func (a AuditData) Count(checkID string) int {
+	return len(FilterFindings(a.Findings, checkID))
 }
@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+)
+
+// ErrInvalidBaseline is returned when a baseline file is not an audit or full-audit JSON export.
+var ErrInvalidBaseline = errors.New("invalid baseline")
+
+// BaselineStatus compares a finding with the same finding in a previous audit (--baseline).
+type BaselineStatus string
+
+const (
+	BaselineNew       BaselineStatus = "new"
+	BaselineUnchanged BaselineStatus = "unchanged"
+	BaselineResolved  BaselineStatus = "resolved"
+)
+
+// BaselineSummary counts the findings of an audit by baseline status.
+type BaselineSummary struct {
+	// Source é o arquivo da auditoria usada como base.
+	Source    string `json:"source"`
+	New       int    `json:"new"`
+	Unchanged int    `json:"unchanged"`
+	Resolved  int    `json:"resolved"`
+}
+
+// AuditBaseline indexes the findings of a previous audit by account.
+type AuditBaseline struct {
+	source string
+	// accounts guarda, por conta, os achados anteriores pela chave de findingKey.
+	accounts map[string]map[string]Finding
+}
+
+// NewAuditBaseline indexes the findings of previously exported audits; source names the export file.
+func NewAuditBaseline(source string, audits []AuditData) *AuditBaseline {
+	b := &AuditBaseline{source: source, accounts: make(map[string]map[string]Finding)}
+	for _, a := range audits {
+		findings := b.accounts[a.AccountID]
+		if findings == nil {
+			findings = make(map[string]Finding)
+			b.accounts[a.AccountID] = findings
+		}
+		for _, f := range a.Findings {
+			findings[findingKey(f)] = f
+		}
+	}
+	return b
+}
+
+// Compare marks each finding of the audit as new or unchanged, fills Resolved with the previous findings
+// that are gone and summarizes the comparison. Um achado só é dado como resolvido se a verificação dele
+// rodou de novo e ele não está entre os suprimidos; contas ausentes da base têm todos os achados como novos.
+func (b *AuditBaseline) Compare(a *AuditData) {
+	summary := &BaselineSummary{Source: b.source}
+	previous := b.accounts[a.AccountID]
+	current := make(map[string]bool, len(a.Findings))
+	for i := range a.Findings {
+		key := findingKey(a.Findings[i])
+		current[key] = true
+		if _, ok := previous[key]; ok {
+			a.Findings[i].Baseline = BaselineUnchanged
+			summary.Unchanged++
+		} else {
+			a.Findings[i].Baseline = BaselineNew
+			summary.New++
+		}
+	}
+
+	// Os suprimidos continuam existindo: não são novos nem resolvidos.
+	for _, f := range a.Suppressed {
+		current[findingKey(f.Finding)] = true
+	}
+
+	a.Resolved = nil
+	for key, f := range previous {
+		if current[key] || !slices.Contains(a.Checks, f.CheckID) {
+			continue
+		}
+		f.Baseline = BaselineResolved
+		a.Resolved = append(a.Resolved, f)
+	}
+	SortFindings(a.Resolved)
+	summary.Resolved = len(a.Resolved)
+	a.Baseline = summary
+}
+
+// OnlyNew keeps only the findings that are new since the baseline; o resumo continua contando os
+// inalterados e os resolvidos.
+func (a *AuditData) OnlyNew() {
+	kept := make([]Finding, 0, len(a.Findings))
+	for _, f := range a.Findings {
+		if f.Baseline == BaselineNew {
+			kept = append(kept, f)
+		}
+	}
+	a.Findings, a.Resolved = kept, nil
+}
+
+// findingKey identifica um recurso de uma verificação entre execuções da auditoria.
+func findingKey(f Finding) string {
+	return f.CheckID + "|" + f.Region + "|" + f.ResourceID
+}
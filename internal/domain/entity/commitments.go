@@ -1,6 +1,10 @@
 package entity
 
-import "time"
+import (
+	"errors"
+	"sort"
+	"time"
+)
 
 // ServiceCoverage representa a cobertura por serviço (% e custos relacionados).
 type ServiceCoverage struct {
@@ -48,4 +52,72 @@ type CommitmentsReport struct {
 	SPSummary  SPSummary `json:"sp_summary"`
 	RISummary  RISummary `json:"ri_summary"`
 	PeriodName string    `json:"period_name"`
+	// Recommendations fica nil quando as recomendações de compra não puderam ser obtidas.
+	Recommendations *PurchaseRecommendations `json:"purchase_recommendations,omitempty"`
+}
+
+// ErrInvalidExpirationWindow is returned when the expiration warning window is negative.
+var ErrInvalidExpirationWindow = errors.New("invalid expiration warning window")
+
+// DefaultExpirationWarningDays é a janela padrão, em dias, para destacar compromissos perto do fim.
+const DefaultExpirationWarningDays = 30
+
+// CommitmentExpiration is an active Savings Plan or reservation and its end date.
+type CommitmentExpiration struct {
+	// Kind é PurchaseSavingsPlans ou PurchaseReservedInstances.
+	Kind string `json:"kind"`
+	// ID é o ARN do Savings Plan ou o ID da assinatura da reserva.
+	ID        string `json:"id"`
+	AccountID string `json:"account_id,omitempty"`
+	// Type é o tipo de Savings Plan (ex: "Compute") ou o serviço da reserva (ex: "Amazon RDS").
+	Type string `json:"type"`
+	// Details descreve o compromisso: família ou tipo de instância, região, forma de pagamento...
+	Details  string `json:"details,omitempty"`
+	Quantity int    `json:"quantity,omitempty"`
+	// HourlyCommitment é o compromisso por hora dos Savings Plans; zero nas reservas.
+	HourlyCommitment float64   `json:"hourly_commitment,omitempty"`
+	Start            time.Time `json:"start"`
+	End              time.Time `json:"end"`
+
+	DaysRemaining int  `json:"days_remaining"`
+	ExpiringSoon  bool `json:"expiring_soon"`
+}
+
+// CommitmentExpirationReport lists the active Savings Plans and reservations of a profile by end date.
+type CommitmentExpirationReport struct {
+	Profile     string                 `json:"profile"`
+	AccountID   string                 `json:"account_id"`
+	WarningDays int                    `json:"warning_days"`
+	Commitments []CommitmentExpiration `json:"commitments"`
+}
+
+// NewCommitmentExpirationReport sorts the commitments by end date, drops those already ended, flags
+// the ones ending within warningDays of now and assigns accountID to those without an account.
+func NewCommitmentExpirationReport(profile, accountID string, commitments []CommitmentExpiration, now time.Time, warningDays int) CommitmentExpirationReport {
+	active := make([]CommitmentExpiration, 0, len(commitments))
+	for _, c := range commitments {
+		if !c.End.After(now) {
+			continue
+		}
+		// As APIs de Savings Plans e de reservas listam só os compromissos da própria conta.
+		if c.AccountID == "" {
+			c.AccountID = accountID
+		}
+		c.DaysRemaining = int(c.End.Sub(now).Hours() / 24)
+		c.ExpiringSoon = c.DaysRemaining <= warningDays
+		active = append(active, c)
+	}
+	sort.SliceStable(active, func(i, j int) bool { return active[i].End.Before(active[j].End) })
+	return CommitmentExpirationReport{Profile: profile, AccountID: accountID, WarningDays: warningDays, Commitments: active}
+}
+
+// ExpiringSoon returns the number of commitments ending within the warning window.
+func (r CommitmentExpirationReport) ExpiringSoon() int {
+	n := 0
+	for _, c := range r.Commitments {
+		if c.ExpiringSoon {
+			n++
+		}
+	}
+	return n
 }
@@ -18,12 +18,16 @@ type CostData struct {
 	Budgets                   []BudgetInfo  `json:"budgets"`
 	CurrentPeriodName         string        `json:"current_period_name"`
 	PreviousPeriodName        string        `json:"previous_period_name"`
-	TimeRange                 int           `json:"time_range,omitempty"`
+	TimeRange                 int           `json:"time_range,omitempty"` // dias de --time-range; 0 nos demais períodos
+	Metric                    CostMetric    `json:"metric"`
+	GroupBy                   Grouping      `json:"group_by,omitempty"`
 	CurrentPeriodStart        time.Time     `json:"current_period_start"`
 	CurrentPeriodEnd          time.Time     `json:"current_period_end"`
 	PreviousPeriodStart       time.Time     `json:"previous_period_start"`
 	PreviousPeriodEnd         time.Time     `json:"previous_period_end"`
 	MonthlyCosts              []MonthlyCost `json:"monthly_costs,omitempty"`
+	// ExcludedCosts são os valores dos tipos de registro removidos dos totais por --exclude.
+	ExcludedCosts []ServiceCost `json:"excluded_costs,omitempty"`
 }
 
 // MonthlyCost represents the cost for a specific month, used for trend analysis.
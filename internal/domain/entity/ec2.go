@@ -3,18 +3,59 @@ package entity
 // EC2Summary is a map of instance state names to instance counts.
 type EC2Summary map[string]int
 
+// EBSVolume is an EBS volume with the attributes that determine its price.
+type EBSVolume struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	SizeGiB int32  `json:"size_gib"`
+	// IOPS e ThroughputMiBps são os valores informados pelo EC2; só gp3, io1 e io2 cobram por eles.
+	IOPS            int32             `json:"iops,omitempty"`
+	ThroughputMiBps int32             `json:"throughput_mibps,omitempty"`
+	Tags            map[string]string `json:"tags,omitempty"`
+}
+
+// StoppedInstance is a stopped EC2 instance and the EBS volumes still attached to it.
+type StoppedInstance struct {
+	ID           string            `json:"id"`
+	InstanceType string            `json:"instance_type"`
+	Volumes      []EBSVolume       `json:"volumes,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+}
+
+// ElasticIP is an Elastic IP address.
+type ElasticIP struct {
+	PublicIP     string            `json:"public_ip"`
+	AllocationID string            `json:"allocation_id"`
+	Tags         map[string]string `json:"tags,omitempty"`
+}
+
+// LoadBalancer is a load balancer and its type (application, network, gateway or classic).
+type LoadBalancer struct {
+	Name string            `json:"name"`
+	ARN  string            `json:"arn"`
+	Type string            `json:"type"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// VpcEndpoint is an interface VPC endpoint.
+type VpcEndpoint struct {
+	ID          string            `json:"id"`
+	ServiceName string            `json:"service_name"`
+	Tags        map[string]string `json:"tags,omitempty"`
+}
+
 // StoppedEC2Instances represents stopped EC2 instances grouped by region.
-type StoppedEC2Instances map[string][]string
+type StoppedEC2Instances map[string][]StoppedInstance
 
 // UnusedVolumes represents unused EBS volumes grouped by region.
-type UnusedVolumes map[string][]string
+type UnusedVolumes map[string][]EBSVolume
 
 // UnusedEIPs represents unused Elastic IPs grouped by region.
-type UnusedEIPs map[string][]string
+type UnusedEIPs map[string][]ElasticIP
 
 // UntaggedResources represents untagged resources grouped by service and region.
 type UntaggedResources map[string]map[string][]string
 
-type IdleLoadBalancers map[string][]string
+type IdleLoadBalancers map[string][]LoadBalancer
 
-type UnusedVpcEndpoints map[string][]string
+type UnusedVpcEndpoints map[string][]VpcEndpoint
@@ -0,0 +1,339 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidFilter is returned when a --tag filter expression cannot be parsed.
+var ErrInvalidFilter = errors.New("invalid cost filter")
+
+// FilterAbsent é o valor especial que seleciona custos sem a tag (ou cost category).
+const FilterAbsent = "<absent>"
+
+// filterDimensions são as dimensões aceitas nos filtros, além das usadas em --group-by.
+var filterDimensions = map[string]bool{
+	"RECORD_TYPE": true,
+}
+
+// FilterCondition matches the costs whose key has one of the values, or has no value when Absent is set.
+type FilterCondition struct {
+	Key    GroupDimension
+	Values []string
+	Absent bool
+}
+
+// CostFilter is a boolean expression over filter conditions. Exatamente um dos campos é preenchido.
+type CostFilter struct {
+	And       []CostFilter
+	Or        []CostFilter
+	Not       *CostFilter
+	Condition *FilterCondition
+}
+
+// FilterError points at the token of a filter expression that could not be parsed.
+type FilterError struct {
+	Expr string
+	// Pos é o deslocamento, em bytes, do token inválido dentro de Expr.
+	Pos int
+	Msg string
+}
+
+func (e *FilterError) Error() string {
+	column := utf8.RuneCountInString(e.Expr[:e.Pos])
+	return fmt.Sprintf("%v: %s at column %d\n  %s\n  %s^", ErrInvalidFilter, e.Msg, column+1, e.Expr, strings.Repeat(" ", column))
+}
+
+func (e *FilterError) Unwrap() error { return ErrInvalidFilter }
+
+// ParseCostFilter parses the --tag expressions and ANDs them together. Sem expressões, retorna nil.
+//
+// Cada expressão combina condições com & ou "," (E), | (OU), ! (NÃO) e parênteses:
+//
+//	Team=a,b                  tag Team igual a "a" ou "b"
+//	Env!=prod                 tag Env diferente de "prod"
+//	Owner=<absent>            recursos sem a tag Owner
+//	REGION=us-east-1 | Team=x dimensão REGION ou tag Team
+//	COST_CATEGORY:Unit=Core   valor de cost category
+//
+// Chaves em maiúsculas que são dimensões do Cost Explorer (REGION, LINKED_ACCOUNT, SERVICE...)
+// filtram a dimensão; use TAG:<chave> para uma tag com o mesmo nome. Valores com caracteres
+// especiais ou espaços nas pontas podem vir entre aspas.
+func ParseCostFilter(exprs []string) (*CostFilter, error) {
+	var filters []CostFilter
+	for _, expr := range exprs {
+		if strings.TrimSpace(expr) == "" {
+			continue
+		}
+		p, err := newFilterParser(expr)
+		if err != nil {
+			return nil, err
+		}
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokEOF {
+			return nil, p.errorf(p.tok, "unexpected %s", p.tok)
+		}
+		filters = append(filters, f)
+	}
+
+	switch len(filters) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &filters[0], nil
+	default:
+		return &CostFilter{And: filters}, nil
+	}
+}
+
+type filterTokenKind int
+
+const (
+	tokEOF filterTokenKind = iota
+	tokWord
+	tokEq
+	tokNeq
+	tokNot
+	tokComma
+	tokAnd
+	tokOr
+	tokLParen
+	tokRParen
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	pos  int
+}
+
+func (t filterToken) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// filterOperators são os caracteres que encerram uma palavra sem aspas.
+const filterOperators = "=!,&|()\"'"
+
+func tokenizeFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+	i := 0
+	for i < len(expr) {
+		r, size := utf8.DecodeRuneInString(expr[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '!' && strings.HasPrefix(expr[i:], "!="):
+			tokens = append(tokens, filterToken{kind: tokNeq, text: "!=", pos: i})
+			i += 2
+		case strings.ContainsRune("=!,&|()", r):
+			kind := map[rune]filterTokenKind{'=': tokEq, '!': tokNot, ',': tokComma, '&': tokAnd, '|': tokOr, '(': tokLParen, ')': tokRParen}[r]
+			tokens = append(tokens, filterToken{kind: kind, text: string(r), pos: i})
+			i += size
+		case r == '"' || r == '\'':
+			end := strings.IndexRune(expr[i+size:], r)
+			if end < 0 {
+				return nil, &FilterError{Expr: expr, Pos: i, Msg: "unterminated quoted value"}
+			}
+			tokens = append(tokens, filterToken{kind: tokWord, text: expr[i+size : i+size+end], pos: i})
+			i += size + end + size
+		default:
+			start := i
+			for i < len(expr) {
+				r, size := utf8.DecodeRuneInString(expr[i:])
+				if strings.ContainsRune(filterOperators, r) {
+					break
+				}
+				i += size
+			}
+			// Palavras podem conter espaços internos (ex: "Amazon Elastic Compute Cloud - Compute").
+			tokens = append(tokens, filterToken{kind: tokWord, text: strings.TrimRightFunc(expr[start:i], unicode.IsSpace), pos: start})
+		}
+	}
+	return append(tokens, filterToken{kind: tokEOF, pos: len(expr)}), nil
+}
+
+type filterParser struct {
+	expr   string
+	tokens []filterToken
+	i      int
+	tok    filterToken
+}
+
+func newFilterParser(expr string) (*filterParser, error) {
+	tokens, err := tokenizeFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &filterParser{expr: expr, tokens: tokens, tok: tokens[0]}, nil
+}
+
+func (p *filterParser) next() {
+	if p.i < len(p.tokens)-1 {
+		p.i++
+	}
+	p.tok = p.tokens[p.i]
+}
+
+func (p *filterParser) peek(n int) filterToken {
+	if p.i+n < len(p.tokens) {
+		return p.tokens[p.i+n]
+	}
+	return p.tokens[len(p.tokens)-1]
+}
+
+func (p *filterParser) errorf(tok filterToken, format string, args ...interface{}) error {
+	return &FilterError{Expr: p.expr, Pos: tok.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *filterParser) parseOr() (CostFilter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return CostFilter{}, err
+	}
+	terms := []CostFilter{left}
+	for p.tok.kind == tokOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return CostFilter{}, err
+		}
+		terms = append(terms, right)
+	}
+	if len(terms) == 1 {
+		return left, nil
+	}
+	return CostFilter{Or: terms}, nil
+}
+
+// parseAnd trata "&" e "," como E. A vírgula só chega aqui quando não continua a lista de valores.
+func (p *filterParser) parseAnd() (CostFilter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return CostFilter{}, err
+	}
+	terms := []CostFilter{left}
+	for p.tok.kind == tokAnd || p.tok.kind == tokComma {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return CostFilter{}, err
+		}
+		terms = append(terms, right)
+	}
+	if len(terms) == 1 {
+		return left, nil
+	}
+	return CostFilter{And: terms}, nil
+}
+
+func (p *filterParser) parseUnary() (CostFilter, error) {
+	switch p.tok.kind {
+	case tokNot:
+		p.next()
+		inner, err := p.parseUnary()
+		if err != nil {
+			return CostFilter{}, err
+		}
+		return CostFilter{Not: &inner}, nil
+	case tokLParen:
+		open := p.tok
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return CostFilter{}, err
+		}
+		if p.tok.kind != tokRParen {
+			return CostFilter{}, p.errorf(open, "unclosed parenthesis")
+		}
+		p.next()
+		return inner, nil
+	default:
+		return p.parseCondition()
+	}
+}
+
+func (p *filterParser) parseCondition() (CostFilter, error) {
+	keyTok := p.tok
+	if keyTok.kind != tokWord {
+		return CostFilter{}, p.errorf(keyTok, "unexpected %s (expected a key such as Team, REGION or COST_CATEGORY:<name>)", keyTok)
+	}
+	key, err := parseFilterKey(keyTok.text)
+	if err != nil {
+		return CostFilter{}, p.errorf(keyTok, "%v", err)
+	}
+	p.next()
+
+	opTok := p.tok
+	if opTok.kind != tokEq && opTok.kind != tokNeq {
+		return CostFilter{}, p.errorf(opTok, "unexpected %s after key %q (expected = or !=)", opTok, keyTok.text)
+	}
+	p.next()
+
+	cond := FilterCondition{Key: key}
+	for {
+		valTok := p.tok
+		if valTok.kind != tokWord || valTok.text == "" {
+			return CostFilter{}, p.errorf(valTok, "unexpected %s (expected a value)", valTok)
+		}
+		if strings.EqualFold(valTok.text, FilterAbsent) {
+			if key.Kind == GroupKindDimension {
+				return CostFilter{}, p.errorf(valTok, "%s is only supported for tags and cost categories", FilterAbsent)
+			}
+			cond.Absent = true
+		} else {
+			cond.Values = append(cond.Values, valTok.text)
+		}
+		if cond.Absent && len(cond.Values) > 0 {
+			return CostFilter{}, p.errorf(valTok, "%s cannot be combined with other values", FilterAbsent)
+		}
+		p.next()
+
+		// Vírgula seguida de "chave=" (ou de !, parênteses) inicia outra condição, não outro valor.
+		if p.tok.kind != tokComma || p.peek(1).kind != tokWord {
+			break
+		}
+		if after := p.peek(2).kind; after == tokEq || after == tokNeq {
+			break
+		}
+		p.next()
+	}
+
+	f := CostFilter{Condition: &cond}
+	if opTok.kind == tokNeq {
+		return CostFilter{Not: &f}, nil
+	}
+	return f, nil
+}
+
+// parseFilterKey interpreta "TAG:<chave>", "COST_CATEGORY:<nome>" (ou "CC:<nome>"), uma dimensão
+// em maiúsculas ou, nos demais casos, uma chave de tag.
+func parseFilterKey(text string) (GroupDimension, error) {
+	if prefix, key, ok := strings.Cut(text, ":"); ok {
+		key = strings.TrimSpace(key)
+		switch strings.ToUpper(prefix) {
+		case "TAG":
+			if key == "" {
+				return GroupDimension{}, fmt.Errorf("TAG: requires a tag key")
+			}
+			return GroupDimension{Kind: GroupKindTag, Key: key}, nil
+		case string(GroupKindCostCategory), "CC":
+			if key == "" {
+				return GroupDimension{}, fmt.Errorf("%s: requires a cost category name", strings.ToUpper(prefix))
+			}
+			return GroupDimension{Kind: GroupKindCostCategory, Key: key}, nil
+		}
+	}
+	if _, ok := groupDimensionLabels[text]; ok || filterDimensions[text] {
+		return GroupDimension{Kind: GroupKindDimension, Key: text}, nil
+	}
+	return GroupDimension{Kind: GroupKindTag, Key: text}, nil
+}
@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// formatFilter escreve o filtro em uma forma compacta, com parênteses explícitos, para comparar a
+// estrutura da árvore nos testes.
+func formatFilter(f *CostFilter) string {
+	switch {
+	case f == nil:
+		return "<nil>"
+	case f.Condition != nil:
+		c := f.Condition
+		key := c.Key.Key
+		switch c.Key.Kind {
+		case GroupKindTag:
+			key = "tag:" + key
+		case GroupKindCostCategory:
+			key = "cc:" + key
+		}
+		if c.Absent {
+			return key + "=" + FilterAbsent
+		}
+		return key + "=" + strings.Join(c.Values, ",")
+	case f.Not != nil:
+		return "!" + formatFilter(f.Not)
+	case len(f.And) > 0:
+		return "(" + joinFilters(f.And, " & ") + ")"
+	case len(f.Or) > 0:
+		return "(" + joinFilters(f.Or, " | ") + ")"
+	default:
+		return "<empty>"
+	}
+}
+
+func joinFilters(filters []CostFilter, sep string) string {
+	parts := make([]string, len(filters))
+	for i := range filters {
+		parts[i] = formatFilter(&filters[i])
+	}
+	return strings.Join(parts, sep)
+}
+
+func TestParseCostFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		exprs []string
+		want  string
+	}{
+		{name: "no expressions", want: "<nil>"},
+		{name: "blank expression", exprs: []string{"  "}, want: "<nil>"},
+		{name: "tag", exprs: []string{"Team=a"}, want: "tag:Team=a"},
+		{name: "multi value", exprs: []string{"Team=a,b, c"}, want: "tag:Team=a,b,c"},
+		{name: "comma before a key starts a condition", exprs: []string{"Team=a,b,Env=prod"}, want: "(tag:Team=a,b & tag:Env=prod)"},
+		{name: "not equal", exprs: []string{"Env!=prod"}, want: "!tag:Env=prod"},
+		{name: "and binds tighter than or", exprs: []string{"Team=a | Env=prod & Owner=x"}, want: "(tag:Team=a | (tag:Env=prod & tag:Owner=x))"},
+		{name: "or is left to right", exprs: []string{"Team=a & Env=prod | Owner=x"}, want: "((tag:Team=a & tag:Env=prod) | tag:Owner=x)"},
+		{name: "parentheses", exprs: []string{"(Team=a | Env=prod) & Owner=x"}, want: "((tag:Team=a | tag:Env=prod) & tag:Owner=x)"},
+		{name: "not binds tighter than and", exprs: []string{"!Team=a & Env=b"}, want: "(!tag:Team=a & tag:Env=b)"},
+		{name: "not of a group", exprs: []string{"!(Team=a | Env=b)"}, want: "!(tag:Team=a | tag:Env=b)"},
+		{name: "double not", exprs: []string{"!!Team=a"}, want: "!!tag:Team=a"},
+		{name: "absent tag", exprs: []string{"Owner=<absent>"}, want: "tag:Owner=<absent>"},
+		{name: "absent is case insensitive", exprs: []string{"Owner!=<ABSENT>"}, want: "!tag:Owner=<absent>"},
+		{name: "absent cost category", exprs: []string{"CC:Unit=<absent>"}, want: "cc:Unit=<absent>"},
+		{name: "dimension", exprs: []string{"REGION=us-east-1,eu-west-1"}, want: "REGION=us-east-1,eu-west-1"},
+		{name: "record type dimension", exprs: []string{"RECORD_TYPE!=Credit"}, want: "!RECORD_TYPE=Credit"},
+		{name: "tag named like a dimension", exprs: []string{"TAG:REGION=x"}, want: "tag:REGION=x"},
+		{name: "lowercase key is a tag", exprs: []string{"region=x"}, want: "tag:region=x"},
+		{name: "cost category", exprs: []string{"COST_CATEGORY:Unit=Core"}, want: "cc:Unit=Core"},
+		{name: "value with inner spaces", exprs: []string{"SERVICE=Amazon Elastic Compute Cloud - Compute | Team=a"}, want: "(SERVICE=Amazon Elastic Compute Cloud - Compute | tag:Team=a)"},
+		{name: "quoted value", exprs: []string{`Team="a,b" , 'x|y'`}, want: "tag:Team=a,b,x|y"},
+		{name: "expressions are ANDed", exprs: []string{"Team=a", "", "Env=b|Env=c"}, want: "(tag:Team=a & (tag:Env=b | tag:Env=c))"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := ParseCostFilter(tt.exprs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := formatFilter(f); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseCostFilterErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		pos  int
+		msg  string
+	}{
+		{name: "missing operator", expr: "Team", pos: 4, msg: `unexpected end of expression after key "Team"`},
+		{name: "missing value", expr: "Team=", pos: 5, msg: "expected a value"},
+		{name: "missing key", expr: "=a", pos: 0, msg: `unexpected "="`},
+		{name: "trailing and", expr: "Team=a &", pos: 8, msg: "unexpected end of expression"},
+		{name: "double or", expr: "Team=a | | Env=b", pos: 9, msg: `unexpected "|"`},
+		{name: "unclosed parenthesis", expr: "x=1 & (Team=a", pos: 6, msg: "unclosed parenthesis"},
+		{name: "unbalanced parenthesis", expr: "Team=a)", pos: 6, msg: `unexpected ")"`},
+		{name: "unterminated quote", expr: `Team="a`, pos: 5, msg: "unterminated quoted value"},
+		{name: "absent dimension", expr: "REGION=<absent>", pos: 7, msg: "only supported for tags and cost categories"},
+		{name: "absent with values", expr: "Owner=a,<absent>", pos: 8, msg: "cannot be combined with other values"},
+		{name: "empty tag key", expr: "TAG:=a", pos: 0, msg: "TAG: requires a tag key"},
+		{name: "empty cost category", expr: "Env=a & cc: =x", pos: 8, msg: "CC: requires a cost category name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCostFilter([]string{tt.expr})
+			var fe *FilterError
+			if !errors.As(err, &fe) {
+				t.Fatalf("expected a FilterError, got %v", err)
+			}
+			if !errors.Is(err, ErrInvalidFilter) {
+				t.Errorf("expected ErrInvalidFilter, got %v", err)
+			}
+			if fe.Pos != tt.pos {
+				t.Errorf("expected position %d, got %d (%s)", tt.pos, fe.Pos, fe.Msg)
+			}
+			if !strings.Contains(fe.Msg, tt.msg) {
+				t.Errorf("expected message to contain %q, got %q", tt.msg, fe.Msg)
+			}
+		})
+	}
+}
+
+func TestFilterErrorPointsAtColumn(t *testing.T) {
+	// A coluna conta caracteres, não bytes: "É" ocupa dois bytes.
+	_, err := ParseCostFilter([]string{"Équipe=a & & x=1"})
+	want := "invalid cost filter: unexpected \"&\" (expected a key such as Team, REGION or COST_CATEGORY:<name>) at column 12\n" +
+		"  Équipe=a & & x=1\n" +
+		"             ^"
+	if err == nil || err.Error() != want {
+		t.Errorf("expected %q, got %v", want, err)
+	}
+}
@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Severity indicates how urgent a finding is.
+type Severity string
+
+// Severidades dos achados de auditoria, da menor para a maior.
+const (
+	SeverityLow    Severity = "low"
+	SeverityMedium Severity = "medium"
+	SeverityHigh   Severity = "high"
+)
+
+// IDs das verificações da auditoria principal.
+const (
+	CheckBudgetExceeded     = "budget-exceeded"
+	CheckHighCostNatGateway = "high-cost-nat-gateway"
+	CheckUnusedVpcEndpoint  = "unused-vpc-endpoint"
+	CheckIdleLoadBalancer   = "idle-load-balancer"
+	CheckStoppedInstance    = "stopped-instance"
+	CheckUnusedVolume       = "unused-volume"
+	CheckUnusedElasticIP    = "unused-elastic-ip"
+	CheckUntaggedResource   = "untagged-resource"
+)
+
+// Finding is a single resource flagged by an audit check.
+type Finding struct {
+	CheckID    string `json:"check_id"`
+	ResourceID string `json:"resource_id"`
+	ARN        string `json:"arn,omitempty"`
+	AccountID  string `json:"account_id,omitempty"`
+	// Region fica vazio para recursos globais, como budgets.
+	Region   string   `json:"region,omitempty"`
+	Service  string   `json:"service"`
+	Severity Severity `json:"severity"`
+	// Details descreve o recurso (tipo, tamanho, custo x limite...).
+	Details string `json:"details,omitempty"`
+	// EstimatedCost é o custo mensal estimado do recurso; nos NAT Gateways, o custo de processamento no
+	// período do relatório. Nil quando não há preço.
+	EstimatedCost  *float64          `json:"estimated_cost,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	Recommendation string            `json:"recommendation"`
+	// Baseline compara o achado com a auditoria de --baseline; vazio quando não há base.
+	Baseline BaselineStatus `json:"baseline,omitempty"`
+}
+
+// FilterFindings returns the findings of a check, in their original order.
+func FilterFindings(findings []Finding, checkID string) []Finding {
+	var out []Finding
+	for _, f := range findings {
+		if f.CheckID == checkID {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// SortFindings orders findings by check, region and resource ID so that reports are deterministic.
+func SortFindings(findings []Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.CheckID != b.CheckID {
+			return a.CheckID < b.CheckID
+		}
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		return a.ResourceID < b.ResourceID
+	})
+}
+
+// BuildARN builds the ARN of a regional resource; a partição é deduzida da região.
+func BuildARN(service, region, accountID, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", Partition(region), service, region, accountID, resource)
+}
+
+// Partition returns the AWS partition of a region, e.g. "aws-us-gov" for us-gov-west-1.
+func Partition(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	default:
+		return "aws"
+	}
+}
@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Origens possíveis de uma previsão de custo.
+const (
+	ForecastSourceCostExplorer = "cost_explorer"
+	ForecastSourceLinear       = "linear"
+)
+
+// forecastZ80 é o quantil da normal para o intervalo de 80%, o mesmo nível pedido ao Cost Explorer.
+const forecastZ80 = 1.2816
+
+// CostForecast is the forecasted total cost of the current month, including the month-to-date spend.
+type CostForecast struct {
+	// Amount é o custo previsto para o mês inteiro (gasto até hoje + previsão até o fim do mês).
+	Amount     float64 `json:"amount"`
+	LowerBound float64 `json:"lower_bound"`
+	UpperBound float64 `json:"upper_bound"`
+
+	// MonthToDate é o custo já realizado no mês.
+	MonthToDate float64 `json:"month_to_date"`
+
+	// PeriodEnd é o fim (exclusivo) do mês previsto.
+	PeriodEnd time.Time `json:"period_end"`
+
+	// Source indica se a previsão veio do Cost Explorer ou da projeção linear local.
+	Source string `json:"source"`
+
+	// ExceededBudgets lista os orçamentos cujo limite a previsão ultrapassa.
+	ExceededBudgets []string `json:"exceeded_budgets,omitempty"`
+}
+
+// BoundsLabel returns the confidence interval formatted for display, e.g. "$90.00 - $110.00".
+func (f CostForecast) BoundsLabel() string {
+	return fmt.Sprintf("$%.2f - $%.2f", f.LowerBound, f.UpperBound)
+}
+
+// CheckBudgets fills ExceededBudgets with the budgets whose limit is below the forecast.
+func (f *CostForecast) CheckBudgets(budgets []BudgetInfo) {
+	f.ExceededBudgets = nil
+	for _, b := range budgets {
+		if b.Limit > 0 && f.Amount > b.Limit {
+			f.ExceededBudgets = append(f.ExceededBudgets, b.Name)
+		}
+	}
+}
+
+// LinearForecast projects the rest of the month from a series of daily costs using
+// a least-squares line. monthToDate is the spend already incurred this month and
+// remainingDays the number of days, including today, left until the end of the month.
+// Os limites usam o desvio padrão dos resíduos, num intervalo de 80%.
+func LinearForecast(daily []float64, monthToDate float64, remainingDays int) (CostForecast, error) {
+	n := len(daily)
+	if n == 0 {
+		return CostForecast{}, fmt.Errorf("no daily cost history to project")
+	}
+
+	// Com um único dia, a reta vira a média (inclinação zero).
+	var slope, intercept float64
+	if n == 1 {
+		intercept = daily[0]
+	} else {
+		var sumX, sumY, sumXY, sumXX float64
+		for i, y := range daily {
+			x := float64(i)
+			sumX += x
+			sumY += y
+			sumXY += x * y
+			sumXX += x * x
+		}
+		fn := float64(n)
+		slope = (fn*sumXY - sumX*sumY) / (fn*sumXX - sumX*sumX)
+		intercept = (sumY - slope*sumX) / fn
+	}
+
+	var residuals float64
+	for i, y := range daily {
+		d := y - (intercept + slope*float64(i))
+		residuals += d * d
+	}
+	var sigma float64
+	if n > 2 {
+		sigma = math.Sqrt(residuals / float64(n-2))
+	}
+
+	var projected float64
+	for k := 0; k < remainingDays; k++ {
+		projected += math.Max(0, intercept+slope*float64(n+k))
+	}
+	margin := forecastZ80 * sigma * math.Sqrt(float64(remainingDays))
+
+	return CostForecast{
+		Amount:      monthToDate + projected,
+		LowerBound:  monthToDate + math.Max(0, projected-margin),
+		UpperBound:  monthToDate + projected + margin,
+		MonthToDate: monthToDate,
+		Source:      ForecastSourceLinear,
+	}, nil
+}
@@ -7,9 +7,10 @@ type FullAuditReport struct {
 	AccountID string `json:"account_id"`
 
 	// Sub-relatórios
-	MainAudit        *AuditData           `json:"main_audit,omitempty"`
-	TransferAudit    *DataTransferReport  `json:"transfer_audit,omitempty"`
-	LogsAudit        *CloudWatchLogsAudit `json:"logs_audit,omitempty"`
-	S3Audit          *S3LifecycleAudit    `json:"s3_audit,omitempty"`
-	CommitmentsAudit *CommitmentsReport   `json:"commitments_audit,omitempty"`
+	MainAudit        *AuditData                  `json:"main_audit,omitempty"`
+	TransferAudit    *DataTransferReport         `json:"transfer_audit,omitempty"`
+	LogsAudit        *CloudWatchLogsAudit        `json:"logs_audit,omitempty"`
+	S3Audit          *S3LifecycleAudit           `json:"s3_audit,omitempty"`
+	CommitmentsAudit *CommitmentsReport          `json:"commitments_audit,omitempty"`
+	ExpirationsAudit *CommitmentExpirationReport `json:"expirations_audit,omitempty"`
 }
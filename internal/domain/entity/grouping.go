@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidGrouping is returned when a --group-by specification is not valid.
+var ErrInvalidGrouping = errors.New("invalid grouping")
+
+// MaxGroupingLevels é o limite de agrupamentos do Cost Explorer por consulta.
+const MaxGroupingLevels = 2
+
+// GroupKind is the kind of a Cost Explorer grouping key.
+type GroupKind string
+
+// Tipos de agrupamento aceitos pelo Cost Explorer.
+const (
+	GroupKindDimension    GroupKind = "DIMENSION"
+	GroupKindTag          GroupKind = "TAG"
+	GroupKindCostCategory GroupKind = "COST_CATEGORY"
+)
+
+// groupDimensionLabels são as dimensões suportadas e seus nomes de exibição.
+var groupDimensionLabels = map[string]string{
+	"SERVICE":        "Service",
+	"LINKED_ACCOUNT": "Linked Account",
+	"REGION":         "Region",
+	"USAGE_TYPE":     "Usage Type",
+	"INSTANCE_TYPE":  "Instance Type",
+	"AZ":             "Availability Zone",
+	"OPERATION":      "Operation",
+	"PURCHASE_TYPE":  "Purchase Type",
+	"PLATFORM":       "Platform",
+}
+
+// GroupDimension is a single Cost Explorer grouping, e.g. REGION or TAG:Team.
+type GroupDimension struct {
+	Kind GroupKind
+	Key  string
+}
+
+// Grouping is the ordered list of dimensions used to group costs (at most two levels).
+type Grouping []GroupDimension
+
+// DefaultGrouping returns the grouping by service used when --group-by is not given.
+func DefaultGrouping() Grouping {
+	return Grouping{{Kind: GroupKindDimension, Key: "SERVICE"}}
+}
+
+// LinkedAccountDimension returns the grouping by member account used in organization mode.
+func LinkedAccountDimension() GroupDimension {
+	return GroupDimension{Kind: GroupKindDimension, Key: "LINKED_ACCOUNT"}
+}
+
+// ParseGrouping parses specs such as "REGION", "TAG:Team" or "COST_CATEGORY:Environment".
+// Sem especificações, retorna o agrupamento por serviço.
+func ParseGrouping(specs []string) (Grouping, error) {
+	if len(specs) == 0 {
+		return DefaultGrouping(), nil
+	}
+	if len(specs) > MaxGroupingLevels {
+		return nil, fmt.Errorf("%w: at most %d levels are supported, got %d", ErrInvalidGrouping, MaxGroupingLevels, len(specs))
+	}
+
+	grouping := make(Grouping, 0, len(specs))
+	for _, spec := range specs {
+		dim, err := parseGroupDimension(strings.TrimSpace(spec))
+		if err != nil {
+			return nil, err
+		}
+		for _, existing := range grouping {
+			if existing == dim {
+				return nil, fmt.Errorf("%w: %q is repeated", ErrInvalidGrouping, spec)
+			}
+		}
+		grouping = append(grouping, dim)
+	}
+	return grouping, nil
+}
+
+func parseGroupDimension(spec string) (GroupDimension, error) {
+	prefix, key, hasKey := strings.Cut(spec, ":")
+	switch strings.ToUpper(prefix) {
+	case "TAG", string(GroupKindCostCategory):
+		if !hasKey || strings.TrimSpace(key) == "" {
+			return GroupDimension{}, fmt.Errorf("%w: %q requires a key, e.g. %s:Team", ErrInvalidGrouping, spec, strings.ToUpper(prefix))
+		}
+		kind := GroupKindTag
+		if strings.ToUpper(prefix) == string(GroupKindCostCategory) {
+			kind = GroupKindCostCategory
+		}
+		return GroupDimension{Kind: kind, Key: strings.TrimSpace(key)}, nil
+	}
+
+	name := strings.ToUpper(strings.ReplaceAll(spec, "-", "_"))
+	if _, ok := groupDimensionLabels[name]; !ok || hasKey {
+		return GroupDimension{}, fmt.Errorf("%w: %q (valid: SERVICE, LINKED_ACCOUNT, REGION, USAGE_TYPE, INSTANCE_TYPE, AZ, OPERATION, PURCHASE_TYPE, PLATFORM, TAG:<key>, COST_CATEGORY:<name>)", ErrInvalidGrouping, spec)
+	}
+	return GroupDimension{Kind: GroupKindDimension, Key: name}, nil
+}
+
+// String returns the specification of the dimension, e.g. "TAG:Team".
+func (d GroupDimension) String() string {
+	if d.Kind == GroupKindDimension {
+		return d.Key
+	}
+	return fmt.Sprintf("%s:%s", d.Kind, d.Key)
+}
+
+// Label returns a human-readable name for the dimension, e.g. "Usage Type" or "Tag Team".
+func (d GroupDimension) Label() string {
+	switch d.Kind {
+	case GroupKindTag:
+		return "Tag " + d.Key
+	case GroupKindCostCategory:
+		return "Cost Category " + d.Key
+	default:
+		return groupDimensionLabels[d.Key]
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler so groupings are exported as their specification.
+func (d GroupDimension) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// IsDefault reports whether the grouping is the default grouping by service.
+func (g Grouping) IsDefault() bool {
+	return len(g) == 0 || (len(g) == 1 && g[0] == DefaultGrouping()[0])
+}
+
+// Title returns the title of the cost column, e.g. "Cost By Service" or "Cost By Tag Team / Region".
+func (g Grouping) Title() string {
+	if len(g) == 0 {
+		g = DefaultGrouping()
+	}
+	labels := make([]string, len(g))
+	for i, d := range g {
+		labels[i] = d.Label()
+	}
+	return "Cost By " + strings.Join(labels, " / ")
+}
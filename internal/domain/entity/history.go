@@ -0,0 +1,251 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrSnapshotNotFound is returned when a snapshot ID is not in the history store.
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// Snapshot is the result of a report run kept in the local history store, so that costs and findings
+// can be compared across runs without calling AWS.
+type Snapshot struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	// Report é o relatório que gerou o snapshot: cost, trend, audit ou full-audit.
+	Report      string       `json:"report"`
+	Metric      CostMetric   `json:"metric"`
+	PeriodStart time.Time    `json:"period_start"`
+	PeriodEnd   time.Time    `json:"period_end"`
+	Tag         []string     `json:"tag,omitempty"`
+	Exclude     []RecordType `json:"exclude,omitempty"`
+
+	// Apenas um dos resultados é preenchido, conforme o relatório; no full-audit, a auditoria principal.
+	Profiles []ProfileData `json:"profiles,omitempty"`
+	Trends   []CostTrend   `json:"trends,omitempty"`
+	Audits   []AuditData   `json:"audits,omitempty"`
+}
+
+// SnapshotSummary is the index entry of a snapshot, listed without reading the whole snapshot.
+type SnapshotSummary struct {
+	ID          string     `json:"id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	Report      string     `json:"report"`
+	Metric      CostMetric `json:"metric"`
+	PeriodStart time.Time  `json:"period_start"`
+	PeriodEnd   time.Time  `json:"period_end"`
+	Profiles    []string   `json:"profiles"`
+	// Cost é o custo do período atual (cost) ou da janela inteira (trend); nil nas auditorias.
+	Cost *float64 `json:"cost,omitempty"`
+	// Findings é o número de achados das auditorias; nil nos relatórios de custo.
+	Findings *int `json:"findings,omitempty"`
+}
+
+// Summary returns the index entry of the snapshot.
+func (s Snapshot) Summary() SnapshotSummary {
+	sum := SnapshotSummary{
+		ID:          s.ID,
+		CreatedAt:   s.CreatedAt,
+		Report:      s.Report,
+		Metric:      s.Metric,
+		PeriodStart: s.PeriodStart,
+		PeriodEnd:   s.PeriodEnd,
+		Profiles:    s.ProfileNames(),
+	}
+	switch {
+	case s.Profiles != nil:
+		var cost float64
+		for _, p := range s.Profiles {
+			cost += p.CurrentMonth
+		}
+		sum.Cost = &cost
+	case s.Trends != nil:
+		var cost float64
+		for _, t := range s.Trends {
+			cost += t.Total()
+		}
+		sum.Cost = &cost
+	case s.Audits != nil:
+		var findings int
+		for _, a := range s.Audits {
+			findings += len(a.Findings)
+		}
+		sum.Findings = &findings
+	}
+	return sum
+}
+
+// ProfileNames returns the profiles of the snapshot, in report order and without repetitions.
+func (s Snapshot) ProfileNames() []string {
+	var names []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, p := range s.Profiles {
+		add(p.Profile)
+	}
+	for _, t := range s.Trends {
+		add(t.Profile)
+	}
+	for _, a := range s.Audits {
+		add(a.Profile)
+	}
+	return names
+}
+
+// FilterProfiles keeps only the results of the given profiles; sem perfis, mantém todos.
+func (s Snapshot) FilterProfiles(profiles []string) Snapshot {
+	if len(profiles) == 0 {
+		return s
+	}
+	keep := make(map[string]bool, len(profiles))
+	for _, p := range profiles {
+		keep[p] = true
+	}
+	out := s
+	out.Profiles, out.Trends, out.Audits = nil, nil, nil
+	for _, p := range s.Profiles {
+		if keep[p.Profile] {
+			out.Profiles = append(out.Profiles, p)
+		}
+	}
+	for _, t := range s.Trends {
+		if keep[t.Profile] {
+			out.Trends = append(out.Trends, t)
+		}
+	}
+	for _, a := range s.Audits {
+		if keep[a.Profile] {
+			out.Audits = append(out.Audits, a)
+		}
+	}
+	return out
+}
+
+// FindingHistoryPoint is the number of audit findings of a profile in one snapshot.
+type FindingHistoryPoint struct {
+	SnapshotID string
+	CreatedAt  time.Time
+	Findings   int
+	// Savings é a economia mensal estimada do snapshot; nil quando não foi calculada.
+	Savings *float64
+}
+
+// FindingHistory is the number of findings of a profile across audit snapshots, oldest first.
+type FindingHistory struct {
+	Profile   string
+	AccountID string
+	Points    []FindingHistoryPoint
+}
+
+// BuildCostHistory merges the monthly costs of cost and trend snapshots into one monthly trend per
+// profile, metric and filter, going further back than the Cost Explorer history. Os snapshots de custo só
+// contam quando o período cabe em um mês do calendário (ex: mês corrente ou --month); quando vários
+// snapshots cobrem o mesmo mês, vale o mais recente, que tem o mês mais completo.
+func BuildCostHistory(snapshots []Snapshot) []CostTrend {
+	sorted := make([]Snapshot, len(snapshots))
+	copy(sorted, snapshots)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CreatedAt.Before(sorted[j].CreatedAt) })
+
+	type monthCost struct {
+		start time.Time
+		cost  float64
+	}
+	var keys []string
+	trends := make(map[string]*CostTrend)
+	months := make(map[string]map[time.Time]float64)
+	record := func(s Snapshot, profile, accountID string, costs []monthCost) {
+		key := strings.Join([]string{profile, string(s.Metric), strings.Join(s.Tag, ";"), RecordTypesLabel(s.Exclude)}, "|")
+		if _, ok := trends[key]; !ok {
+			keys = append(keys, key)
+			trends[key] = &CostTrend{Profile: profile, Metric: s.Metric, Granularity: TrendMonthly}
+			months[key] = make(map[time.Time]float64)
+		}
+		if accountID != "" {
+			trends[key].AccountID = accountID
+		}
+		for _, c := range costs {
+			months[key][c.start] = c.cost
+		}
+	}
+
+	for _, s := range sorted {
+		for _, p := range s.Profiles {
+			month := time.Date(s.PeriodStart.Year(), s.PeriodStart.Month(), 1, 0, 0, 0, 0, time.UTC)
+			if !s.PeriodStart.Equal(month) || s.PeriodEnd.After(month.AddDate(0, 1, 0)) {
+				continue
+			}
+			record(s, p.Profile, p.AccountID, []monthCost{{month, p.CurrentMonth}})
+		}
+		for _, t := range s.Trends {
+			if t.Granularity != TrendMonthly {
+				continue
+			}
+			costs := make([]monthCost, len(t.Points))
+			for i, p := range t.Points {
+				costs[i] = monthCost{time.Date(p.Start.Year(), p.Start.Month(), 1, 0, 0, 0, 0, time.UTC), p.Cost}
+			}
+			record(s, t.Profile, t.AccountID, costs)
+		}
+	}
+
+	out := make([]CostTrend, 0, len(keys))
+	for _, key := range keys {
+		trend := trends[key]
+		starts := make([]time.Time, 0, len(months[key]))
+		for start := range months[key] {
+			starts = append(starts, start)
+		}
+		sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+		for _, start := range starts {
+			trend.Points = append(trend.Points, TrendPoint{Start: start, Label: TrendMonthly.label(start), Cost: months[key][start]})
+		}
+		trend.PeriodStart = starts[0]
+		trend.PeriodEnd = starts[len(starts)-1].AddDate(0, 1, 0)
+		out = append(out, *trend)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Profile < out[j].Profile })
+	return out
+}
+
+// BuildFindingHistory returns, per profile, the number of findings in each audit and full-audit snapshot.
+func BuildFindingHistory(snapshots []Snapshot) []FindingHistory {
+	sorted := make([]Snapshot, len(snapshots))
+	copy(sorted, snapshots)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CreatedAt.Before(sorted[j].CreatedAt) })
+
+	var out []FindingHistory
+	index := make(map[string]int)
+	for _, s := range sorted {
+		for _, a := range s.Audits {
+			i, ok := index[a.Profile]
+			if !ok {
+				i = len(out)
+				index[a.Profile] = i
+				out = append(out, FindingHistory{Profile: a.Profile})
+			}
+			point := FindingHistoryPoint{SnapshotID: s.ID, CreatedAt: s.CreatedAt, Findings: len(a.Findings)}
+			if a.PotentialSavings != nil {
+				total := a.PotentialSavings.Total()
+				point.Savings = &total
+			}
+			out[i].AccountID = a.AccountID
+			out[i].Points = append(out[i].Points, point)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Profile < out[j].Profile })
+	return out
+}
+
+// NewSnapshotID builds a snapshot ID from its creation time and report, e.g. "20261016-153045-audit".
+func NewSnapshotID(createdAt time.Time, report string) string {
+	return fmt.Sprintf("%s-%s", createdAt.UTC().Format("20060102-150405"), report)
+}
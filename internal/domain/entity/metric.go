@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCostMetric is returned when a cost metric name is not recognized.
+var ErrInvalidCostMetric = errors.New("invalid cost metric")
+
+// CostMetric is the Cost Explorer metric used to value costs.
+type CostMetric string
+
+// Métricas de custo suportadas pelo Cost Explorer.
+const (
+	CostMetricUnblended    CostMetric = "UnblendedCost"
+	CostMetricBlended      CostMetric = "BlendedCost"
+	CostMetricAmortized    CostMetric = "AmortizedCost"
+	CostMetricNetAmortized CostMetric = "NetAmortizedCost"
+	CostMetricNetUnblended CostMetric = "NetUnblendedCost"
+)
+
+var costMetricsByOption = map[string]CostMetric{
+	"unblended":     CostMetricUnblended,
+	"blended":       CostMetricBlended,
+	"amortized":     CostMetricAmortized,
+	"net-amortized": CostMetricNetAmortized,
+	"net-unblended": CostMetricNetUnblended,
+}
+
+// ParseCostMetric parses a cost metric option such as "amortized" or "net-unblended".
+// Os nomes do Cost Explorer (ex: "AmortizedCost") também são aceitos. Vazio equivale a "unblended".
+func ParseCostMetric(s string) (CostMetric, error) {
+	option := strings.ToLower(strings.TrimSpace(s))
+	if option == "" {
+		return CostMetricUnblended, nil
+	}
+	option = strings.ReplaceAll(option, "_", "-")
+	if m, ok := costMetricsByOption[option]; ok {
+		return m, nil
+	}
+	for _, m := range costMetricsByOption {
+		if strings.EqualFold(string(m), option) {
+			return m, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q (valid: unblended, blended, amortized, net-amortized, net-unblended)", ErrInvalidCostMetric, s)
+}
+
+// Label returns a human-readable name for the metric, e.g. "Net amortized".
+func (m CostMetric) Label() string {
+	switch m {
+	case CostMetricBlended:
+		return "Blended"
+	case CostMetricAmortized:
+		return "Amortized"
+	case CostMetricNetAmortized:
+		return "Net amortized"
+	case CostMetricNetUnblended:
+		return "Net unblended"
+	default:
+		return "Unblended"
+	}
+}
+
+// OrDefault returns the metric, or UnblendedCost when it is empty.
+func (m CostMetric) OrDefault() CostMetric {
+	if m == "" {
+		return CostMetricUnblended
+	}
+	return m
+}
@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// OrgAccount is a member account of an AWS Organization.
+type OrgAccount struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Email  string `json:"email,omitempty"`
+	Status string `json:"status,omitempty"`
+
+	// OUPath é o caminho da unidade organizacional da conta (ex: "Root/Workloads/Prod").
+	OUPath string `json:"ou_path"`
+}
+
+// LinkedAccountCost contains the costs of a linked account as seen from the management account.
+type LinkedAccountCost struct {
+	AccountID    string        `json:"account_id"`
+	CurrentCost  float64       `json:"current_cost"`
+	PreviousCost float64       `json:"previous_cost"`
+	ServiceCosts []ServiceCost `json:"service_costs"`
+}
+
+// AssumeRoleOptions configures access to other accounts by assuming an IAM role from a base profile.
+type AssumeRoleOptions struct {
+	// RoleName é o nome da role em cada conta (ex: "OrganizationAccountAccessRole").
+	RoleName   string
+	ExternalID string
+
+	// Duration é a duração da sessão assumida; zero usa o padrão do SDK.
+	Duration time.Duration
+}
+
+// ProfileName returns the name of the profile that accesses accountID by assuming the role from
+// baseProfile, e.g. "base@111111111111/OrganizationAccountAccessRole".
+// Com external ID ou duração, o nome ganha um sufixo com o hash dessas opções: sessões com opções
+// diferentes não compartilham credenciais, e o external ID não aparece nas tabelas e exportações.
+func (o AssumeRoleOptions) ProfileName(baseProfile, accountID string) string {
+	name := fmt.Sprintf("%s@%s/%s", baseProfile, accountID, o.RoleName)
+	if o.ExternalID == "" && o.Duration == 0 {
+		return name
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%d", o.ExternalID, o.Duration)))
+	return name + "#" + hex.EncodeToString(sum[:4])
+}
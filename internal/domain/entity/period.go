@@ -0,0 +1,169 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidPeriod is returned when a date range or month cannot be parsed or is not valid.
+var ErrInvalidPeriod = errors.New("invalid period")
+
+const dateLayout = "2006-01-02"
+
+type periodKind int
+
+const (
+	periodCurrentMonth periodKind = iota
+	periodLastDays
+	periodPreviousMonth
+	periodPreviousDays
+	periodCustom
+)
+
+// Period is a Cost Explorer time window. Start is inclusive and End is exclusive,
+// matching the semantics of the Cost Explorer API.
+type Period struct {
+	Start time.Time
+	End   time.Time
+
+	kind periodKind
+	days int
+}
+
+// CurrentMonthPeriod returns the period from the first day of the current month until today.
+func CurrentMonthPeriod(now time.Time) Period {
+	today := truncateDay(now)
+	start := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := today
+	// No primeiro dia do mês o período ficaria vazio; inclui o dia de hoje.
+	if end.Equal(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return Period{Start: start, End: end, kind: periodCurrentMonth}
+}
+
+// LastDaysPeriod returns the period covering the last n days before today.
+func LastDaysPeriod(now time.Time, n int) Period {
+	today := truncateDay(now)
+	return Period{Start: today.AddDate(0, 0, -n), End: today, kind: periodLastDays, days: n}
+}
+
+// MonthPeriod returns the period covering a whole calendar month.
+func MonthPeriod(year int, month time.Month) Period {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return Period{Start: start, End: start.AddDate(0, 1, 0), kind: periodCustom}
+}
+
+// DateRangePeriod returns the period from `from` to `to`, both inclusive.
+func DateRangePeriod(from, to time.Time) Period {
+	return Period{Start: truncateDay(from), End: truncateDay(to).AddDate(0, 0, 1), kind: periodCustom}
+}
+
+// ParseMonthPeriod parses a month in the YYYY-MM format.
+func ParseMonthPeriod(month string) (Period, error) {
+	t, err := time.Parse("2006-01", month)
+	if err != nil {
+		return Period{}, fmt.Errorf("%w: month %q must be in the YYYY-MM format", ErrInvalidPeriod, month)
+	}
+	return MonthPeriod(t.Year(), t.Month()), nil
+}
+
+// ParseDateRangePeriod parses an inclusive date range in the YYYY-MM-DD format.
+func ParseDateRangePeriod(from, to string) (Period, error) {
+	start, err := time.Parse(dateLayout, from)
+	if err != nil {
+		return Period{}, fmt.Errorf("%w: from date %q must be in the YYYY-MM-DD format", ErrInvalidPeriod, from)
+	}
+	end, err := time.Parse(dateLayout, to)
+	if err != nil {
+		return Period{}, fmt.Errorf("%w: to date %q must be in the YYYY-MM-DD format", ErrInvalidPeriod, to)
+	}
+	if end.Before(start) {
+		return Period{}, fmt.Errorf("%w: to date %s is before from date %s", ErrInvalidPeriod, to, from)
+	}
+	return DateRangePeriod(start, end), nil
+}
+
+// Days returns the number of days in the period.
+func (p Period) Days() int {
+	return int(p.End.Sub(p.Start).Hours() / 24)
+}
+
+// LastDays returns n for a period created by LastDaysPeriod, and 0 for any other period.
+func (p Period) LastDays() int {
+	if p.kind != periodLastDays {
+		return 0
+	}
+	return p.days
+}
+
+// IsOngoing reports whether the period runs until today, i.e. its costs are still accruing.
+func (p Period) IsOngoing(now time.Time) bool {
+	return !p.End.Before(truncateDay(now))
+}
+
+// Previous returns the period used for comparison, immediately before p.
+// Períodos de meses inteiros comparam com o mesmo número de meses anteriores;
+// o mês corrente (parcial) compara com o mês anterior completo; os demais
+// comparam com o mesmo número de dias imediatamente antes.
+func (p Period) Previous() Period {
+	switch {
+	case p.kind == periodCurrentMonth:
+		return Period{Start: p.Start.AddDate(0, -1, 0), End: p.Start, kind: periodPreviousMonth}
+	case p.kind == periodLastDays:
+		return Period{Start: p.Start.AddDate(0, 0, -p.days), End: p.Start, kind: periodPreviousDays, days: p.days}
+	case p.wholeMonths() > 0:
+		return Period{Start: p.Start.AddDate(0, -p.wholeMonths(), 0), End: p.Start, kind: periodCustom}
+	default:
+		return Period{Start: p.Start.AddDate(0, 0, -p.Days()), End: p.Start, kind: periodCustom}
+	}
+}
+
+// Name returns a human-readable name for the period, e.g. "Current month's cost".
+func (p Period) Name(subject string) string {
+	switch p.kind {
+	case periodCurrentMonth:
+		return fmt.Sprintf("Current month's %s", subject)
+	case periodLastDays:
+		return fmt.Sprintf("Current %d days %s", p.days, subject)
+	case periodPreviousMonth:
+		return fmt.Sprintf("Last month's %s", subject)
+	case periodPreviousDays:
+		return fmt.Sprintf("Previous %d days %s", p.days, subject)
+	default:
+		return fmt.Sprintf("%s %s", p.Label(), subject)
+	}
+}
+
+// Label returns a compact description of the dates, e.g. "2026-08" or "2026-07-01 to 2026-07-15".
+func (p Period) Label() string {
+	switch months := p.wholeMonths(); {
+	case months == 1:
+		return p.Start.Format("2006-01")
+	case months > 1:
+		return fmt.Sprintf("%s to %s", p.Start.Format("2006-01"), p.End.AddDate(0, 0, -1).Format("2006-01"))
+	default:
+		return fmt.Sprintf("%s to %s", p.Start.Format(dateLayout), p.End.AddDate(0, 0, -1).Format(dateLayout))
+	}
+}
+
+// StartDate returns the start date in the YYYY-MM-DD format used by Cost Explorer.
+func (p Period) StartDate() string { return p.Start.Format(dateLayout) }
+
+// EndDate returns the exclusive end date in the YYYY-MM-DD format used by Cost Explorer.
+func (p Period) EndDate() string { return p.End.Format(dateLayout) }
+
+// wholeMonths retorna quantos meses completos o período cobre, ou 0 se ele não
+// começa e termina no primeiro dia de um mês.
+func (p Period) wholeMonths() int {
+	if p.Start.Day() != 1 || p.End.Day() != 1 || !p.End.After(p.Start) {
+		return 0
+	}
+	return (p.End.Year()-p.Start.Year())*12 + int(p.End.Month()-p.Start.Month())
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
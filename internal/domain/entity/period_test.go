@@ -0,0 +1,245 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestPeriods(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name                string
+		period              Period
+		start, end          time.Time
+		days, lastDays      int
+		label, currName     string
+		prevStart, prevEnd  time.Time
+		prevLabel, prevName string
+		ongoing             bool
+	}{
+		{
+			name:   "current month",
+			period: CurrentMonthPeriod(now),
+			start:  date(2026, 10, 1), end: date(2026, 10, 16), days: 15,
+			label: "2026-10-01 to 2026-10-15", currName: "Current month's cost",
+			prevStart: date(2026, 9, 1), prevEnd: date(2026, 10, 1),
+			prevLabel: "2026-09", prevName: "Last month's cost",
+			ongoing: true,
+		},
+		{
+			name:   "current month on its first day",
+			period: CurrentMonthPeriod(date(2026, 10, 1)),
+			start:  date(2026, 10, 1), end: date(2026, 10, 2), days: 1,
+			label: "2026-10-01 to 2026-10-01", currName: "Current month's cost",
+			prevStart: date(2026, 9, 1), prevEnd: date(2026, 10, 1),
+			prevLabel: "2026-09", prevName: "Last month's cost",
+		},
+		{
+			name:   "current month in january",
+			period: CurrentMonthPeriod(date(2027, 1, 10)),
+			start:  date(2027, 1, 1), end: date(2027, 1, 10), days: 9,
+			label: "2027-01-01 to 2027-01-09", currName: "Current month's cost",
+			prevStart: date(2026, 12, 1), prevEnd: date(2027, 1, 1),
+			prevLabel: "2026-12", prevName: "Last month's cost",
+			ongoing: true,
+		},
+		{
+			name:   "last days",
+			period: LastDaysPeriod(now, 7),
+			start:  date(2026, 10, 9), end: date(2026, 10, 16), days: 7, lastDays: 7,
+			label: "2026-10-09 to 2026-10-15", currName: "Current 7 days cost",
+			prevStart: date(2026, 10, 2), prevEnd: date(2026, 10, 9),
+			prevLabel: "2026-10-02 to 2026-10-08", prevName: "Previous 7 days cost",
+			ongoing: true,
+		},
+		{
+			name:   "last days across a year boundary",
+			period: LastDaysPeriod(date(2027, 1, 5), 10),
+			start:  date(2026, 12, 26), end: date(2027, 1, 5), days: 10, lastDays: 10,
+			label: "2026-12-26 to 2027-01-04", currName: "Current 10 days cost",
+			prevStart: date(2026, 12, 16), prevEnd: date(2026, 12, 26),
+			prevLabel: "2026-12-16 to 2026-12-25", prevName: "Previous 10 days cost",
+			ongoing: true,
+		},
+		{
+			name:   "calendar month",
+			period: MonthPeriod(2026, time.August),
+			start:  date(2026, 8, 1), end: date(2026, 9, 1), days: 31,
+			label: "2026-08", currName: "2026-08 cost",
+			prevStart: date(2026, 7, 1), prevEnd: date(2026, 8, 1),
+			prevLabel: "2026-07", prevName: "2026-07 cost",
+		},
+		{
+			name:   "february of a leap year",
+			period: MonthPeriod(2024, time.February),
+			start:  date(2024, 2, 1), end: date(2024, 3, 1), days: 29,
+			label: "2024-02", currName: "2024-02 cost",
+			prevStart: date(2024, 1, 1), prevEnd: date(2024, 2, 1),
+			prevLabel: "2024-01", prevName: "2024-01 cost",
+		},
+		{
+			name:   "january compares with december",
+			period: MonthPeriod(2026, time.January),
+			start:  date(2026, 1, 1), end: date(2026, 2, 1), days: 31,
+			label: "2026-01", currName: "2026-01 cost",
+			prevStart: date(2025, 12, 1), prevEnd: date(2026, 1, 1),
+			prevLabel: "2025-12", prevName: "2025-12 cost",
+		},
+		{
+			name:   "date range of whole months across a year",
+			period: DateRangePeriod(date(2025, 11, 1), date(2026, 2, 28)),
+			start:  date(2025, 11, 1), end: date(2026, 3, 1), days: 120,
+			label: "2025-11 to 2026-02", currName: "2025-11 to 2026-02 cost",
+			prevStart: date(2025, 7, 1), prevEnd: date(2025, 11, 1),
+			prevLabel: "2025-07 to 2025-10", prevName: "2025-07 to 2025-10 cost",
+		},
+		{
+			name:   "date range within a month",
+			period: DateRangePeriod(date(2026, 7, 1), date(2026, 7, 15)),
+			start:  date(2026, 7, 1), end: date(2026, 7, 16), days: 15,
+			label: "2026-07-01 to 2026-07-15", currName: "2026-07-01 to 2026-07-15 cost",
+			prevStart: date(2026, 6, 16), prevEnd: date(2026, 7, 1),
+			prevLabel: "2026-06-16 to 2026-06-30", prevName: "2026-06-16 to 2026-06-30 cost",
+		},
+		{
+			name:   "single day range until today",
+			period: DateRangePeriod(date(2026, 10, 15), date(2026, 10, 15)),
+			start:  date(2026, 10, 15), end: date(2026, 10, 16), days: 1,
+			label: "2026-10-15 to 2026-10-15", currName: "2026-10-15 to 2026-10-15 cost",
+			prevStart: date(2026, 10, 14), prevEnd: date(2026, 10, 15),
+			prevLabel: "2026-10-14 to 2026-10-14", prevName: "2026-10-14 to 2026-10-14 cost",
+			ongoing: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.period
+			if !p.Start.Equal(tt.start) || !p.End.Equal(tt.end) {
+				t.Errorf("expected %s to %s, got %s to %s", tt.start, tt.end, p.Start, p.End)
+			}
+			if got := p.Days(); got != tt.days {
+				t.Errorf("expected %d days, got %d", tt.days, got)
+			}
+			if got := p.LastDays(); got != tt.lastDays {
+				t.Errorf("expected LastDays %d, got %d", tt.lastDays, got)
+			}
+			if got := p.Label(); got != tt.label {
+				t.Errorf("expected label %q, got %q", tt.label, got)
+			}
+			if got := p.Name("cost"); got != tt.currName {
+				t.Errorf("expected name %q, got %q", tt.currName, got)
+			}
+			if got := p.IsOngoing(now); got != tt.ongoing {
+				t.Errorf("expected IsOngoing %v, got %v", tt.ongoing, got)
+			}
+
+			prev := p.Previous()
+			if !prev.Start.Equal(tt.prevStart) || !prev.End.Equal(tt.prevEnd) {
+				t.Errorf("expected previous %s to %s, got %s to %s", tt.prevStart, tt.prevEnd, prev.Start, prev.End)
+			}
+			if got := prev.Label(); got != tt.prevLabel {
+				t.Errorf("expected previous label %q, got %q", tt.prevLabel, got)
+			}
+			if got := prev.Name("cost"); got != tt.prevName {
+				t.Errorf("expected previous name %q, got %q", tt.prevName, got)
+			}
+		})
+	}
+}
+
+func TestWholeMonths(t *testing.T) {
+	tests := []struct {
+		name   string
+		period Period
+		want   int
+	}{
+		{name: "one month", period: MonthPeriod(2026, time.August), want: 1},
+		{name: "across a year", period: DateRangePeriod(date(2025, 12, 1), date(2026, 1, 31)), want: 2},
+		{name: "full year", period: DateRangePeriod(date(2025, 1, 1), date(2025, 12, 31)), want: 12},
+		{name: "starts mid month", period: DateRangePeriod(date(2026, 7, 2), date(2026, 8, 31)), want: 0},
+		{name: "ends mid month", period: DateRangePeriod(date(2026, 7, 1), date(2026, 8, 30)), want: 0},
+		{name: "current month", period: CurrentMonthPeriod(date(2026, 10, 16)), want: 0},
+		{name: "empty", period: Period{Start: date(2026, 7, 1), End: date(2026, 7, 1)}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.wholeMonths(); got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseDateRangePeriod(t *testing.T) {
+	tests := []struct {
+		name       string
+		from, to   string
+		start, end time.Time
+		wantErr    string
+	}{
+		{name: "range", from: "2026-07-01", to: "2026-07-15", start: date(2026, 7, 1), end: date(2026, 7, 16)},
+		{name: "same day", from: "2026-07-01", to: "2026-07-01", start: date(2026, 7, 1), end: date(2026, 7, 2)},
+		{name: "end of year", from: "2026-12-01", to: "2026-12-31", start: date(2026, 12, 1), end: date(2027, 1, 1)},
+		{name: "to before from", from: "2026-07-15", to: "2026-07-01", wantErr: "to date 2026-07-01 is before from date 2026-07-15"},
+		{name: "invalid from", from: "2026-7-1", to: "2026-07-15", wantErr: `from date "2026-7-1"`},
+		{name: "invalid to", from: "2026-07-01", to: "2026-02-30", wantErr: `to date "2026-02-30"`},
+		{name: "empty", from: "", to: "2026-07-15", wantErr: `from date ""`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseDateRangePeriod(tt.from, tt.to)
+			if tt.wantErr != "" {
+				if !errors.Is(err, ErrInvalidPeriod) {
+					t.Fatalf("expected ErrInvalidPeriod, got %v", err)
+				}
+				if !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error to contain %q, got %q", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !p.Start.Equal(tt.start) || !p.End.Equal(tt.end) {
+				t.Errorf("expected %s to %s, got %s to %s", tt.start, tt.end, p.Start, p.End)
+			}
+		})
+	}
+}
+
+func TestParseMonthPeriod(t *testing.T) {
+	tests := []struct {
+		name       string
+		month      string
+		start, end time.Time
+		wantErr    bool
+	}{
+		{name: "month", month: "2026-08", start: date(2026, 8, 1), end: date(2026, 9, 1)},
+		{name: "december", month: "2026-12", start: date(2026, 12, 1), end: date(2027, 1, 1)},
+		{name: "invalid month", month: "2026-13", wantErr: true},
+		{name: "with day", month: "2026-08-01", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseMonthPeriod(tt.month)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidPeriod) {
+					t.Errorf("expected ErrInvalidPeriod, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !p.Start.Equal(tt.start) || !p.End.Equal(tt.end) {
+				t.Errorf("expected %s to %s, got %s to %s", tt.start, tt.end, p.Start, p.End)
+			}
+		})
+	}
+}
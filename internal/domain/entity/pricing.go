@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidPriceList is returned when an offline price list cannot be used.
+var ErrInvalidPriceList = errors.New("invalid price list")
+
+// Linha de base incluída no preço do armazenamento gp3; só o que passa dela é cobrado.
+const (
+	gp3BaselineIOPS       = 3000
+	gp3BaselineThroughput = 125
+)
+
+// RegionPrices are the on-demand list prices (USD) used to estimate the cost of idle resources in a region.
+type RegionPrices struct {
+	// EBSStorageGBMonth é o preço por GB-mês, por tipo de volume (gp2, gp3, io1, io2, st1, sc1, standard).
+	EBSStorageGBMonth map[string]float64 `json:"ebs_gb_month,omitempty"`
+	// EBSIOPSMonth é o preço por IOPS provisionado-mês, por tipo de volume.
+	EBSIOPSMonth map[string]float64 `json:"ebs_iops_month,omitempty"`
+	// EBSThroughputMiBpsMonth é o preço por MiB/s provisionado-mês, por tipo de volume.
+	EBSThroughputMiBpsMonth map[string]float64 `json:"ebs_throughput_mibps_month,omitempty"`
+	// ElasticIPHour é o preço por hora de um endereço IPv4 público ocioso.
+	ElasticIPHour float64 `json:"eip_hour,omitempty"`
+	// LoadBalancerHour é o preço por hora, por tipo de load balancer (application, network, gateway).
+	LoadBalancerHour map[string]float64 `json:"load_balancer_hour,omitempty"`
+}
+
+// PriceList maps a region code (e.g. "us-east-1") to its prices.
+type PriceList map[string]RegionPrices
+
+// Validate rejects negative prices.
+func (p PriceList) Validate() error {
+	for region, rp := range p {
+		for _, m := range []map[string]float64{rp.EBSStorageGBMonth, rp.EBSIOPSMonth, rp.EBSThroughputMiBpsMonth, rp.LoadBalancerHour} {
+			for key, price := range m {
+				if price < 0 {
+					return fmt.Errorf("%w: negative price for %s in %s", ErrInvalidPriceList, key, region)
+				}
+			}
+		}
+		if rp.ElasticIPHour < 0 {
+			return fmt.Errorf("%w: negative Elastic IP price in %s", ErrInvalidPriceList, region)
+		}
+	}
+	return nil
+}
+
+// VolumeMonthlyCost estimates the monthly cost of an EBS volume: storage plus provisioned IOPS and
+// throughput above the baseline included in the price. O segundo retorno é false quando a região ou o
+// tipo do volume não estão na lista.
+func (p PriceList) VolumeMonthlyCost(region string, v EBSVolume) (float64, bool) {
+	rp, ok := p[region]
+	if !ok {
+		return 0, false
+	}
+	storage, ok := rp.EBSStorageGBMonth[v.Type]
+	if !ok {
+		return 0, false
+	}
+	cost := storage * float64(v.SizeGiB)
+
+	iops, throughput := float64(v.IOPS), float64(v.ThroughputMiBps)
+	if v.Type == "gp3" {
+		iops = max(iops-gp3BaselineIOPS, 0)
+		throughput = max(throughput-gp3BaselineThroughput, 0)
+	}
+	cost += iops * rp.EBSIOPSMonth[v.Type]
+	cost += throughput * rp.EBSThroughputMiBpsMonth[v.Type]
+	return cost, true
+}
+
+// ElasticIPMonthlyCost estimates the monthly cost of an unassociated Elastic IP.
+func (p PriceList) ElasticIPMonthlyCost(region string) (float64, bool) {
+	rp, ok := p[region]
+	if !ok || rp.ElasticIPHour == 0 {
+		return 0, false
+	}
+	return rp.ElasticIPHour * HoursPerMonth, true
+}
+
+// LoadBalancerMonthlyCost estimates the fixed monthly cost of a load balancer, without capacity units.
+func (p PriceList) LoadBalancerMonthlyCost(region, lbType string) (float64, bool) {
+	price, ok := p[region].LoadBalancerHour[lbType]
+	if !ok {
+		return 0, false
+	}
+	return price * HoursPerMonth, true
+}
+
+// SavingsEstimate is the estimated monthly cost of the idle resources found by an audit, i.e. what
+// removing them would save.
+type SavingsEstimate struct {
+	// Source é a origem dos preços: a AWS Pricing API ou o arquivo de --price-list.
+	Source            string  `json:"source"`
+	StoppedInstances  float64 `json:"stopped_instances"`
+	UnusedVolumes     float64 `json:"unused_volumes"`
+	UnusedEIPs        float64 `json:"unused_eips"`
+	IdleLoadBalancers float64 `json:"idle_load_balancers"`
+	// Unpriced conta os recursos cujo tipo ou região não constam da lista de preços.
+	Unpriced int `json:"unpriced,omitempty"`
+}
+
+// Total returns the potential monthly savings across all checks.
+func (s SavingsEstimate) Total() float64 {
+	return s.StoppedInstances + s.UnusedVolumes + s.UnusedEIPs + s.IdleLoadBalancers
+}
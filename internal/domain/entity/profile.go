@@ -8,6 +8,12 @@ type ProfileData struct {
 	// AccountID é o ID da conta AWS associada.
 	AccountID string `json:"account_id"`
 
+	// AccountName é o nome da conta-membro no modo organização (--org).
+	AccountName string `json:"account_name,omitempty"`
+
+	// OUPath é o caminho da unidade organizacional da conta-membro no modo organização.
+	OUPath string `json:"ou_path,omitempty"`
+
 	// LastMonth é o custo total do período anterior.
 	LastMonth float64 `json:"last_month"`
 
@@ -20,6 +26,15 @@ type ProfileData struct {
 	// ServiceCostsFormatted é uma lista de strings prontas para exibição na UI.
 	ServiceCostsFormatted []string `json:"-"` // Omitido do JSON por ser um dado de apresentação
 
+	// Forecast é a previsão de custo para o fim do mês; nula quando o período não chega até hoje.
+	Forecast *CostForecast `json:"forecast,omitempty"`
+
+	// ExcludedCosts são os valores removidos do total por --exclude (créditos, reembolsos, impostos, suporte).
+	ExcludedCosts []ServiceCost `json:"excluded_costs,omitempty"`
+
+	// Budgets contém os dados brutos dos orçamentos da conta (gasto real vs limite).
+	Budgets []BudgetInfo `json:"budgets,omitempty"`
+
 	// BudgetInfo é uma lista de strings formatadas sobre orçamentos para a UI.
 	BudgetInfo []string `json:"-"` // Omitido do JSON
 
@@ -42,6 +57,12 @@ type ProfileData struct {
 	// PreviousPeriodName é o nome descritivo do período de custo anterior.
 	PreviousPeriodName string `json:"previous_period_name"`
 
+	// Metric é a métrica do Cost Explorer usada nos custos (ex: "AmortizedCost").
+	Metric CostMetric `json:"metric"`
+
+	// GroupBy é o agrupamento usado em ServiceCosts (ex: SERVICE ou TAG:Team, REGION).
+	GroupBy Grouping `json:"group_by,omitempty"`
+
 	// PercentChangeInCost armazena a variação percentual do custo entre os períodos.
 	PercentChangeInCost *float64 `json:"percent_change_in_total_cost,omitempty"`
 }
@@ -0,0 +1,183 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidPurchaseOption is returned when a term, payment option or lookback period is not recognized.
+var ErrInvalidPurchaseOption = errors.New("invalid purchase recommendation option")
+
+// HoursPerMonth é a média de horas por mês usada pela AWS na precificação de compromissos.
+const HoursPerMonth = 730
+
+// CommitmentTerm is the term of a Savings Plan or reservation.
+type CommitmentTerm string
+
+// Prazos de compromisso suportados.
+const (
+	TermOneYear    CommitmentTerm = "1y"
+	TermThreeYears CommitmentTerm = "3y"
+)
+
+// Months returns the length of the term in months.
+func (t CommitmentTerm) Months() int {
+	if t == TermThreeYears {
+		return 36
+	}
+	return 12
+}
+
+// PaymentOption is how a Savings Plan or reservation is paid.
+type PaymentOption string
+
+// Formas de pagamento suportadas.
+const (
+	PaymentNoUpfront      PaymentOption = "no-upfront"
+	PaymentPartialUpfront PaymentOption = "partial-upfront"
+	PaymentAllUpfront     PaymentOption = "all-upfront"
+)
+
+// DefaultLookbackDays é o período de análise padrão; o Cost Explorer aceita 7, 30 ou 60 dias.
+const DefaultLookbackDays = 30
+
+var validLookbackDays = []int{7, 30, 60}
+
+// PurchaseOptions are the parameters of the Savings Plans and RI purchase recommendations.
+type PurchaseOptions struct {
+	Term         CommitmentTerm `json:"term"`
+	Payment      PaymentOption  `json:"payment_option"`
+	LookbackDays int            `json:"lookback_days"`
+}
+
+// ParsePurchaseOptions parses the term ("1y", "3y"), the payment option ("no-upfront",
+// "partial-upfront", "all-upfront") and the lookback period (7, 30 or 60 days).
+// Valores vazios ou zero equivalem a 1 ano, sem pagamento adiantado e 30 dias.
+func ParsePurchaseOptions(term, payment string, lookbackDays int) (PurchaseOptions, error) {
+	opts := PurchaseOptions{Term: TermOneYear, Payment: PaymentNoUpfront, LookbackDays: DefaultLookbackDays}
+
+	switch strings.ToLower(strings.TrimSpace(term)) {
+	case "", "1", "1y", "1yr", "1-year":
+	case "3", "3y", "3yr", "3-years":
+		opts.Term = TermThreeYears
+	default:
+		return PurchaseOptions{}, fmt.Errorf("%w: term %q (valid: 1y, 3y)", ErrInvalidPurchaseOption, term)
+	}
+
+	switch option := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(payment)), "_", "-"); option {
+	case "":
+	case string(PaymentNoUpfront), string(PaymentPartialUpfront), string(PaymentAllUpfront):
+		opts.Payment = PaymentOption(option)
+	default:
+		return PurchaseOptions{}, fmt.Errorf("%w: payment option %q (valid: no-upfront, partial-upfront, all-upfront)", ErrInvalidPurchaseOption, payment)
+	}
+
+	if lookbackDays != 0 {
+		valid := false
+		for _, d := range validLookbackDays {
+			valid = valid || d == lookbackDays
+		}
+		if !valid {
+			return PurchaseOptions{}, fmt.Errorf("%w: lookback period of %d days (valid: 7, 30, 60)", ErrInvalidPurchaseOption, lookbackDays)
+		}
+		opts.LookbackDays = lookbackDays
+	}
+	return opts, nil
+}
+
+// Label returns a human-readable description of the options, e.g. "1 year, no upfront, 30-day lookback".
+func (o PurchaseOptions) Label() string {
+	term := "1 year"
+	if o.Term == TermThreeYears {
+		term = "3 years"
+	}
+	return fmt.Sprintf("%s, %s, %d-day lookback", term, strings.ReplaceAll(string(o.Payment), "-", " "), o.LookbackDays)
+}
+
+// Tipos de recomendação de compra.
+const (
+	PurchaseSavingsPlans      = "Savings Plans"
+	PurchaseReservedInstances = "Reserved Instances"
+)
+
+// PurchaseRecommendation is a Cost Explorer recommendation to buy a Savings Plan or reservations.
+type PurchaseRecommendation struct {
+	Kind string `json:"kind"`
+	// Type é o tipo de Savings Plan (ex: "Compute") ou o serviço da reserva (ex: "Amazon RDS").
+	Type string `json:"type"`
+	// Details descreve o que é comprado: tipo de instância, região, plataforma...
+	Details string `json:"details,omitempty"`
+	// Quantity é o número de instâncias reservadas; zero para Savings Plans.
+	Quantity int `json:"quantity,omitempty"`
+
+	// HourlyCommitment é o compromisso por hora. Nas reservas, é o custo mensal (adiantado
+	// amortizado mais recorrente) dividido por HoursPerMonth.
+	HourlyCommitment        float64 `json:"hourly_commitment"`
+	UpfrontCost             float64 `json:"upfront_cost"`
+	EstimatedMonthlySavings float64 `json:"estimated_monthly_savings"`
+	EstimatedSavingsPercent float64 `json:"estimated_savings_percent"`
+	// EstimatedROI é a economia dividida pelo custo do compromisso, em %.
+	EstimatedROI    float64 `json:"estimated_roi_percent"`
+	BreakEvenMonths float64 `json:"break_even_months"`
+}
+
+// PurchaseRecommendations groups the Savings Plans and RI purchase recommendations of an account.
+// As duas listas são alternativas para o mesmo uso (ex: EC2) e não devem ser somadas.
+type PurchaseRecommendations struct {
+	Options           PurchaseOptions          `json:"options"`
+	SavingsPlans      []PurchaseRecommendation `json:"savings_plans"`
+	ReservedInstances []PurchaseRecommendation `json:"reserved_instances"`
+	// Unavailable lista os tipos cuja consulta falhou; as listas acima trazem só os demais.
+	Unavailable []UnavailableRecommendation `json:"unavailable,omitempty"`
+}
+
+// UnavailableRecommendation is a Savings Plan type or reservation service whose recommendations could not be fetched.
+type UnavailableRecommendation struct {
+	Kind  string `json:"kind"`
+	Type  string `json:"type"`
+	Error string `json:"error"`
+}
+
+// UnavailableOf returns the unavailable recommendation types of the given kind.
+func (p PurchaseRecommendations) UnavailableOf(kind string) []UnavailableRecommendation {
+	var out []UnavailableRecommendation
+	for _, u := range p.Unavailable {
+		if u.Kind == kind {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+// SavingsPlansMonthlySavings returns the estimated monthly savings of all Savings Plans recommendations.
+func (p PurchaseRecommendations) SavingsPlansMonthlySavings() float64 {
+	return sumMonthlySavings(p.SavingsPlans)
+}
+
+// ReservedInstancesMonthlySavings returns the estimated monthly savings of all RI recommendations.
+func (p PurchaseRecommendations) ReservedInstancesMonthlySavings() float64 {
+	return sumMonthlySavings(p.ReservedInstances)
+}
+
+func sumMonthlySavings(recs []PurchaseRecommendation) float64 {
+	total := 0.0
+	for _, r := range recs {
+		total += r.EstimatedMonthlySavings
+	}
+	return total
+}
+
+// BreakEvenMonths returns the number of months until the savings of a commitment pay for its upfront cost.
+// Com custo adiantado U, economia mensal S (já descontada a amortização de U) e prazo de T meses,
+// o gasto sob demanda evitado por mês é S + U/T, e o ponto de equilíbrio é U / (S + U/T).
+func BreakEvenMonths(upfront, monthlySavings float64, term CommitmentTerm) float64 {
+	if upfront <= 0 {
+		return 0
+	}
+	avoided := monthlySavings + upfront/float64(term.Months())
+	if avoided <= 0 {
+		return float64(term.Months())
+	}
+	return upfront / avoided
+}
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidRecordType is returned when an --exclude option is not recognized.
+var ErrInvalidRecordType = errors.New("invalid record type")
+
+// RecordType is a value of the Cost Explorer RECORD_TYPE dimension.
+type RecordType string
+
+// Tipos de registro que podem ser excluídos dos totais.
+const (
+	RecordTypeCredit  RecordType = "Credit"
+	RecordTypeRefund  RecordType = "Refund"
+	RecordTypeTax     RecordType = "Tax"
+	RecordTypeSupport RecordType = "Support"
+)
+
+var recordTypesByOption = map[string]RecordType{
+	"credits": RecordTypeCredit,
+	"refunds": RecordTypeRefund,
+	"tax":     RecordTypeTax,
+	"support": RecordTypeSupport,
+}
+
+// ParseRecordTypes parses --exclude options such as "credits" or "tax". O singular
+// ("credit", "refund") e os nomes do Cost Explorer ("Credit") também são aceitos.
+func ParseRecordTypes(options []string) ([]RecordType, error) {
+	var types []RecordType
+	seen := make(map[RecordType]bool)
+	for _, o := range options {
+		option := strings.ToLower(strings.TrimSpace(o))
+		if option == "" {
+			continue
+		}
+		t, ok := recordTypesByOption[option]
+		if !ok {
+			t, ok = recordTypesByOption[option+"s"]
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: %q (valid: credits, refunds, tax, support)", ErrInvalidRecordType, o)
+		}
+		if !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+	return types, nil
+}
+
+// RecordTypesLabel returns the record types as a display list, e.g. "Credit, Tax".
+func RecordTypesLabel(types []RecordType) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+	return strings.Join(names, ", ")
+}
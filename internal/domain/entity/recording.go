@@ -0,0 +1,10 @@
+package entity
+
+import "errors"
+
+var (
+	// ErrInvalidRecording is returned when a --replay directory is not a recording made with --record.
+	ErrInvalidRecording = errors.New("invalid recording")
+	// ErrNotRecorded is returned in replay mode for an AWS request that is not in the recording.
+	ErrNotRecorded = errors.New("no recorded response")
+)
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// CostExplorerRequestPrice is the price of one Cost Explorer API request, in USD.
+const CostExplorerRequestPrice = 0.01
+
+// ResponseCacheOptions configures the on-disk cache of Cost Explorer responses.
+type ResponseCacheOptions struct {
+	Dir string
+	// TTL é a validade de uma resposta; respostas de outro dia (UTC) nunca são reaproveitadas.
+	TTL time.Duration
+}
+
+// ResponseCacheStats counts the Cost Explorer requests answered by the response cache in a run.
+type ResponseCacheStats struct {
+	Hits   int
+	Misses int
+	// HitsByOperation conta as respostas reaproveitadas por operação (ex: GetCostAndUsage).
+	HitsByOperation map[string]int
+}
+
+// SavedCost returns the estimated cost of the requests answered by the cache.
+func (s ResponseCacheStats) SavedCost() float64 {
+	return float64(s.Hits) * CostExplorerRequestPrice
+}
+
+// Operations returns the operations with cache hits, in alphabetical order.
+func (s ResponseCacheStats) Operations() []string {
+	ops := make([]string, 0, len(s.HitsByOperation))
+	for op := range s.HitsByOperation {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	return ops
+}
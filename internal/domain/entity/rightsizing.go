@@ -0,0 +1,50 @@
+package entity
+
+// Ações de rightsizing recomendadas pelo Cost Explorer.
+const (
+	RightsizingTerminate = "Terminate"
+	RightsizingModify    = "Modify"
+)
+
+// RightsizingRecommendation is a Cost Explorer recommendation to terminate or downsize an EC2 instance.
+type RightsizingRecommendation struct {
+	AccountID    string `json:"account_id"`
+	Region       string `json:"region"`
+	InstanceID   string `json:"instance_id"`
+	InstanceName string `json:"instance_name,omitempty"`
+	Action       string `json:"action"`
+	CurrentType  string `json:"current_type"`
+	// TargetType fica vazio nas recomendações de encerramento.
+	TargetType string `json:"target_type,omitempty"`
+
+	CurrentMonthlyCost      float64 `json:"current_monthly_cost"`
+	EstimatedMonthlySavings float64 `json:"estimated_monthly_savings"`
+
+	// Utilização máxima no período de análise, em %. Memória e disco dependem do CloudWatch agent
+	// e ficam nulos sem ele.
+	MaxCPUUtilization     *float64 `json:"max_cpu_utilization,omitempty"`
+	MaxMemoryUtilization  *float64 `json:"max_memory_utilization,omitempty"`
+	MaxStorageUtilization *float64 `json:"max_storage_utilization,omitempty"`
+
+	// FindingReasons são os motivos da recomendação (ex: CPU_OVER_PROVISIONED).
+	FindingReasons []string `json:"finding_reasons,omitempty"`
+}
+
+// RightsizingReport lists the EC2 rightsizing recommendations of a profile, ordered by savings.
+type RightsizingReport struct {
+	Profile         string                      `json:"profile"`
+	AccountID       string                      `json:"account_id"`
+	Recommendations []RightsizingRecommendation `json:"recommendations"`
+	TotalSavings    float64                     `json:"total_estimated_monthly_savings"`
+}
+
+// Count returns the number of recommendations with the given action.
+func (r RightsizingReport) Count(action string) int {
+	n := 0
+	for _, rec := range r.Recommendations {
+		if rec.Action == action {
+			n++
+		}
+	}
+	return n
+}
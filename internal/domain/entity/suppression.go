@@ -0,0 +1,132 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidSuppression is returned when a suppressions file entry cannot be used.
+var ErrInvalidSuppression = errors.New("invalid suppression")
+
+// Suppression is an allowlist entry: audit findings that match every criterion set in it are reported
+// as suppressed instead of counted, e.g. a load balancer kept idle as a DR standby.
+type Suppression struct {
+	Check      string `json:"check,omitempty" yaml:"check,omitempty" toml:"check,omitempty"`
+	ResourceID string `json:"resource_id,omitempty" yaml:"resource_id,omitempty" toml:"resource_id,omitempty"`
+	// ARN aceita os curingas "*" (qualquer sequência, inclusive "/") e "?" (um caractere).
+	ARN       string `json:"arn,omitempty" yaml:"arn,omitempty" toml:"arn,omitempty"`
+	AccountID string `json:"account_id,omitempty" yaml:"account_id,omitempty" toml:"account_id,omitempty"`
+	Region    string `json:"region,omitempty" yaml:"region,omitempty" toml:"region,omitempty"`
+	// Tags exige todas as tags informadas; os valores aceitam os mesmos curingas do ARN.
+	Tags map[string]string `json:"tags,omitempty" yaml:"tags,omitempty" toml:"tags,omitempty"`
+
+	Reason string `json:"reason" yaml:"reason" toml:"reason"`
+	// Expires é o último dia (YYYY-MM-DD) em que a supressão vale; vazio não expira.
+	Expires string `json:"expires,omitempty" yaml:"expires,omitempty" toml:"expires,omitempty"`
+}
+
+// SuppressedFinding is a finding hidden by a suppression, with the reason it was suppressed.
+type SuppressedFinding struct {
+	Finding
+	Reason  string `json:"suppression_reason"`
+	Expires string `json:"suppression_expires,omitempty"`
+}
+
+// Validate requires a reason and at least one criterion, and checks the expiry date and ARN pattern.
+func (s Suppression) Validate() error {
+	if s.Reason == "" {
+		return fmt.Errorf("%w: reason is required", ErrInvalidSuppression)
+	}
+	if s.Check == "" && s.ResourceID == "" && s.ARN == "" && s.AccountID == "" && s.Region == "" && len(s.Tags) == 0 {
+		return fmt.Errorf("%w: %q must match on check, resource_id, arn, account_id, region or tags", ErrInvalidSuppression, s.Reason)
+	}
+	if s.Expires != "" {
+		if _, err := time.Parse(dateLayout, s.Expires); err != nil {
+			return fmt.Errorf("%w: expires %q must be in the YYYY-MM-DD format", ErrInvalidSuppression, s.Expires)
+		}
+	}
+	return nil
+}
+
+// Expired reports whether the suppression's last day is before now.
+func (s Suppression) Expired(now time.Time) bool {
+	// Datas no formato YYYY-MM-DD se ordenam como texto.
+	return s.Expires != "" && now.Format(dateLayout) > s.Expires
+}
+
+// Matches reports whether the finding meets every criterion of the suppression.
+func (s Suppression) Matches(f Finding) bool {
+	if s.Check != "" && s.Check != f.CheckID {
+		return false
+	}
+	if s.ResourceID != "" && s.ResourceID != f.ResourceID {
+		return false
+	}
+	if s.ARN != "" && !globMatch(s.ARN, f.ARN) {
+		return false
+	}
+	if s.AccountID != "" && s.AccountID != f.AccountID {
+		return false
+	}
+	if s.Region != "" && s.Region != f.Region {
+		return false
+	}
+	for key, pattern := range s.Tags {
+		value, ok := f.Tags[key]
+		if !ok || !globMatch(pattern, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// SuppressFindings separa os achados cobertos por alguma supressão; vale a primeira que combinar.
+func SuppressFindings(findings []Finding, suppressions []Suppression) ([]Finding, []SuppressedFinding) {
+	if len(suppressions) == 0 {
+		return findings, nil
+	}
+	kept := make([]Finding, 0, len(findings))
+	var suppressed []SuppressedFinding
+	for _, f := range findings {
+		matched := false
+		for _, s := range suppressions {
+			if s.Matches(f) {
+				suppressed = append(suppressed, SuppressedFinding{Finding: f, Reason: s.Reason, Expires: s.Expires})
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			kept = append(kept, f)
+		}
+	}
+	return kept, suppressed
+}
+
+// globMatch compara s com um padrão em que "*" casa qualquer sequência e "?" um único caractere.
+func globMatch(pattern, s string) bool {
+	p, i := 0, 0
+	star, mark := -1, 0
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, i
+			p++
+		case star >= 0:
+			// Retrocede: o último "*" passa a cobrir mais um caractere.
+			p = star + 1
+			mark++
+			i = mark
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
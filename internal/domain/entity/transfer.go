@@ -26,4 +26,6 @@ type DataTransferReport struct {
 	PeriodStart time.Time `json:"period_start"`
 	PeriodEnd   time.Time `json:"period_end"`
 	PeriodName  string    `json:"period_name"`
+
+	Metric CostMetric `json:"metric"`
 }
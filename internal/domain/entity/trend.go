@@ -0,0 +1,250 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrInvalidTrend is returned when the trend window, granularity or series are not valid.
+var ErrInvalidTrend = errors.New("invalid trend options")
+
+// Limites da análise de tendência.
+const (
+	DefaultTrendMonths = 6
+	// MaxTrendMonths é o histórico máximo do Cost Explorer com dados de vários anos habilitados.
+	MaxTrendMonths = 38
+	// MaxDailyTrendMonths é o histórico dos pontos diários (e semanais, somados a partir deles):
+	// o Cost Explorer só tem custos diários dos últimos 14 meses, e a janela começa no dia 1º.
+	MaxDailyTrendMonths = 13
+	// TrendMaxSeries é o número de séries exibidas; as demais são somadas em TrendOtherSeries.
+	TrendMaxSeries = 8
+	// TrendOtherSeries é o nome da série que soma as demais. Parênteses não são aceitos em valores
+	// de tag, e a série é identificada por CostTrend.OtherSeries, não pelo nome.
+	TrendOtherSeries = "(other)"
+)
+
+// TrendGranularity is the size of each point of a cost trend.
+type TrendGranularity string
+
+// Granularidades aceitas em --granularity.
+const (
+	TrendMonthly TrendGranularity = "monthly"
+	TrendWeekly  TrendGranularity = "weekly"
+	TrendDaily   TrendGranularity = "daily"
+)
+
+// ParseTrendGranularity parses a --granularity value. Vazio resulta em TrendMonthly.
+func ParseTrendGranularity(s string) (TrendGranularity, error) {
+	switch g := TrendGranularity(strings.ToLower(strings.TrimSpace(s))); g {
+	case "":
+		return TrendMonthly, nil
+	case TrendMonthly, TrendWeekly, TrendDaily:
+		return g, nil
+	default:
+		return "", fmt.Errorf("%w: granularity %q (valid: monthly, weekly, daily)", ErrInvalidTrend, s)
+	}
+}
+
+// MaxMonths returns the longest trend window, in months, that Cost Explorer serves at this granularity.
+func (g TrendGranularity) MaxMonths() int {
+	if g == TrendMonthly {
+		return MaxTrendMonths
+	}
+	return MaxDailyTrendMonths
+}
+
+// Unit returns the name of a trend point, e.g. "Month".
+func (g TrendGranularity) Unit() string {
+	switch g {
+	case TrendDaily:
+		return "Day"
+	case TrendWeekly:
+		return "Week"
+	default:
+		return "Month"
+	}
+}
+
+// bucketStart retorna o início do ponto que contém t: o primeiro dia do mês, a segunda-feira
+// da semana ou o próprio dia.
+func (g TrendGranularity) bucketStart(t time.Time) time.Time {
+	day := truncateDay(t)
+	switch g {
+	case TrendDaily:
+		return day
+	case TrendWeekly:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	default:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func (g TrendGranularity) next(t time.Time) time.Time {
+	switch g {
+	case TrendDaily:
+		return t.AddDate(0, 0, 1)
+	case TrendWeekly:
+		return t.AddDate(0, 0, 7)
+	default:
+		return t.AddDate(0, 1, 0)
+	}
+}
+
+func (g TrendGranularity) label(t time.Time) string {
+	switch g {
+	case TrendDaily:
+		return t.Format(dateLayout)
+	case TrendWeekly:
+		return "Wk " + t.Format(dateLayout)
+	default:
+		return t.Format("Jan 2006")
+	}
+}
+
+// TrendPeriod returns the window of a trend over the last n months: from the first day of
+// the month n months ago until today, so the current month is included month-to-date.
+// The window is limited to the history Cost Explorer serves at the granularity.
+func TrendPeriod(now time.Time, months int, granularity TrendGranularity) (Period, error) {
+	if maxMonths := granularity.MaxMonths(); months < 1 || months > maxMonths {
+		return Period{}, fmt.Errorf("%w: trend months must be between 1 and %d with %s granularity, got %d", ErrInvalidTrend, maxMonths, granularity, months)
+	}
+	today := truncateDay(now)
+	start := today.AddDate(0, -months, 0)
+	start = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return Period{Start: start, End: today, kind: periodCustom}, nil
+}
+
+// TrendSample is a cost returned by Cost Explorer for a day or month, optionally for a series.
+type TrendSample struct {
+	Date   time.Time
+	Series string
+	Cost   float64
+}
+
+// TrendPoint is the cost of one month, week or day of a trend.
+type TrendPoint struct {
+	Start time.Time `json:"start"`
+	Label string    `json:"label"`
+	Cost  float64   `json:"cost"`
+	// SeriesCosts tem o custo de cada série do ponto, na ordem de CostTrend.Series.
+	SeriesCosts []float64 `json:"series_costs,omitempty"`
+}
+
+// CostTrend is the cost history of a profile group, optionally split into stacked series.
+type CostTrend struct {
+	Profile     string           `json:"profile"`
+	AccountID   string           `json:"account_id"`
+	Metric      CostMetric       `json:"metric"`
+	Granularity TrendGranularity `json:"granularity"`
+	PeriodStart time.Time        `json:"period_start"`
+	PeriodEnd   time.Time        `json:"period_end"`
+	// SeriesBy é a dimensão das séries (SERVICE, TAG:Team...); nula sem séries.
+	SeriesBy *GroupDimension `json:"series_by,omitempty"`
+	Series   []string        `json:"series,omitempty"`
+	// OtherSeries indica que a última série é TrendOtherSeries, a soma das séries além de TrendMaxSeries.
+	OtherSeries bool         `json:"other_series,omitempty"`
+	Points      []TrendPoint `json:"points"`
+	// ExcludedCosts são os valores dos tipos de registro removidos dos pontos por --exclude, no período inteiro.
+	ExcludedCosts []ServiceCost `json:"excluded_costs,omitempty"`
+}
+
+// Total returns the cost of the whole trend window.
+func (t CostTrend) Total() float64 {
+	var total float64
+	for _, p := range t.Points {
+		total += p.Cost
+	}
+	return total
+}
+
+// IsOtherSeries reports whether the i-th series is the sum of the series beyond TrendMaxSeries.
+func (t CostTrend) IsOtherSeries(i int) bool {
+	return t.OtherSeries && i == len(t.Series)-1
+}
+
+// SeriesTotal returns the cost of the i-th series over the whole trend window.
+func (t CostTrend) SeriesTotal(i int) float64 {
+	var total float64
+	for _, p := range t.Points {
+		if i < len(p.SeriesCosts) {
+			total += p.SeriesCosts[i]
+		}
+	}
+	return total
+}
+
+// BuildCostTrend sums the samples into one point per month, week or day of the period.
+// Pontos sem custo valem zero. Quando há séries, as TrendMaxSeries de maior custo são mantidas,
+// em ordem decrescente, e as demais são somadas em TrendOtherSeries.
+func BuildCostTrend(granularity TrendGranularity, period Period, samples []TrendSample) CostTrend {
+	trend := CostTrend{Granularity: granularity, PeriodStart: period.Start, PeriodEnd: period.End}
+
+	index := make(map[time.Time]int)
+	for start := granularity.bucketStart(period.Start); start.Before(period.End); start = granularity.next(start) {
+		// O primeiro ponto semanal pode começar antes do período; o início exibido é o do período.
+		pointStart := start
+		if pointStart.Before(period.Start) {
+			pointStart = period.Start
+		}
+		index[start] = len(trend.Points)
+		trend.Points = append(trend.Points, TrendPoint{Start: pointStart, Label: granularity.label(pointStart)})
+	}
+
+	seriesTotals := make(map[string]float64)
+	for _, s := range samples {
+		i, ok := index[granularity.bucketStart(s.Date)]
+		if !ok {
+			continue
+		}
+		trend.Points[i].Cost += s.Cost
+		if s.Series != "" {
+			seriesTotals[s.Series] += s.Cost
+		}
+	}
+	if len(seriesTotals) == 0 {
+		return trend
+	}
+
+	names := make([]string, 0, len(seriesTotals))
+	for name := range seriesTotals {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if seriesTotals[names[i]] != seriesTotals[names[j]] {
+			return seriesTotals[names[i]] > seriesTotals[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	// Só agrupa em "Other" quando isso junta pelo menos duas séries.
+	if len(names) > TrendMaxSeries+1 {
+		names = append(names[:TrendMaxSeries], TrendOtherSeries)
+		trend.OtherSeries = true
+	}
+	trend.Series = names
+
+	// A série de outras fica fora de position, então uma série real com o mesmo nome não é somada nela por engano.
+	position := make(map[string]int, len(names))
+	for i, name := range names {
+		if !trend.IsOtherSeries(i) {
+			position[name] = i
+		}
+	}
+	for i := range trend.Points {
+		trend.Points[i].SeriesCosts = make([]float64, len(names))
+	}
+	for _, s := range samples {
+		i, ok := index[granularity.bucketStart(s.Date)]
+		if !ok || s.Series == "" {
+			continue
+		}
+		j, ok := position[s.Series]
+		if !ok {
+			j = len(names) - 1
+		}
+		trend.Points[i].SeriesCosts[j] += s.Cost
+	}
+	return trend
+}
@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTrendPeriod(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		months      int
+		granularity TrendGranularity
+		start       time.Time
+		wantErr     bool
+	}{
+		{name: "default window", months: DefaultTrendMonths, granularity: TrendMonthly, start: date(2026, 4, 1)},
+		{name: "monthly maximum", months: MaxTrendMonths, granularity: TrendMonthly, start: date(2023, 8, 1)},
+		{name: "monthly over maximum", months: MaxTrendMonths + 1, granularity: TrendMonthly, wantErr: true},
+		{name: "daily maximum", months: MaxDailyTrendMonths, granularity: TrendDaily, start: date(2025, 9, 1)},
+		{name: "daily beyond the daily history", months: 24, granularity: TrendDaily, wantErr: true},
+		{name: "weekly beyond the daily history", months: MaxDailyTrendMonths + 1, granularity: TrendWeekly, wantErr: true},
+		{name: "zero months", months: 0, granularity: TrendMonthly, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := TrendPeriod(now, tt.months, tt.granularity)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidTrend) {
+					t.Errorf("expected ErrInvalidTrend, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !p.Start.Equal(tt.start) || !p.End.Equal(date(2026, 10, 16)) {
+				t.Errorf("expected %s to 2026-10-16, got %s to %s", tt.start, p.Start, p.End)
+			}
+		})
+	}
+}
+
+func TestBuildCostTrendOtherSeries(t *testing.T) {
+	period := MonthPeriod(2026, time.August)
+	day := date(2026, 8, 10)
+
+	var samples []TrendSample
+	for i := 0; i < TrendMaxSeries; i++ {
+		samples = append(samples, TrendSample{Date: day, Series: fmt.Sprintf("s%d", i), Cost: float64(100 - i)})
+	}
+	// Duas séries pequenas, uma delas com o nome da série de outras.
+	samples = append(samples,
+		TrendSample{Date: day, Series: TrendOtherSeries, Cost: 2},
+		TrendSample{Date: day, Series: "Other", Cost: 1},
+	)
+
+	trend := BuildCostTrend(TrendMonthly, period, samples)
+	if len(trend.Series) != TrendMaxSeries+1 || !trend.OtherSeries {
+		t.Fatalf("expected %d series with the other series, got %q (other: %v)", TrendMaxSeries+1, trend.Series, trend.OtherSeries)
+	}
+	if !trend.IsOtherSeries(TrendMaxSeries) || trend.IsOtherSeries(0) {
+		t.Errorf("only the last series must be the other series")
+	}
+	if got := trend.SeriesTotal(TrendMaxSeries); got != 3 {
+		t.Errorf("expected the other series to sum 3, got %v", got)
+	}
+	if got := trend.Total(); got != 3+float64(100+93)*TrendMaxSeries/2 {
+		t.Errorf("unexpected total %v", got)
+	}
+
+	// Com uma única série além do limite, ela aparece com o próprio nome.
+	trend = BuildCostTrend(TrendMonthly, period, samples[:TrendMaxSeries+1])
+	if trend.OtherSeries || !slices.Contains(trend.Series, TrendOtherSeries) {
+		t.Errorf("expected a real series named %q, got %q (other: %v)", TrendOtherSeries, trend.Series, trend.OtherSeries)
+	}
+}
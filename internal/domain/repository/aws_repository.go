@@ -11,14 +11,28 @@ type AWSRepository interface {
 	GetAWSProfiles() []string
 	GetAccountID(ctx context.Context, profile string) (string, error)
 	GetSession(ctx context.Context, profile string) (string, error)
+	AssumeRoleProfile(baseProfile, accountID string, role entity.AssumeRoleOptions) string
 
 	// Region Operations
 	GetAllRegions(ctx context.Context, profile string) ([]string, error)
 	GetAccessibleRegions(ctx context.Context, profile string) ([]string, error)
 
 	// Cost Operations
-	GetCostData(ctx context.Context, profile string, timeRange *int, tags []string, breakdown bool) (entity.CostData, error)
-	GetTrendData(ctx context.Context, profile string, tags []string) (map[string]interface{}, error)
+	GetCostData(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, groupBy entity.Grouping, tags []string, exclude []entity.RecordType, breakdown bool) (entity.CostData, error)
+	GetTrendData(ctx context.Context, profile string, period entity.Period, granularity entity.TrendGranularity, metric entity.CostMetric, seriesBy *entity.GroupDimension, tags []string, exclude []entity.RecordType) (entity.CostTrend, error)
+	GetCostForecast(ctx context.Context, profile string, metric entity.CostMetric, tags []string, exclude []entity.RecordType) (entity.CostForecast, error)
+	GetLinkedAccountCosts(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, groupBy entity.Grouping, tags []string, exclude []entity.RecordType) ([]entity.LinkedAccountCost, error)
+
+	// Cost Anomalies
+	GetAnomalyMonitors(ctx context.Context, profile string) ([]entity.AnomalyMonitor, error)
+	GetCostAnomalies(ctx context.Context, profile string, period entity.Period) ([]entity.CostAnomaly, error)
+	GetDailyServiceCosts(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, tags []string, exclude []entity.RecordType) ([]entity.ServiceDailyCosts, error)
+
+	// Rightsizing
+	GetRightsizingRecommendations(ctx context.Context, profile string, regions []string, tags []string) ([]entity.RightsizingRecommendation, error)
+
+	// Organizations
+	GetOrganizationAccounts(ctx context.Context, profile string) ([]entity.OrgAccount, error)
 
 	// Budget Operations
 	GetBudgets(ctx context.Context, profile string) ([]entity.BudgetInfo, error)
@@ -30,11 +44,14 @@ type AWSRepository interface {
 	GetUnusedEIPs(ctx context.Context, profile string, regions []string) (entity.UnusedEIPs, error)
 	GetUntaggedResources(ctx context.Context, profile string, regions []string) (entity.UntaggedResources, error)
 	GetIdleLoadBalancers(ctx context.Context, profile string, regions []string) (entity.IdleLoadBalancers, error)
-	GetNatGatewayCost(ctx context.Context, profile string, timeRange *int, tags []string) ([]entity.NatGatewayCost, error)
+	GetNatGatewayCost(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, tags []string) ([]entity.NatGatewayCost, error)
 	GetUnusedVpcEndpoints(ctx context.Context, profile string, regions []string) (entity.UnusedVpcEndpoints, error)
 
+	// Pricing
+	GetResourcePrices(ctx context.Context, profile string, regions []string) (entity.PriceList, error)
+
 	// Data Transfer
-	GetDataTransferBreakdown(ctx context.Context, profile string, timeRange *int, tags []string) (entity.DataTransferReport, error)
+	GetDataTransferBreakdown(ctx context.Context, profile string, period entity.Period, metric entity.CostMetric, tags []string) (entity.DataTransferReport, error)
 
 	// CloudWatch Logs
 	GetCloudWatchLogGroups(ctx context.Context, profile string, regions []string) ([]entity.CloudWatchLogGroupInfo, error)
@@ -43,6 +60,22 @@ type AWSRepository interface {
 	GetS3LifecycleStatus(ctx context.Context, profile string) ([]entity.S3BucketLifecycleStatus, error)
 
 	// Savings Plans / Reserved Instances (Coverage & Utilization)
-	GetSavingsPlansSummary(ctx context.Context, profile string, timeRange *int, tags []string) (entity.SPSummary, error)
-	GetReservationSummary(ctx context.Context, profile string, timeRange *int, tags []string) (entity.RISummary, error)
+	GetSavingsPlansSummary(ctx context.Context, profile string, period entity.Period, tags []string) (entity.SPSummary, error)
+	GetReservationSummary(ctx context.Context, profile string, period entity.Period, tags []string) (entity.RISummary, error)
+
+	// Savings Plans / Reserved Instances (Purchase Recommendations)
+	GetPurchaseRecommendations(ctx context.Context, profile string, opts entity.PurchaseOptions) (entity.PurchaseRecommendations, error)
+
+	// Savings Plans / Reserved Instances (Expirations)
+	GetActiveCommitments(ctx context.Context, profile string, regions []string) ([]entity.CommitmentExpiration, error)
+
+	// Cost Explorer Response Cache
+	EnableResponseCache(opts entity.ResponseCacheOptions)
+	ResponseCacheStats() entity.ResponseCacheStats
+	ClearResponseCache(dir string) (int, error)
+
+	// Record & Replay
+	RecordAPIResponses(dir string) error
+	RecordingErr() error
+	ReplayAPIResponses(dir string) error
 }
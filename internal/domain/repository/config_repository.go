@@ -1,10 +1,14 @@
 package repository
 
 import (
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
 	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
 )
 
 // ConfigRepository defines the interface for loading configuration files.
 type ConfigRepository interface {
 	LoadConfigFile(filePath string) (*types.Config, error)
+	LoadPriceList(filePath string) (entity.PriceList, error)
+	LoadSuppressions(filePath string) ([]entity.Suppression, error)
+	LoadAuditBaseline(filePath string) ([]entity.AuditData, error)
 }
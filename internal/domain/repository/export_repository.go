@@ -13,6 +13,11 @@ type ExportRepository interface {
 	ExportAuditReportToCSV(auditData []entity.AuditData, filename string, outputDir string) (string, error)
 	ExportAuditReportToJSON(auditData []entity.AuditData, filename string, outputDir string) (string, error)
 
+	// Cost Trend
+	ExportTrendReportToCSV(trends []entity.CostTrend, filename, outputDir string) (string, error)
+	ExportTrendReportToJSON(trends []entity.CostTrend, filename, outputDir string) (string, error)
+	ExportTrendReportToPDF(trends []entity.CostTrend, filename, outputDir string) (string, error)
+
 	// Data Transfer
 	ExportTransferReportToCSV(reports []entity.DataTransferReport, filename, outputDir string) (string, error)
 	ExportTransferReportToJSON(reports []entity.DataTransferReport, filename, outputDir string) (string, error)
@@ -33,6 +38,21 @@ type ExportRepository interface {
 	ExportCommitmentsReportToJSON(reports []entity.CommitmentsReport, filename, outputDir string) (string, error)
 	ExportCommitmentsReportToPDF(reports []entity.CommitmentsReport, filename, outputDir string) (string, error)
 
+	// Commitment Expirations (SP/RI)
+	ExportExpirationReportToCSV(reports []entity.CommitmentExpirationReport, filename, outputDir string) (string, error)
+	ExportExpirationReportToJSON(reports []entity.CommitmentExpirationReport, filename, outputDir string) (string, error)
+	ExportExpirationReportToPDF(reports []entity.CommitmentExpirationReport, filename, outputDir string) (string, error)
+
+	// Cost Anomalies
+	ExportAnomalyReportToCSV(reports []entity.AnomalyReport, filename, outputDir string) (string, error)
+	ExportAnomalyReportToJSON(reports []entity.AnomalyReport, filename, outputDir string) (string, error)
+	ExportAnomalyReportToPDF(reports []entity.AnomalyReport, filename, outputDir string) (string, error)
+
+	// EC2 Rightsizing
+	ExportRightsizingReportToCSV(reports []entity.RightsizingReport, filename, outputDir string) (string, error)
+	ExportRightsizingReportToJSON(reports []entity.RightsizingReport, filename, outputDir string) (string, error)
+	ExportRightsizingReportToPDF(reports []entity.RightsizingReport, filename, outputDir string) (string, error)
+
 	// Full Audit
 	ExportFullAuditReportToCSV(reports []entity.FullAuditReport, filename, outputDir string) ([]string, error)
 	ExportFullAuditReportToJSON(reports []entity.FullAuditReport, filename, outputDir string) (string, error)
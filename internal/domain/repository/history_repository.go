@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"github.com/diillson/aws-finops-dashboard-go/internal/domain/entity"
+)
+
+// HistoryRepository defines the interface for the local store of report snapshots.
+type HistoryRepository interface {
+	// SaveSnapshot grava o snapshot em dir e retorna o ID usado, que muda se o ID pedido já existir.
+	SaveSnapshot(dir string, snapshot entity.Snapshot) (string, error)
+	// ListSnapshots retorna o índice dos snapshots, do mais antigo para o mais recente.
+	ListSnapshots(dir string) ([]entity.SnapshotSummary, error)
+	GetSnapshot(dir, id string) (*entity.Snapshot, error)
+}
@@ -1,5 +1,24 @@
 package types
 
+import "time"
+
+// ReportKind identifica qual relatório o caso de uso deve executar.
+type ReportKind string
+
+const (
+	ReportCost        ReportKind = "cost"
+	ReportAudit       ReportKind = "audit"
+	ReportTrend       ReportKind = "trend"
+	ReportTransfer    ReportKind = "transfer"
+	ReportLogs        ReportKind = "logs"
+	ReportS3          ReportKind = "s3"
+	ReportCommitments ReportKind = "commitments"
+	ReportExpirations ReportKind = "expirations"
+	ReportAnomalies   ReportKind = "anomalies"
+	ReportRightsizing ReportKind = "rightsizing"
+	ReportFullAudit   ReportKind = "full-audit"
+)
+
 // CLIArgs represents the command-line arguments.
 type CLIArgs struct {
 	ConfigFile     string
@@ -7,17 +26,75 @@ type CLIArgs struct {
 	Regions        []string
 	All            bool
 	Combine        bool
+	Org            bool
 	ReportName     string
 	ReportType     []string
 	Dir            string
 	TimeRange      *int
+	From           string
+	To             string
+	Month          string
+	Metric         string
+	GroupBy        []string
 	Tag            []string
-	Trend          bool
-	Audit          bool
+	Exclude        []string
 	BreakdownCosts bool
-	Transfer       bool
-	LogsAudit      bool
-	S3Audit        bool
-	Commitments    bool
-	FullAudit      bool
+
+	// Opções da análise de tendência.
+	TrendMonths int
+	Granularity string
+	TrendSeries string
+
+	// Opções das recomendações de compra de Savings Plans e RIs.
+	Term         string
+	Payment      string
+	LookbackDays int
+
+	// ExpiringWithin é a janela, em dias, para destacar Savings Plans e reservas perto do fim.
+	ExpiringWithin int
+
+	// PriceList é um arquivo JSON de preços usado no lugar da AWS Pricing API.
+	PriceList string
+
+	// Checks seleciona as verificações da auditoria: IDs incluem e IDs prefixados com "-" excluem.
+	Checks []string
+
+	// Suppressions é o arquivo (TOML, YAML ou JSON) com as supressões de achados da auditoria.
+	Suppressions string
+
+	// Baseline é a exportação JSON de um audit ou full-audit anterior; os achados são comparados com ela.
+	// OnlyNew mostra apenas os achados novos desde a base.
+	Baseline string
+	OnlyNew  bool
+
+	// HistoryDir é o diretório do histórico local de snapshots (padrão: ~/.aws-finops/history).
+	// NoHistory desliga a gravação do snapshot da execução.
+	HistoryDir string
+	NoHistory  bool
+
+	// CacheDir é o diretório do cache das respostas do Cost Explorer (padrão: ~/.aws-finops/cache) e
+	// CacheTTL a validade de cada resposta. NoCache desliga o cache.
+	CacheDir string
+	CacheTTL time.Duration
+	NoCache  bool
+
+	// Verbose exibe detalhes da execução, como os acertos do cache.
+	Verbose bool
+
+	// Record grava as respostas das chamadas à AWS no diretório informado; Replay reproduz uma
+	// gravação sem acessar a AWS.
+	Record string
+	Replay string
+
+	// AssumeRole é o nome da role assumida em cada conta a partir do perfil base.
+	// Sem Accounts, as contas são descobertas via AWS Organizations.
+	AssumeRole   string
+	Accounts     []string
+	ExternalID   string
+	RoleDuration time.Duration
+
+	// Report é o relatório selecionado pelo subcomando. Vazio significa que o
+	// comando raiz foi usado sem seleção explícita (dashboard de custos, a menos
+	// que o arquivo de configuração indique outro relatório).
+	Report ReportKind
 }
@@ -2,15 +2,44 @@ package types
 
 // Config represents the application configuration that can be loaded from a file.
 type Config struct {
-	Profiles   []string `json:"profiles" yaml:"profiles" toml:"profiles"`
-	Regions    []string `json:"regions" yaml:"regions" toml:"regions"`
-	Combine    bool     `json:"combine" yaml:"combine" toml:"combine"`
-	ReportName string   `json:"report_name" yaml:"report_name" toml:"report_name"`
-	ReportType []string `json:"report_type" yaml:"report_type" toml:"report_type"`
-	Dir        string   `json:"dir" yaml:"dir" toml:"dir"`
-	TimeRange  int      `json:"time_range" yaml:"time_range" toml:"time_range"`
-	Tag        []string `json:"tag" yaml:"tag" toml:"tag"`
-	Audit      bool     `json:"audit" yaml:"audit" toml:"audit"`
-	Trend      bool     `json:"trend" yaml:"trend" toml:"trend"`
-	All        bool
+	Profiles       []string `json:"profiles" yaml:"profiles" toml:"profiles"`
+	Regions        []string `json:"regions" yaml:"regions" toml:"regions"`
+	Combine        bool     `json:"combine" yaml:"combine" toml:"combine"`
+	Org            bool     `json:"org" yaml:"org" toml:"org"`
+	ReportName     string   `json:"report_name" yaml:"report_name" toml:"report_name"`
+	ReportType     []string `json:"report_type" yaml:"report_type" toml:"report_type"`
+	Dir            string   `json:"dir" yaml:"dir" toml:"dir"`
+	TimeRange      int      `json:"time_range" yaml:"time_range" toml:"time_range"`
+	From           string   `json:"from" yaml:"from" toml:"from"`
+	To             string   `json:"to" yaml:"to" toml:"to"`
+	Month          string   `json:"month" yaml:"month" toml:"month"`
+	Metric         string   `json:"metric" yaml:"metric" toml:"metric"`
+	GroupBy        []string `json:"group_by" yaml:"group_by" toml:"group_by"`
+	Tag            []string `json:"tag" yaml:"tag" toml:"tag"`
+	Exclude        []string `json:"exclude" yaml:"exclude" toml:"exclude"`
+	TrendMonths    int      `json:"trend_months" yaml:"trend_months" toml:"trend_months"`
+	Granularity    string   `json:"granularity" yaml:"granularity" toml:"granularity"`
+	TrendSeries    string   `json:"trend_series" yaml:"trend_series" toml:"trend_series"`
+	Term           string   `json:"term" yaml:"term" toml:"term"`
+	Payment        string   `json:"payment_option" yaml:"payment_option" toml:"payment_option"`
+	LookbackDays   int      `json:"lookback_days" yaml:"lookback_days" toml:"lookback_days"`
+	ExpiringWithin int      `json:"expiring_within" yaml:"expiring_within" toml:"expiring_within"`
+	PriceList      string   `json:"price_list" yaml:"price_list" toml:"price_list"`
+	Checks         []string `json:"checks" yaml:"checks" toml:"checks"`
+	Suppressions   string   `json:"suppressions" yaml:"suppressions" toml:"suppressions"`
+	Baseline       string   `json:"baseline" yaml:"baseline" toml:"baseline"`
+	OnlyNew        bool     `json:"only_new" yaml:"only_new" toml:"only_new"`
+	HistoryDir     string   `json:"history_dir" yaml:"history_dir" toml:"history_dir"`
+	NoHistory      bool     `json:"no_history" yaml:"no_history" toml:"no_history"`
+	CacheDir       string   `json:"cache_dir" yaml:"cache_dir" toml:"cache_dir"`
+	CacheTTL       string   `json:"cache_ttl" yaml:"cache_ttl" toml:"cache_ttl"`
+	NoCache        bool     `json:"no_cache" yaml:"no_cache" toml:"no_cache"`
+	Verbose        bool     `json:"verbose" yaml:"verbose" toml:"verbose"`
+	AssumeRole     string   `json:"assume_role" yaml:"assume_role" toml:"assume_role"`
+	Accounts       []string `json:"accounts" yaml:"accounts" toml:"accounts"`
+	ExternalID     string   `json:"external_id" yaml:"external_id" toml:"external_id"`
+	RoleDuration   string   `json:"role_duration" yaml:"role_duration" toml:"role_duration"`
+	Audit          bool     `json:"audit" yaml:"audit" toml:"audit"`
+	Trend          bool     `json:"trend" yaml:"trend" toml:"trend"`
+	All            bool
 }
@@ -25,8 +25,8 @@ type ConsoleInterface interface {
 	// CreateTable cria uma interface para uma tabela.
 	CreateTable() TableInterface
 
-	// DisplayTrendBars exibe os gráficos de barras de tendência.
-	DisplayTrendBars(monthlyCosts []MonthlyCost)
+	// DisplayTrendBars exibe os gráficos de barras de tendência; unit é o nome de cada ponto (Month, Week, Day).
+	DisplayTrendBars(unit string, bars []TrendBar)
 }
 
 // StatusHandle é uma interface para atualizar uma mensagem de status (spinner).
@@ -42,8 +42,8 @@ type TableInterface interface {
 	Render() string
 }
 
-// MonthlyCost representa o custo para um mês específico, usado para gráficos de tendência.
-type MonthlyCost struct {
-	Month string  `json:"month"`
+// TrendBar representa o custo de um mês, semana ou dia, usado para gráficos de tendência.
+type TrendBar struct {
+	Label string  `json:"label"`
 	Cost  float64 `json:"cost"`
 }
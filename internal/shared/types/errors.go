@@ -5,4 +5,14 @@ import "errors"
 var (
 	ErrNoProfilesFound      = errors.New("no AWS profiles found. Please configure AWS CLI first")
 	ErrNoValidProfilesFound = errors.New("none of the specified profiles were found in AWS configuration")
+	ErrConflictingReports   = errors.New("only one report can be selected at a time; use a subcommand (e.g. 'aws-finops audit') instead of combining report flags")
+	ErrConflictingPeriods   = errors.New("--time-range, --month and --from/--to are mutually exclusive")
+	ErrUnknownReport        = errors.New("unknown report")
+	ErrMultipleBaseProfiles = errors.New("--assume-role uses a single base profile; select it with --profiles")
+	ErrInvalidAccountID     = errors.New("invalid AWS account ID")
+	ErrOrgWithAssumeRole    = errors.New("--org and --assume-role cannot be used together")
+	ErrInvalidChecks        = errors.New("invalid --checks selection")
+	ErrOnlyNewWithoutBase   = errors.New("--only-new requires --baseline")
+	ErrInvalidCacheTTL      = errors.New("invalid --cache-ttl")
+	ErrRecordWithReplay     = errors.New("--record and --replay cannot be used together")
 )
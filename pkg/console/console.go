@@ -76,13 +76,13 @@ func (t *Table) Render() string {
 }
 
 // --- Trend Bars ---
-func (c *Console) DisplayTrendBars(monthlyCosts []types.MonthlyCost) {
-	if len(monthlyCosts) == 0 {
+func (c *Console) DisplayTrendBars(unit string, bars []types.TrendBar) {
+	if len(bars) == 0 {
 		pterm.Warning.Println("No trend data to display.")
 		return
 	}
 	maxCost := 0.0
-	for _, cost := range monthlyCosts {
+	for _, cost := range bars {
 		if cost.Cost > maxCost {
 			maxCost = cost.Cost
 		}
@@ -92,10 +92,15 @@ func (c *Console) DisplayTrendBars(monthlyCosts []types.MonthlyCost) {
 		return
 	}
 
-	tableData := pterm.TableData{{"Month", "Cost", "", "MoM Change"}}
+	// MoM, WoW ou DoD, conforme a granularidade.
+	changeTitle := map[string]string{"Month": "MoM Change", "Week": "WoW Change", "Day": "DoD Change"}[unit]
+	if changeTitle == "" {
+		changeTitle = "Change"
+	}
+	tableData := pterm.TableData{{unit, "Cost", "", changeTitle}}
 	var prevCost *float64
 
-	for _, mc := range monthlyCosts {
+	for _, mc := range bars {
 		barLength := int((mc.Cost / maxCost) * 40)
 		if barLength < 0 {
 			barLength = 0
@@ -119,7 +124,7 @@ func (c *Console) DisplayTrendBars(monthlyCosts []types.MonthlyCost) {
 				}
 			}
 		}
-		tableData = append(tableData, []string{mc.Month, fmt.Sprintf("$%.2f", mc.Cost), barColor.Sprint(bar), change})
+		tableData = append(tableData, []string{mc.Label, fmt.Sprintf("$%.2f", mc.Cost), barColor.Sprint(bar), change})
 		currentCost := mc.Cost
 		prevCost = &currentCost
 	}
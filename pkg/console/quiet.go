@@ -0,0 +1,44 @@
+package console
+
+import (
+	"io"
+
+	"github.com/diillson/aws-finops-dashboard-go/internal/shared/types"
+	"github.com/pterm/pterm"
+)
+
+// QuietConsole é um Console sem saída interativa (spinners e barras de progresso).
+// Usado em modos não interativos, como o servidor HTTP, onde várias requisições
+// podem rodar ao mesmo tempo. As mensagens de log continuam sendo exibidas.
+type QuietConsole struct {
+	Console
+}
+
+// NewQuietConsole cria um novo QuietConsole.
+func NewQuietConsole() types.ConsoleInterface {
+	return &QuietConsole{}
+}
+
+// Print, Printf, Println descartam a saída de relatórios.
+func (c *QuietConsole) Print(a ...interface{})                 {}
+func (c *QuietConsole) Printf(format string, a ...interface{}) {}
+func (c *QuietConsole) Println(a ...interface{})               {}
+
+type quietStatusHandle struct{}
+
+func (c *QuietConsole) Status(message string) types.StatusHandle { return quietStatusHandle{} }
+func (quietStatusHandle) Update(message string)                  {}
+func (quietStatusHandle) Stop()                                  {}
+
+// GetMultiPrinter retorna um MultiPrinter próprio que descarta a saída.
+func (c *QuietConsole) GetMultiPrinter() *pterm.MultiPrinter {
+	return pterm.DefaultMultiPrinter.WithWriter(io.Discard)
+}
+
+// NewProgressbar cria uma barra de progresso que descarta a saída.
+func (c *QuietConsole) NewProgressbar(total int, title string) *pterm.ProgressbarPrinter {
+	return c.Console.NewProgressbar(total, title).WithWriter(io.Discard)
+}
+
+// DisplayTrendBars não exibe nada.
+func (c *QuietConsole) DisplayTrendBars(unit string, bars []types.TrendBar) {}